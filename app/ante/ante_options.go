@@ -6,6 +6,8 @@ import (
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
+	delayedackkeeper "github.com/dymensionxyz/dymension/x/delayedack/keeper"
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
 	ethante "github.com/evmos/ethermint/app/ante"
 
 	errorsmod "cosmossdk.io/errors"
@@ -21,6 +23,8 @@ type HandlerOptions struct {
 	EvmKeeper              ethante.EVMKeeper
 	FeegrantKeeper         ante.FeegrantKeeper
 	TxFeesKeeper           *txfeeskeeper.Keeper
+	DelayedAckKeeper       *delayedackkeeper.Keeper
+	RollappKeeper          *rollappkeeper.Keeper
 	SignModeHandler        authsigning.SignModeHandler
 	MaxTxGasWanted         uint64
 	ExtensionOptionChecker ante.ExtensionOptionChecker
@@ -45,5 +49,11 @@ func (options HandlerOptions) validate() error {
 	if options.TxFeesKeeper == nil {
 		return errorsmod.Wrap(errortypes.ErrLogic, "tx fees keeper is required for AnteHandler")
 	}
+	if options.DelayedAckKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "delayed ack keeper is required for AnteHandler")
+	}
+	if options.RollappKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "rollapp keeper is required for AnteHandler")
+	}
 	return nil
 }
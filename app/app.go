@@ -191,6 +191,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		ibcclientclient.UpgradeProposalHandler,
 		streamermoduleclient.CreateStreamHandler,
 		streamermoduleclient.TerminateStreamHandler,
+		streamermoduleclient.AddToStreamHandler,
 	)
 
 	return govProposalHandlers
@@ -253,6 +254,7 @@ var (
 		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		sequencermoduletypes.ModuleName: {authtypes.Minter, authtypes.Burner, authtypes.Staking},
 		streamermoduletypes.ModuleName:  nil,
+		rollappmoduletypes.ModuleName:   {authtypes.Burner},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 
 		evmtypes.ModuleName:        {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
@@ -399,7 +401,7 @@ func New(
 		txfeestypes.StoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey, delayedacktypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	// load state streaming if enabled
@@ -468,8 +470,9 @@ func New(
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
+	// NOTE: the streamer hooks reference app.StreamerKeeper, which is only set below
 	app.StakingKeeper = *stakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(), app.StreamerKeeper.StakingHooks()),
 	)
 
 	// Create Ethermint keepers
@@ -556,6 +559,11 @@ func New(
 		keys[rollappmoduletypes.StoreKey],
 		keys[rollappmoduletypes.MemStoreKey],
 		app.GetSubspace(rollappmoduletypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	app.SequencerKeeper = *sequencermodulekeeper.NewKeeper(
@@ -567,26 +575,34 @@ func New(
 		app.BankKeeper,
 		app.RollappKeeper,
 	)
+	app.RollappKeeper.SetSequencerKeeper(app.SequencerKeeper)
 
 	app.StreamerKeeper = *streamermodulekeeper.NewKeeper(
 		keys[streamermoduletypes.StoreKey],
 		app.GetSubspace(streamermoduletypes.ModuleName),
 
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.EpochsKeeper,
 		app.AccountKeeper,
 		app.IncentivesKeeper,
+		app.StakingKeeper,
+		app.RollappKeeper,
+		app.SequencerKeeper,
 	)
 
 	app.DelayedAckKeeper = *delayedackkeeper.NewKeeper(
 		appCodec,
 		keys[delayedacktypes.StoreKey],
 		keys[delayedacktypes.MemStoreKey],
+		tkeys[delayedacktypes.TransientStoreKey],
+		app.GetSubspace(delayedacktypes.ModuleName),
 		app.RollappKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ConnectionKeeper,
 		app.IBCKeeper.ClientKeeper,
+		app.BankKeeper,
 	)
 
 	/* -------------------------------- set hooks ------------------------------- */
@@ -687,6 +703,7 @@ func New(
 		// insert rollapp hooks receivers here
 		app.SequencerKeeper.RollappHooks(),
 		transferStack.(delayedackmodule.IBCMiddleware),
+		app.StreamerKeeper.RollappHooks(),
 	))
 
 	/****  Module Options ****/
@@ -716,7 +733,7 @@ func New(
 		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		upgrade.NewAppModule(app.UpgradeKeeper),
 		evidence.NewAppModule(app.EvidenceKeeper),
-		ibc.NewAppModule(app.IBCKeeper),
+		rollappmodule.NewIBCAppModule(app.IBCKeeper, app.RollappKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		packetforwardmiddleware.NewAppModule(app.PacketForwardMiddlewareKeeper),
 		transferModule,
@@ -877,6 +894,8 @@ func New(
 		EvmKeeper:              app.EvmKeeper,
 		FeegrantKeeper:         app.FeeGrantKeeper,
 		TxFeesKeeper:           app.TxFeesKeeper,
+		DelayedAckKeeper:       &app.DelayedAckKeeper,
+		RollappKeeper:          &app.RollappKeeper,
 		SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 		MaxTxGasWanted:         maxGasWanted,
 		ExtensionOptionChecker: nil, //uses default
@@ -1075,6 +1094,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(rollappmoduletypes.ModuleName)
 	paramsKeeper.Subspace(sequencermoduletypes.ModuleName)
 	paramsKeeper.Subspace(streamermoduletypes.ModuleName)
+	paramsKeeper.Subspace(delayedacktypes.ModuleName)
 	// this line is used by starport scaffolding # stargate/app/paramSubspace
 
 	// ethermint subspaces
@@ -0,0 +1,150 @@
+package ibctesting_test
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// Transfer from a rollapp to the hub over a client which is not the rollapp canonical one.
+// The packet claims to come from the rollapp without going through its finalization, so it is rejected
+func (suite *KeeperTestSuite) TestTransferRollappToHub_NonCanonicalClient() {
+	canonicalPath := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(canonicalPath)
+	suite.CreateRollappWithMetadata(canonicalPath, sdk.DefaultBondDenom)
+
+	// another client tracking the same chain-id
+	spoofedPath := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(spoofedPath)
+	suite.Require().NotEqual(canonicalPath.EndpointA.ClientID, spoofedPath.EndpointA.ClientID)
+
+	hubApp := ConvertToApp(suite.hubChain)
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+
+	transfer := func(path *ibctesting.Path) error {
+		msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinToSendToB, suite.rollappChain.SenderAccount.GetAddress().String(), suite.hubChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+		res, err := suite.rollappChain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		return path.RelayPacket(packet)
+	}
+
+	// the packet over the spoofed client is acknowledged right away with an error, and the sender is refunded
+	rollappApp := ConvertToApp(suite.rollappChain)
+	senderBalance := rollappApp.BankKeeper.GetBalance(suite.rollappChain.GetContext(), suite.rollappChain.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	err := transfer(spoofedPath)
+	suite.Require().NoError(err)
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	spoofedDenom := types.ParseDenomTrace(types.GetPrefixedDenom(spoofedPath.EndpointA.ChannelConfig.PortID, spoofedPath.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().False(hubApp.BankKeeper.HasDenomMetaData(suite.hubChain.GetContext(), spoofedDenom))
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), suite.hubChain.SenderAccount.GetAddress(), spoofedDenom).IsZero())
+	suite.Require().Equal(senderBalance, rollappApp.BankKeeper.GetBalance(suite.rollappChain.GetContext(), suite.rollappChain.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+
+	// the packet over the canonical client is delayed until finalization, and then given the rollapp metadata
+	err = transfer(canonicalPath)
+	//expecting error as no AcknowledgePacket expected to return
+	suite.Require().Error(err)
+	pendingPackets = hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Len(pendingPackets, 1)
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	canonicalDenom := types.ParseDenomTrace(types.GetPrefixedDenom(canonicalPath.EndpointA.ChannelConfig.PortID, canonicalPath.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().True(hubApp.BankKeeper.HasDenomMetaData(suite.hubChain.GetContext(), canonicalDenom))
+}
+
+// Binding a rollapp to clients or channels which don't belong to it
+func (suite *KeeperTestSuite) TestSetCanonicalClient_InvalidBinding() {
+	rollappPath := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(rollappPath)
+	cosmosPath := suite.NewTransferPath(suite.hubChain, suite.cosmosChain)
+	suite.coordinator.Setup(cosmosPath)
+	suite.CreateRollapp(rollappPath)
+
+	hubApp := ConvertToApp(suite.hubChain)
+	msgServer := rollappkeeper.NewMsgServerImpl(hubApp.RollappKeeper)
+	creator := suite.hubChain.SenderAccount.GetAddress().String()
+	goCtx := sdk.WrapSDKContext(suite.hubChain.GetContext())
+
+	// the client of another chain
+	_, err := msgServer.SetCanonicalClient(goCtx, rollapptypes.NewMsgSetCanonicalClient(creator, suite.rollappChain.ChainID, cosmosPath.EndpointA.ClientID, nil))
+	suite.Require().ErrorIs(err, rollapptypes.ErrInvalidClientID)
+
+	// a channel of another client
+	_, err = msgServer.SetCanonicalClient(goCtx, rollapptypes.NewMsgSetCanonicalClient(creator, suite.rollappChain.ChainID, rollappPath.EndpointA.ClientID, []string{cosmosPath.EndpointA.ChannelID}))
+	suite.Require().ErrorIs(err, rollapptypes.ErrInvalidChannelID)
+
+	// restricting the rollapp to its own channel
+	_, err = msgServer.SetCanonicalClient(goCtx, rollapptypes.NewMsgSetCanonicalClient(creator, suite.rollappChain.ChainID, rollappPath.EndpointA.ClientID, []string{rollappPath.EndpointA.ChannelID}))
+	suite.Require().NoError(err)
+	rollapp, found, err := hubApp.RollappKeeper.GetRollappByPortChannel(suite.hubChain.GetContext(), types.PortID, rollappPath.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.rollappChain.ChainID, rollapp.RollappId)
+	_, found, err = hubApp.RollappKeeper.GetRollappByPortChannel(suite.hubChain.GetContext(), types.PortID, cosmosPath.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+}
+
+// Transfers from the hub to a rollapp sent over a channel which is not bound to the rollapp once relayed back.
+// The packets were sent before the rollapp was bound to another client, so they are settled right away
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_SentBeforeBinding() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	rollappEndpoint := path.EndpointB
+	hubApp := ConvertToApp(suite.hubChain)
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	balanceBefore := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	send := func(timeoutHeight clienttypes.Height) channeltypes.Packet {
+		msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, sender.String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+		res, err := suite.hubChain.SendMsgs(msg)
+		suite.Require().NoError(err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+		suite.Require().NoError(err)
+		return packet
+	}
+
+	// one packet is received by the rollapp and the other one times out, before the rollapp is registered
+	ackedPacket := send(clienttypes.NewHeight(100, 110))
+	timedOutPacket := send(clienttypes.GetSelfHeight(suite.rollappChain.GetContext()))
+	err := rollappEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	res, err := rollappEndpoint.RecvPacketWithResult(ackedPacket)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	// the rollapp is registered and bound to another client
+	canonicalPath := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(canonicalPath)
+	suite.CreateRollapp(canonicalPath)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	// the acknowledgement and the timeout are settled without being delayed
+	err = hubEndpoint.AcknowledgePacket(ackedPacket, ack)
+	suite.Require().NoError(err)
+	err = hubEndpoint.TimeoutPacket(timedOutPacket)
+	suite.Require().NoError(err)
+
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	balance := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore.Sub(coinToSendToB), balance)
+}
@@ -1,15 +1,18 @@
 package ibctesting_test
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
-)
 
-//TODO: test hub -> rollapp
-// eventhough the ackwonledgement from the rollapp is delayed, the tokens should be available on the rollapp chain
+	delayedacktypes "github.com/dymensionxyz/dymension/x/delayedack/types"
+)
 
 // Transfer from cosmos chain to the hub. No delay expected
 func (suite *KeeperTestSuite) TestTransferCosmosToHub() {
@@ -80,7 +83,7 @@ func (suite *KeeperTestSuite) TestTransferRollappToHub_NotFinalized() {
 	rollappEndpoint := path.EndpointB
 	hubIBCKeeper := suite.hubChain.App.GetIBCKeeper()
 
-	suite.CreateRollapp()
+	suite.CreateRollapp(path)
 
 	timeoutHeight := clienttypes.NewHeight(100, 110)
 	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
@@ -100,6 +103,73 @@ func (suite *KeeperTestSuite) TestTransferRollappToHub_NotFinalized() {
 	suite.Require().Error(err) // relay committed
 	found := hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(hubEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	suite.Require().False(found)
+
+	// the pending packet can be looked up by its hub channel and sequence
+	res2, err := ConvertToApp(suite.hubChain).DelayedAckKeeper.RollappPacket(sdk.WrapSDKContext(suite.hubChain.GetContext()), &delayedacktypes.QueryGetRollappPacketRequest{
+		ChannelId: hubEndpoint.ChannelID,
+		Sequence:  packet.GetSequence(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.rollappChain.ChainID, res2.RollappId)
+	suite.Require().Equal(delayedacktypes.RollappPacket_PENDING, res2.RollappPacket.Status)
+}
+
+// The packet is delayed until its actual proof height is finalized, even if the light client
+// was updated past it before the packet was relayed
+func (suite *KeeperTestSuite) TestTransferRollappToHub_ProofHeight() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubIBCKeeper := suite.hubChain.App.GetIBCKeeper()
+	hubApp := ConvertToApp(suite.hubChain)
+
+	rollappEndpoint := path.EndpointB
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	/* --------------------- initiating transfer on rollapp --------------------- */
+	msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID, coinToSendToB, suite.rollappChain.SenderAccount.GetAddress().String(), suite.hubChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.rollappChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// prove the packet commitment at the current client height
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	proof, proofHeight := rollappEndpoint.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	// update the client past the proof height before relaying the packet
+	suite.coordinator.CommitNBlocks(suite.rollappChain, 5)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	clientHeight := hubEndpoint.GetClientState().GetLatestHeight().GetRevisionHeight()
+	suite.Require().Greater(clientHeight, proofHeight.GetRevisionHeight())
+
+	_, err = suite.hubChain.SendMsgs(channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.hubChain.SenderAccount.GetAddress().String()))
+	suite.Require().NoError(err)
+
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Len(pendingPackets, 1)
+	suite.Require().Equal(proofHeight.GetRevisionHeight(), pendingPackets[0].ProofHeight)
+
+	// finalizing the state right below the proof height doesn't release the packet
+	err = suite.FinalizeRollappUntil(proofHeight.GetRevisionHeight() - 1)
+	suite.Require().NoError(err)
+	found := hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(hubEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// finalizing the proof height releases the packet, while the client height is not finalized yet
+	err = suite.FinalizeRollappUntil(proofHeight.GetRevisionHeight())
+	suite.Require().NoError(err)
+	found = hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(hubEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
 }
 
 // rollapp w/o state updates. should return ErrAck
@@ -114,7 +184,7 @@ func (suite *KeeperTestSuite) TestTransferRollappToHub_Finalization() {
 	rollappEndpoint := path.EndpointB
 	rollappIBCKeeper := suite.rollappChain.App.GetIBCKeeper()
 
-	suite.CreateRollapp()
+	suite.CreateRollapp(path)
 
 	timeoutHeight := clienttypes.NewHeight(100, 110)
 	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
@@ -145,7 +215,284 @@ func (suite *KeeperTestSuite) TestTransferRollappToHub_Finalization() {
 	suite.Require().True(found)
 }
 
-//state was already finalized
+func (suite *KeeperTestSuite) TestTransferRollappToHub_Reverted() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubIBCKeeper := suite.hubChain.App.GetIBCKeeper()
+
+	rollappEndpoint := path.EndpointB
+	rollappApp := ConvertToApp(suite.rollappChain)
+
+	suite.CreateRollapp(path)
 
-//TODO:
-// timeout??
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	sender := suite.rollappChain.SenderAccount.GetAddress()
+	balanceBefore := rollappApp.BankKeeper.GetBalance(suite.rollappChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	/* --------------------- initiating transfer on rollapp --------------------- */
+	msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID, coinToSendToB, sender.String(), suite.hubChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.rollappChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	//expecting error as no AcknowledgePacket expected to return
+	suite.Require().Error(err) // relay committed
+
+	found := hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(hubEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// revert the rollapp states, the pending packet is rejected with an error acknowledgement
+	err = suite.RevertRollapp()
+	suite.Require().NoError(err)
+	found = hubIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(hubEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	pendingPackets := ConvertToApp(suite.hubChain).DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+
+	// the rejected packet is not processed by a later finalization
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+
+	// relay the error acknowledgement, the sender is refunded
+	suite.coordinator.CommitBlock(suite.hubChain)
+	err = rollappEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	ack := channeltypes.NewErrorAcknowledgement(delayedacktypes.ErrRollappStateReverted)
+	err = rollappEndpoint.AcknowledgePacket(packet, ack.Acknowledgement())
+	suite.Require().NoError(err)
+	balanceAfter := rollappApp.BankKeeper.GetBalance(suite.rollappChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balanceAfter)
+}
+
+// Transfer from the hub to a rollapp. The acknowledgement from the rollapp is delayed until finalization,
+// while the tokens are available on the rollapp chain right away
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_AckFinalization() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubApp := ConvertToApp(suite.hubChain)
+
+	rollappEndpoint := path.EndpointB
+	rollappIBCKeeper := suite.rollappChain.App.GetIBCKeeper()
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	/* ----------------------- initiating transfer on hub ----------------------- */
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, suite.hubChain.SenderAccount.GetAddress().String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay send and acknowledgement
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	found := rollappIBCKeeper.ChannelKeeper.HasPacketAcknowledgement(rollappEndpoint.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	// the acknowledgement is pending on the hub
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Len(pendingPackets, 1)
+	suite.Require().Equal(delayedacktypes.RollappPacket_ON_ACK, pendingPackets[0].Type)
+	suite.Require().NotEmpty(pendingPackets[0].Acknowledgement)
+
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	pendingPackets = hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+}
+
+// Transfer from the hub to a rollapp which times out. The sender is refunded only once the rollapp
+// state proving the timeout is finalized
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_TimeoutFinalization() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubApp := ConvertToApp(suite.hubChain)
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.rollappChain.GetContext())
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	balanceBefore := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	/* ----------------------- initiating transfer on hub ----------------------- */
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, sender.String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// let the packet time out on the rollapp and relay the timeout
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	err = hubEndpoint.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	// the timeout is pending on the hub, the sender is not refunded yet
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Len(pendingPackets, 1)
+	suite.Require().Equal(delayedacktypes.RollappPacket_ON_TIMEOUT, pendingPackets[0].Type)
+	balance := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().True(balanceBefore.Sub(coinToSendToB).IsEqual(balance))
+
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	pendingPackets = hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	balance = hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+}
+
+// Transfer from the hub to a rollapp which times out while the rollapp state is reverted.
+// The timeout can't be trusted, but the packet commitment is gone, so the sender is refunded
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_TimeoutReverted() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubApp := ConvertToApp(suite.hubChain)
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.rollappChain.GetContext())
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	balanceBefore := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	/* ----------------------- initiating transfer on hub ----------------------- */
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, sender.String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// let the packet time out on the rollapp and relay the timeout
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	err = hubEndpoint.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	err = suite.RevertRollapp()
+	suite.Require().NoError(err)
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	balance := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+
+	// the rejected timeout is not processed again by a later finalization
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	balance = hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+}
+
+// Transfer from the hub to a rollapp which times out after the rollapp is frozen.
+// The frozen rollapp won't finalize the timeout anymore, so the sender is refunded right away
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_TimeoutFrozen() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubApp := ConvertToApp(suite.hubChain)
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.rollappChain.GetContext())
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	balanceBefore := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	/* ----------------------- initiating transfer on hub ----------------------- */
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, sender.String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// let the packet time out on the rollapp, freeze the rollapp and relay the timeout
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	suite.FreezeRollapp()
+	err = hubEndpoint.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	balance := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+}
+
+// Transfer from the hub to a rollapp which is deregistered before the acknowledgement is relayed.
+// The acknowledgement can't be trusted anymore, so the sender is refunded right away
+func (suite *KeeperTestSuite) TestTransferHubToRollapp_AckDeregistered() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+
+	hubEndpoint := path.EndpointA
+	hubApp := ConvertToApp(suite.hubChain)
+
+	rollappEndpoint := path.EndpointB
+
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	amount, ok := sdk.NewIntFromString("10000000000000000000") //10DYM
+	suite.Require().True(ok)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	balanceBefore := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+
+	/* ----------------------- initiating transfer on hub ----------------------- */
+	msg := types.NewMsgTransfer(hubEndpoint.ChannelConfig.PortID, hubEndpoint.ChannelID, coinToSendToB, sender.String(), suite.rollappChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+	res, err := suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// receive the packet on the rollapp
+	err = rollappEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	res, err = rollappEndpoint.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// deregister the rollapp and relay the acknowledgement
+	err = hubEndpoint.UpdateClient()
+	suite.Require().NoError(err)
+	suite.DeregisterRollapp()
+	err = hubEndpoint.AcknowledgePacket(packet, ack)
+	suite.Require().NoError(err)
+
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	balance := hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(balanceBefore, balance)
+}
@@ -20,7 +20,7 @@ func (suite *KeeperTestSuite) TestDenomRegistation_RollappToHub() {
 	suite.coordinator.Setup(path)
 
 	//register rollapp with metadata for stake denom
-	suite.CreateRollappWithMetadata(sdk.DefaultBondDenom)
+	suite.CreateRollappWithMetadata(path, sdk.DefaultBondDenom)
 	suite.FinalizeRollapp()
 
 	found := ConvertToApp(suite.hubChain).BankKeeper.HasDenomMetaData(suite.hubChain.GetContext(), sdk.DefaultBondDenom)
@@ -0,0 +1,199 @@
+package ibctesting_test
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	delayedackkeeper "github.com/dymensionxyz/dymension/x/delayedack/keeper"
+	delayedacktypes "github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// transferWithMemo sends a transfer from the rollapp to the hub and relays it, without an acknowledgement being written
+func (suite *KeeperTestSuite) transferWithMemo(path *ibctesting.Path, amount sdk.Int, memo string) channeltypes.Packet {
+	rollappEndpoint := path.EndpointB
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+	msg := types.NewMsgTransfer(rollappEndpoint.ChannelConfig.PortID, rollappEndpoint.ChannelID, coinToSendToB, suite.rollappChain.SenderAccount.GetAddress().String(), suite.hubChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, memo)
+	res, err := suite.rollappChain.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// relay send
+	err = path.RelayPacket(packet)
+	//expecting error as no AcknowledgePacket expected to return
+	suite.Require().Error(err) // relay committed
+	return packet
+}
+
+// pendingDemandOrder returns the single pending demand order of the rollapp
+func (suite *KeeperTestSuite) pendingDemandOrder() delayedacktypes.DemandOrder {
+	delayedAckKeeper := ConvertToApp(suite.hubChain).DelayedAckKeeper
+	res, err := delayedAckKeeper.DemandOrders(sdk.WrapSDKContext(suite.hubChain.GetContext()), &delayedacktypes.QueryDemandOrdersRequest{
+		RollappId: suite.rollappChain.ChainID,
+		Status:    delayedacktypes.RollappPacket_PENDING,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DemandOrders, 1)
+	return res.DemandOrders[0]
+}
+
+// fulfillDemandOrder funds the fulfiller with the price of the order and fulfills it
+func (suite *KeeperTestSuite) fulfillDemandOrder(fulfiller sdk.AccAddress, demandOrder delayedacktypes.DemandOrder) {
+	hubApp := ConvertToApp(suite.hubChain)
+	ctx := suite.hubChain.GetContext()
+	err := bankutil.FundAccount(hubApp.BankKeeper, ctx, fulfiller, sdk.NewCoins(demandOrder.Price))
+	suite.Require().NoError(err)
+
+	msgServer := delayedackkeeper.NewMsgServerImpl(hubApp.DelayedAckKeeper)
+	_, err = msgServer.FulfillOrder(sdk.WrapSDKContext(ctx), delayedacktypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id))
+	suite.Require().NoError(err)
+}
+
+// Transfer from a rollapp to the hub with an eIBC fee. The recipient is paid by the fulfiller right away,
+// and the fulfiller receives the transfer on finalization
+func (suite *KeeperTestSuite) TestEIBCDemandOrder_Finalization() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	hubApp := ConvertToApp(suite.hubChain)
+	suite.CreateRollapp(path)
+
+	recipient := suite.hubChain.SenderAccount.GetAddress()
+	fulfiller := suite.hubChain.SenderAccounts[1].SenderAccount.GetAddress()
+	hubDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	packet := suite.transferWithMemo(path, sdk.NewInt(1000), `{"eibc":{"fee":"100"}}`)
+
+	demandOrder := suite.pendingDemandOrder()
+	suite.Require().True(demandOrder.Price.IsEqual(sdk.NewInt64Coin(hubDenom, 900)))
+	suite.Require().True(demandOrder.Fee.IsEqual(sdk.NewInt64Coin(hubDenom, 100)))
+	suite.Require().Equal(recipient.String(), demandOrder.Recipient)
+
+	suite.fulfillDemandOrder(fulfiller, demandOrder)
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), recipient, hubDenom).IsEqual(sdk.NewInt64Coin(hubDenom, 900)))
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), fulfiller, hubDenom).IsZero())
+
+	// an order can only be fulfilled once
+	msgServer := delayedackkeeper.NewMsgServerImpl(hubApp.DelayedAckKeeper)
+	_, err := msgServer.FulfillOrder(sdk.WrapSDKContext(suite.hubChain.GetContext()), delayedacktypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id))
+	suite.Require().ErrorIs(err, delayedacktypes.ErrDemandOrderAlreadyFulfilled)
+
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	found := hubApp.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.hubChain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	// the transfer went to the fulfiller
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), recipient, hubDenom).IsEqual(sdk.NewInt64Coin(hubDenom, 900)))
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), fulfiller, hubDenom).IsEqual(sdk.NewInt64Coin(hubDenom, 1000)))
+	demandOrder, found = hubApp.DelayedAckKeeper.GetDemandOrder(suite.hubChain.GetContext(), demandOrder.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(delayedacktypes.RollappPacket_ACCEPTED, demandOrder.TrackingPacketStatus)
+
+	// finalized orders can't be fulfilled
+	_, err = msgServer.FulfillOrder(sdk.WrapSDKContext(suite.hubChain.GetContext()), delayedacktypes.NewMsgFulfillOrder(fulfiller.String(), demandOrder.Id))
+	suite.Require().ErrorIs(err, delayedacktypes.ErrDemandOrderInactive)
+}
+
+// Transfer from a rollapp to the hub with an eIBC fee which is not fulfilled. The recipient receives the transfer on finalization
+func (suite *KeeperTestSuite) TestEIBCDemandOrder_NotFulfilled() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	hubApp := ConvertToApp(suite.hubChain)
+	suite.CreateRollapp(path)
+
+	recipient := suite.hubChain.SenderAccount.GetAddress()
+	hubDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	suite.transferWithMemo(path, sdk.NewInt(1000), `{"eibc":{"fee":"100"}}`)
+	demandOrder := suite.pendingDemandOrder()
+
+	// the recipient lowers the fee
+	msgServer := delayedackkeeper.NewMsgServerImpl(hubApp.DelayedAckKeeper)
+	_, err := msgServer.UpdateDemandOrder(sdk.WrapSDKContext(suite.hubChain.GetContext()), delayedacktypes.NewMsgUpdateDemandOrder(recipient.String(), demandOrder.Id, "50"))
+	suite.Require().NoError(err)
+	demandOrder = suite.pendingDemandOrder()
+	suite.Require().True(demandOrder.Price.IsEqual(sdk.NewInt64Coin(hubDenom, 950)))
+	suite.Require().True(demandOrder.Fee.IsEqual(sdk.NewInt64Coin(hubDenom, 50)))
+
+	err = suite.FinalizeRollapp()
+	suite.Require().NoError(err)
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), recipient, hubDenom).IsEqual(sdk.NewInt64Coin(hubDenom, 1000)))
+}
+
+// Transfer from a rollapp to the hub with an eIBC fee, fulfilled and then reverted. The fulfiller isn't paid back,
+// and no error acknowledgement refunds the sender, as the recipient was already paid
+func (suite *KeeperTestSuite) TestEIBCDemandOrder_Reverted() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	hubApp := ConvertToApp(suite.hubChain)
+	suite.CreateRollapp(path)
+
+	fulfiller := suite.hubChain.SenderAccounts[1].SenderAccount.GetAddress()
+	hubDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+
+	packet := suite.transferWithMemo(path, sdk.NewInt(1000), `{"eibc":{"fee":"100"}}`)
+	demandOrder := suite.pendingDemandOrder()
+	suite.fulfillDemandOrder(fulfiller, demandOrder)
+
+	err := suite.RevertRollapp()
+	suite.Require().NoError(err)
+	demandOrder, found := hubApp.DelayedAckKeeper.GetDemandOrder(suite.hubChain.GetContext(), demandOrder.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(delayedacktypes.RollappPacket_REJECTED, demandOrder.TrackingPacketStatus)
+	suite.Require().True(hubApp.BankKeeper.GetBalance(suite.hubChain.GetContext(), fulfiller, hubDenom).IsZero())
+	found = hubApp.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.hubChain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+// Transfer from a rollapp to the hub with an eIBC fee, not fulfilled and then reverted. The sender is refunded
+func (suite *KeeperTestSuite) TestEIBCDemandOrder_RevertedNotFulfilled() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	hubApp := ConvertToApp(suite.hubChain)
+	suite.CreateRollapp(path)
+
+	packet := suite.transferWithMemo(path, sdk.NewInt(1000), `{"eibc":{"fee":"100"}}`)
+	demandOrder := suite.pendingDemandOrder()
+
+	err := suite.RevertRollapp()
+	suite.Require().NoError(err)
+	demandOrder, found := hubApp.DelayedAckKeeper.GetDemandOrder(suite.hubChain.GetContext(), demandOrder.Id)
+	suite.Require().True(found)
+	suite.Require().Equal(delayedacktypes.RollappPacket_REJECTED, demandOrder.TrackingPacketStatus)
+	found = hubApp.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.hubChain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+}
+
+// Transfer from a rollapp to the hub with an invalid eIBC memo. The packet is acknowledged with an error right away
+func (suite *KeeperTestSuite) TestEIBCDemandOrder_InvalidMemo() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	hubApp := ConvertToApp(suite.hubChain)
+	suite.CreateRollapp(path)
+
+	timeoutHeight := clienttypes.NewHeight(100, 110)
+	coinToSendToB := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	msg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinToSendToB, suite.rollappChain.SenderAccount.GetAddress().String(), suite.hubChain.SenderAccount.GetAddress().String(), timeoutHeight, 0, `{"eibc":{"fee":"2000"}}`)
+	res, err := suite.rollappChain.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the error acknowledgement is written, so the relay succeeds
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	pendingPackets := hubApp.DelayedAckKeeper.ListRollappPendingPackets(suite.hubChain.GetContext(), suite.rollappChain.ChainID, math.MaxUint64)
+	suite.Require().Empty(pendingPackets)
+	demandOrders := hubApp.DelayedAckKeeper.GetAllDemandOrder(suite.hubChain.GetContext())
+	suite.Require().Empty(demandOrders)
+}
@@ -0,0 +1,168 @@
+package ibctesting_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	"github.com/dymensionxyz/dymension/x/rollapp"
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// postStateRoot posts a rollapp state of a single block with the given state root
+func (suite *KeeperTestSuite) postStateRoot(height uint64, stateRoot []byte, status rollapptypes.StateStatus) {
+	rollappKeeper := ConvertToApp(suite.hubChain).RollappKeeper
+	ctx := suite.hubChain.GetContext()
+
+	stateInfoIdx := rollapptypes.StateInfoIndex{RollappId: suite.rollappChain.ChainID, Index: 2}
+	rollappKeeper.SetStateInfo(ctx, rollapptypes.StateInfo{
+		StateInfoIndex: stateInfoIdx,
+		StartHeight:    height,
+		NumBlocks:      1,
+		Status:         status,
+		BDs:            rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{{Height: height, StateRoot: stateRoot}}},
+	})
+	rollappKeeper.SetLatestStateInfoIndex(ctx, stateInfoIdx)
+}
+
+// validateClientUpdate runs the client update decorator over a MsgUpdateClient of the given header
+func (suite *KeeperTestSuite) validateClientUpdate(clientID string, header *ibctmtypes.Header) error {
+	msg, err := clienttypes.NewMsgUpdateClient(clientID, header, suite.hubChain.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	txBuilder := suite.hubChain.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msg))
+
+	decorator := rollapp.NewClientUpdateDecorator(ConvertToApp(suite.hubChain).RollappKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	_, err = decorator.AnteHandle(suite.hubChain.GetContext(), txBuilder.GetTx(), false, next)
+	return err
+}
+
+// Updating the canonical client of a rollapp with headers checked against the posted state roots
+func (suite *KeeperTestSuite) TestClientUpdate_StateRoot() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	suite.CreateRollapp(path)
+
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	header, err := suite.hubChain.ConstructUpdateTMClientHeader(suite.rollappChain, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	height := uint64(header.Header.Height)
+
+	// no state posted for the header height yet
+	err = suite.validateClientUpdate(path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+
+	// the posted state root doesn't match the header app hash
+	suite.postStateRoot(height, []byte("wrong state root"), rollapptypes.STATE_STATUS_RECEIVED)
+	err = suite.validateClientUpdate(path.EndpointA.ClientID, header)
+	suite.Require().ErrorIs(err, rollapptypes.ErrInvalidAppHash)
+
+	// reverted states are not checked against
+	suite.postStateRoot(height, []byte("wrong state root"), rollapptypes.STATE_STATUS_REVERTED)
+	err = suite.validateClientUpdate(path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+
+	// the posted state root matches the header app hash
+	suite.postStateRoot(height, header.Header.AppHash, rollapptypes.STATE_STATUS_RECEIVED)
+	err = suite.validateClientUpdate(path.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.hubChain.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+	_, err = suite.hubChain.SendMsgs(msg)
+	suite.Require().NoError(err)
+}
+
+// Updating a client tracking the rollapp chain-id which is not its canonical client isn't checked
+func (suite *KeeperTestSuite) TestClientUpdate_NonCanonicalClient() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	suite.CreateRollapp(path)
+
+	otherPath := ibctesting.NewPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.SetupClients(otherPath)
+
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	header, err := suite.hubChain.ConstructUpdateTMClientHeader(suite.rollappChain, otherPath.EndpointA.ClientID)
+	suite.Require().NoError(err)
+
+	suite.postStateRoot(uint64(header.Header.Height), []byte("wrong state root"), rollapptypes.STATE_STATUS_RECEIVED)
+	err = suite.validateClientUpdate(otherPath.EndpointA.ClientID, header)
+	suite.Require().NoError(err)
+	err = suite.validateClientUpdate(path.EndpointA.ClientID, header)
+	suite.Require().ErrorIs(err, rollapptypes.ErrInvalidAppHash)
+}
+
+// Updating the canonical client of a rollapp through authz, which bypasses the ante handler
+func (suite *KeeperTestSuite) TestClientUpdate_AuthzExec() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	suite.CreateRollapp(path)
+
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	header, err := suite.hubChain.ConstructUpdateTMClientHeader(suite.rollappChain, path.EndpointA.ClientID)
+	suite.Require().NoError(err)
+	suite.postStateRoot(uint64(header.Header.Height), []byte("wrong state root"), rollapptypes.STATE_STATUS_RECEIVED)
+
+	sender := suite.hubChain.SenderAccount.GetAddress()
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, sender.String())
+	suite.Require().NoError(err)
+	execMsg := authz.NewMsgExec(sender, []sdk.Msg{msg})
+
+	hubApp := ConvertToApp(suite.hubChain)
+	handler := hubApp.MsgServiceRouter().Handler(&execMsg)
+	_, err = handler(suite.hubChain.GetContext(), &execMsg)
+	suite.Require().ErrorIs(err, rollapptypes.ErrInvalidAppHash)
+}
+
+// Posting a rollapp state whose state roots don't match the headers its canonical client already got
+func (suite *KeeperTestSuite) TestUpdateState_ClientConsensusStateMismatch() {
+	path := suite.NewTransferPath(suite.hubChain, suite.rollappChain)
+	suite.coordinator.Setup(path)
+	suite.CreateRollapp(path)
+
+	hubApp := ConvertToApp(suite.hubChain)
+	rollappID := suite.rollappChain.ChainID
+	proposer := suite.hubChain.SenderAccount.GetAddress().String()
+	ctx := suite.hubChain.GetContext()
+	hubApp.SequencerKeeper.SetSequencer(ctx, sequencertypes.Sequencer{SequencerAddress: proposer, RollappIDs: []string{rollappID}, Status: sequencertypes.Bonded})
+	hubApp.SequencerKeeper.SetScheduler(ctx, sequencertypes.Scheduler{SequencerAddress: proposer, Status: sequencertypes.Proposer})
+
+	// the client is updated before the states of its heights are posted
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	firstHeight := path.EndpointA.GetClientState().GetLatestHeight().GetRevisionHeight()
+	firstAppHash := path.EndpointA.GetConsensusState(path.EndpointA.GetClientState().GetLatestHeight()).GetRoot().GetHash()
+	suite.coordinator.CommitBlock(suite.rollappChain)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	secondHeight := path.EndpointA.GetClientState().GetLatestHeight().GetRevisionHeight()
+
+	ctx = suite.hubChain.GetContext()
+	stateInfoIdx := rollapptypes.StateInfoIndex{RollappId: rollappID, Index: 1}
+	hubApp.RollappKeeper.SetStateInfo(ctx, rollapptypes.StateInfo{StateInfoIndex: stateInfoIdx, StartHeight: 1, NumBlocks: firstHeight - 1})
+	hubApp.RollappKeeper.SetLatestStateInfoIndex(ctx, stateInfoIdx)
+	msgServer := rollappkeeper.NewMsgServerImpl(hubApp.RollappKeeper)
+	// posts the blocks from startHeight up to height, only the last one having a state root
+	updateState := func(startHeight, height uint64, stateRoot []byte) {
+		bds := &rollapptypes.BlockDescriptors{BD: []rollapptypes.BlockDescriptor{{Height: height, StateRoot: stateRoot}}}
+		_, err := msgServer.UpdateState(sdk.WrapSDKContext(suite.hubChain.GetContext()), rollapptypes.NewMsgUpdateState(proposer, rollappID, startHeight, height-startHeight+1, "", 0, bds))
+		suite.Require().NoError(err)
+		suite.coordinator.CommitBlock(suite.hubChain)
+	}
+
+	// the posted state root matches the consensus state
+	updateState(firstHeight, firstHeight, firstAppHash)
+	rollapp, found := hubApp.RollappKeeper.GetRollapp(suite.hubChain.GetContext(), rollappID)
+	suite.Require().True(found)
+	suite.Require().False(rollapp.Frozen)
+
+	// the posted state root doesn't match the consensus state, the rollapp is frozen
+	updateState(firstHeight+1, secondHeight, []byte("wrong state root"))
+	rollapp, found = hubApp.RollappKeeper.GetRollapp(suite.hubChain.GetContext(), rollappID)
+	suite.Require().True(found)
+	suite.Require().True(rollapp.Frozen)
+}
@@ -5,9 +5,13 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibctesting "github.com/cosmos/ibc-go/v6/testing"
 	app "github.com/dymensionxyz/dymension/app"
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
 	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -55,7 +59,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.rollappChain = suite.coordinator.GetChain(ibctesting.GetChainID(3)) // convenience and readability
 }
 
-func (suite *KeeperTestSuite) CreateRollapp() {
+func (suite *KeeperTestSuite) CreateRollapp(path *ibctesting.Path) {
 	msgCreateRollapp := rollapptypes.NewMsgCreateRollapp(
 		suite.hubChain.SenderAccount.GetAddress().String(),
 		suite.rollappChain.ChainID,
@@ -81,9 +85,11 @@ func (suite *KeeperTestSuite) CreateRollapp() {
 	rollappKeeper.SetStateInfo(ctx, stateInfo)
 	// uppdate the LatestStateInfoIndex of the rollapp
 	rollappKeeper.SetLatestFinalizedStateIndex(ctx, stateInfoIdx)
+
+	suite.BindRollapp(path)
 }
 
-func (suite *KeeperTestSuite) CreateRollappWithMetadata(denom string) {
+func (suite *KeeperTestSuite) CreateRollappWithMetadata(path *ibctesting.Path, denom string) {
 	msgCreateRollapp := rollapptypes.NewMsgCreateRollapp(
 		suite.hubChain.SenderAccount.GetAddress().String(),
 		suite.rollappChain.ChainID,
@@ -111,6 +117,19 @@ func (suite *KeeperTestSuite) CreateRollappWithMetadata(denom string) {
 	)
 	_, err := suite.hubChain.SendMsgs(msgCreateRollapp)
 	suite.Require().NoError(err) // message committed
+	suite.BindRollapp(path)
+}
+
+// BindRollapp binds the rollapp to the hub client of the path
+func (suite *KeeperTestSuite) BindRollapp(path *ibctesting.Path) {
+	msgSetCanonicalClient := rollapptypes.NewMsgSetCanonicalClient(
+		suite.hubChain.SenderAccount.GetAddress().String(),
+		suite.rollappChain.ChainID,
+		path.EndpointA.ClientID,
+		nil,
+	)
+	_, err := suite.hubChain.SendMsgs(msgSetCanonicalClient)
+	suite.Require().NoError(err) // message committed
 }
 
 func (suite *KeeperTestSuite) FinalizeRollapp() error {
@@ -138,6 +157,73 @@ func (suite *KeeperTestSuite) FinalizeRollapp() error {
 	return err
 }
 
+// FinalizeRollappUntil finalizes a rollapp state following the latest finalized one, up to the given height
+func (suite *KeeperTestSuite) FinalizeRollappUntil(endHeight uint64) error {
+	rollappKeeper := ConvertToApp(suite.hubChain).RollappKeeper
+	ctx := suite.hubChain.GetContext()
+
+	latestFinalizedStateIdx, found := rollappKeeper.GetLatestFinalizedStateIndex(ctx, suite.rollappChain.ChainID)
+	suite.Require().True(found)
+	latestFinalizedState, found := rollappKeeper.GetStateInfo(ctx, suite.rollappChain.ChainID, latestFinalizedStateIdx.Index)
+	suite.Require().True(found)
+
+	startHeight := latestFinalizedState.StartHeight + latestFinalizedState.NumBlocks
+	suite.Require().GreaterOrEqual(endHeight, startHeight)
+	stateInfoIdx := rollapptypes.StateInfoIndex{RollappId: suite.rollappChain.ChainID, Index: latestFinalizedStateIdx.Index + 1}
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: stateInfoIdx,
+		StartHeight:    startHeight,
+		NumBlocks:      endHeight - startHeight + 1,
+		Status:         rollapptypes.STATE_STATUS_FINALIZED,
+	}
+
+	rollappKeeper.SetStateInfo(ctx, stateInfo)
+	rollappKeeper.SetLatestFinalizedStateIndex(ctx, stateInfoIdx)
+
+	return rollappKeeper.GetHooks().AfterStateFinalized(ctx, suite.rollappChain.ChainID, &stateInfo)
+}
+
+// RevertRollapp reverts the rollapp states following the latest finalized one
+func (suite *KeeperTestSuite) RevertRollapp() error {
+	rollappKeeper := ConvertToApp(suite.hubChain).RollappKeeper
+	ctx := suite.hubChain.GetContext()
+
+	latestFinalizedStateIdx, found := rollappKeeper.GetLatestFinalizedStateIndex(ctx, suite.rollappChain.ChainID)
+	suite.Require().True(found)
+	latestFinalizedState, found := rollappKeeper.GetStateInfo(ctx, suite.rollappChain.ChainID, latestFinalizedStateIdx.Index)
+	suite.Require().True(found)
+
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: suite.rollappChain.ChainID, Index: latestFinalizedStateIdx.Index + 1},
+		StartHeight:    latestFinalizedState.StartHeight + latestFinalizedState.NumBlocks,
+		NumBlocks:      10,
+		Status:         rollapptypes.STATE_STATUS_REVERTED,
+	}
+
+	return rollappKeeper.GetHooks().AfterStatesReverted(
+		ctx,
+		suite.rollappChain.ChainID,
+		[]rollapptypes.StateInfo{stateInfo},
+	)
+}
+
+// FreezeRollapp freezes the rollapp through governance
+func (suite *KeeperTestSuite) FreezeRollapp() {
+	msgServer := rollappkeeper.NewMsgServerImpl(ConvertToApp(suite.hubChain).RollappKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := msgServer.FreezeRollapp(sdk.WrapSDKContext(suite.hubChain.GetContext()), rollapptypes.NewMsgFreezeRollapp(authority, suite.rollappChain.ChainID))
+	suite.Require().NoError(err)
+}
+
+// DeregisterRollapp freezes and deregisters the rollapp through governance
+func (suite *KeeperTestSuite) DeregisterRollapp() {
+	suite.FreezeRollapp()
+	msgServer := rollappkeeper.NewMsgServerImpl(ConvertToApp(suite.hubChain).RollappKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err := msgServer.DeregisterRollapp(sdk.WrapSDKContext(suite.hubChain.GetContext()), rollapptypes.NewMsgDeregisterRollapp(authority, suite.rollappChain.ChainID))
+	suite.Require().NoError(err)
+}
+
 func (suite *KeeperTestSuite) NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
 	path := ibctesting.NewPath(chainA, chainB)
 	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
@@ -10,6 +10,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
@@ -72,6 +73,12 @@ func (ConnectionKeeperStub) GetConnection(ctx sdk.Context, connectionID string)
 	return connectiontypes.ConnectionEnd{}, false
 }
 
+type BankKeeperStub struct{}
+
+func (BankKeeperStub) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+
 type RollappKeeperStub struct{}
 
 func (RollappKeeperStub) GetParams(ctx sdk.Context) rollapptypes.Params {
@@ -82,6 +89,10 @@ func (RollappKeeperStub) GetRollapp(ctx sdk.Context, chainID string) (rollapptyp
 	return rollapptypes.Rollapp{}, false
 }
 
+func (RollappKeeperStub) GetRollappByPortChannel(ctx sdk.Context, portID string, channelID string) (rollapptypes.Rollapp, bool, error) {
+	return rollapptypes.Rollapp{}, false, nil
+}
+
 func (RollappKeeperStub) StateInfo(c context.Context, req *rollapptypes.QueryGetStateInfoRequest) (*rollapptypes.QueryGetStateInfoResponse, error) {
 	return nil, nil
 }
@@ -89,26 +100,37 @@ func (RollappKeeperStub) StateInfo(c context.Context, req *rollapptypes.QueryGet
 func DelayedackKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(types.TransientStoreKey)
 
 	db := tmdb.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db)
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
+	paramsSubspace := typesparams.NewSubspace(cdc,
+		codec.NewLegacyAmino(),
+		storeKey,
+		memStoreKey,
+		"DelayedAckParams",
+	)
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
+		tStoreKey,
+		paramsSubspace,
 
 		RollappKeeperStub{},
 		ICS4WrapperStub{},
 		ChannelKeeperStub{},
 		ClientKeeperStub{},
 		ConnectionKeeperStub{},
+		BankKeeperStub{},
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -0,0 +1,35 @@
+package delayedack
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// ProofHeightDecorator records the proof heights of the relayed IBC packets, which are not
+// passed down to the IBC application callbacks
+type ProofHeightDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewProofHeightDecorator(keeper keeper.Keeper) ProofHeightDecorator {
+	return ProofHeightDecorator{keeper: keeper}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (d ProofHeightDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		switch msg := m.(type) {
+		case *channeltypes.MsgRecvPacket:
+			d.keeper.SetPacketProofHeight(ctx, types.RollappPacket_ON_RECV, msg.Packet.DestinationPort, msg.Packet.DestinationChannel, msg.Packet.Sequence, msg.ProofHeight)
+		case *channeltypes.MsgAcknowledgement:
+			d.keeper.SetPacketProofHeight(ctx, types.RollappPacket_ON_ACK, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Packet.Sequence, msg.ProofHeight)
+		case *channeltypes.MsgTimeout:
+			d.keeper.SetPacketProofHeight(ctx, types.RollappPacket_ON_TIMEOUT, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Packet.Sequence, msg.ProofHeight)
+		case *channeltypes.MsgTimeoutOnClose:
+			d.keeper.SetPacketProofHeight(ctx, types.RollappPacket_ON_TIMEOUT, msg.Packet.SourcePort, msg.Packet.SourceChannel, msg.Packet.Sequence, msg.ProofHeight)
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string) *cobra.Command {
+	// Group delayedack queries under a subcommand
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(CmdListRollappPackets())
+	cmd.AddCommand(CmdShowRollappPacket())
+	cmd.AddCommand(CmdPendingPacketsSummary())
+	cmd.AddCommand(CmdListDemandOrders())
+	cmd.AddCommand(CmdShowDemandOrder())
+
+	return cmd
+}
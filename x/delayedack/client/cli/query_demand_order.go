@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/spf13/cobra"
+)
+
+const FlagRollapp = "rollapp"
+
+func CmdListDemandOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-demand-orders",
+		Short: "list the demand orders by the status of their tracked packets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			rollappId, err := cmd.Flags().GetString(FlagRollapp)
+			if err != nil {
+				return err
+			}
+			argStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := parseStatus(argStatus)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryDemandOrdersRequest{
+				RollappId:  rollappId,
+				Status:     status,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DemandOrders(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagRollapp, "", "rollapp of the orders")
+	cmd.Flags().String(FlagStatus, "pending", "status of the tracked packets (pending|accepted|rejected)")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDemandOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-demand-order [order-id]",
+		Short: "shows a demand order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetDemandOrderRequest{
+				Id: args[0],
+			}
+
+			res, err := queryClient.DemandOrder(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagStatus         = "status"
+	FlagChannel        = "channel"
+	FlagMinProofHeight = "min-proof-height"
+	FlagMaxProofHeight = "max-proof-height"
+	FlagType           = "type"
+)
+
+func CmdListRollappPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-rollapp-packets [rollapp-id]",
+		Short: "list the delayed packets of a rollapp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			argStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			status, err := parseStatus(argStatus)
+			if err != nil {
+				return err
+			}
+			channel, err := cmd.Flags().GetString(FlagChannel)
+			if err != nil {
+				return err
+			}
+			minProofHeight, err := cmd.Flags().GetUint64(FlagMinProofHeight)
+			if err != nil {
+				return err
+			}
+			maxProofHeight, err := cmd.Flags().GetUint64(FlagMaxProofHeight)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRollappPacketsRequest{
+				RollappId:      args[0],
+				Status:         status,
+				ChannelId:      channel,
+				MinProofHeight: minProofHeight,
+				MaxProofHeight: maxProofHeight,
+				Pagination:     pageReq,
+			}
+
+			res, err := queryClient.RollappPackets(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "pending", "status of the packets (pending|accepted|rejected)")
+	cmd.Flags().String(FlagChannel, "", "hub channel of the packets")
+	cmd.Flags().Uint64(FlagMinProofHeight, 0, "minimal proof height of the packets")
+	cmd.Flags().Uint64(FlagMaxProofHeight, 0, "maximal proof height of the packets")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRollappPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rollapp-packet [channel-id] [sequence]",
+		Short: "shows a delayed packet by its hub channel and sequence",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			argSequence, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argType, err := cmd.Flags().GetString(FlagType)
+			if err != nil {
+				return err
+			}
+			packetType, err := parseType(argType)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetRollappPacketRequest{
+				ChannelId: args[0],
+				Sequence:  argSequence,
+				Type:      packetType,
+			}
+
+			res, err := queryClient.RollappPacket(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagType, "recv", "IBC callback the packet is delayed for (recv|ack|timeout)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingPacketsSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-summary [rollapp-id]",
+		Short: "shows the pending packet counts and amounts of a rollapp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingPacketsSummaryRequest{
+				RollappId: args[0],
+			}
+
+			res, err := queryClient.PendingPacketsSummary(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseStatus(s string) (types.RollappPacket_Status, error) {
+	status, ok := types.RollappPacket_Status_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("invalid packet status: %s", s)
+	}
+	return types.RollappPacket_Status(status), nil
+}
+
+func parseType(s string) (types.RollappPacket_Type, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "ON_") {
+		name = "ON_" + name
+	}
+	packetType, ok := types.RollappPacket_Type_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid packet type: %s", s)
+	}
+	return types.RollappPacket_Type(packetType), nil
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdFulfillOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order [order-id]",
+		Short:   "Fulfill a demand order by paying its recipient ahead of the rollapp finalization",
+		Example: "dymd tx delayedack fulfill-order ORDER_ID",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFulfillOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
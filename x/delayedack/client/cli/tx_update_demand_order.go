@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdUpdateDemandOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-demand-order [order-id] [new-fee]",
+		Short:   "Update the fee of an unfulfilled demand order",
+		Example: "dymd tx delayedack update-demand-order ORDER_ID 100",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateDemandOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -8,11 +8,24 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
-	//FIXME: should we set the rollapp packets in store?
+	k.SetParams(ctx, genState.Params)
+	for _, rollappPacket := range genState.RollappPackets {
+		k.SetRollappPacket(ctx, rollappPacket.RollappId, rollappPacket)
+		// Packets are always stored as pending, and moved to their status key once processed
+		if rollappPacket.Status != types.RollappPacket_PENDING {
+			k.UpdateRollappPacketStatus(ctx, rollappPacket.RollappId, rollappPacket, rollappPacket.Status)
+		}
+	}
+	for _, demandOrder := range genState.DemandOrders {
+		k.SetDemandOrder(ctx, demandOrder)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.RollappPackets = k.GetAllRollappPackets(ctx)
+	genesis.DemandOrders = k.GetAllDemandOrder(ctx)
 	return genesis
 }
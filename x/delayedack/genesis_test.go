@@ -0,0 +1,65 @@
+package delayedack_test
+
+import (
+	"math"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/dymensionxyz/dymension/x/delayedack"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	packet := func(sequence uint64) *channeltypes.Packet {
+		p := channeltypes.NewPacket([]byte("data"), sequence, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+		return &p
+	}
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		RollappPackets: []types.RollappPacket{
+			{RollappId: "rollapp_1234-1", Packet: packet(1), Status: types.RollappPacket_PENDING, ProofHeight: 1},
+			{RollappId: "rollapp_1234-1", Packet: packet(2), Status: types.RollappPacket_ACCEPTED, ProofHeight: 2},
+			{RollappId: "rollapp_1234-1", Packet: packet(3), Status: types.RollappPacket_REJECTED, ProofHeight: 3, Error: "error"},
+			{RollappId: "rollapp_1234-1", Packet: packet(1), Status: types.RollappPacket_PENDING, ProofHeight: 4, Type: types.RollappPacket_ON_ACK, Acknowledgement: []byte("ack")},
+			{RollappId: "rollapp_5678-1", Packet: packet(1), Status: types.RollappPacket_PENDING, ProofHeight: 5, Type: types.RollappPacket_ON_TIMEOUT},
+		},
+		DemandOrders: []types.DemandOrder{
+			{
+				Id:                   types.BuildDemandOrderID([]byte("key1")),
+				TrackingPacketKey:    "key1",
+				RollappId:            "rollapp_1234-1",
+				Price:                sdk.NewInt64Coin("adym", 90),
+				Fee:                  sdk.NewInt64Coin("adym", 10),
+				Recipient:            sample.AccAddress(),
+				Fulfiller:            sample.AccAddress(),
+				TrackingPacketStatus: types.RollappPacket_PENDING,
+			},
+			{
+				Id:                   types.BuildDemandOrderID([]byte("key2")),
+				TrackingPacketKey:    "key2",
+				RollappId:            "rollapp_5678-1",
+				Price:                sdk.NewInt64Coin("adym", 90),
+				Fee:                  sdk.NewInt64Coin("adym", 10),
+				Recipient:            sample.AccAddress(),
+				TrackingPacketStatus: types.RollappPacket_ACCEPTED,
+			},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.DelayedackKeeper(t)
+	delayedack.InitGenesis(ctx, *k, genesisState)
+	got := delayedack.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+
+	require.Equal(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.RollappPackets, got.RollappPackets)
+	require.ElementsMatch(t, genesisState.DemandOrders, got.DemandOrders)
+	require.Len(t, k.ListRollappPendingPackets(ctx, "rollapp_1234-1", math.MaxUint64), 2)
+	require.Len(t, k.ListRollappPendingPackets(ctx, "rollapp_5678-1", math.MaxUint64), 1)
+}
@@ -1,7 +1,12 @@
 package delayedack
 
 import (
+	"math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	"github.com/dymensionxyz/dymension/x/delayedack/types"
 	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
 )
@@ -12,6 +17,40 @@ func (im IBCMiddleware) BeforeUpdateState(ctx sdk.Context, seqAddr string, rolla
 	return nil
 }
 
+// AfterStatesReverted implements the RollappHooks interface
+func (im IBCMiddleware) AfterStatesReverted(ctx sdk.Context, rollappID string, stateInfos []rollapptypes.StateInfo) error {
+	if len(stateInfos) == 0 {
+		return nil
+	}
+	// Reject the packets proven from the first reverted height onwards
+	rejectErr := sdkerrors.Wrapf(types.ErrRollappStateReverted, "rollappID %s, height %d", rollappID, stateInfos[0].StartHeight)
+	im.RejectRollappPackets(ctx, rollappID, stateInfos[0].StartHeight, rejectErr)
+	return nil
+}
+
+// AfterRollappRegistered implements the RollappHooks interface
+func (im IBCMiddleware) AfterRollappRegistered(ctx sdk.Context, rollappID string) error {
+	return nil
+}
+
+// AfterRollappFrozen implements the RollappHooks interface
+func (im IBCMiddleware) AfterRollappFrozen(ctx sdk.Context, rollappID string) error {
+	// None of the pending packets will be finalized, reject them all so the senders get refunded
+	rejectErr := sdkerrors.Wrapf(types.ErrRollappFrozen, "rollappID %s", rollappID)
+	im.RejectRollappPackets(ctx, rollappID, 0, rejectErr)
+	return nil
+}
+
+// AfterRollappDeregistered implements the RollappHooks interface
+func (im IBCMiddleware) AfterRollappDeregistered(ctx sdk.Context, rollappID string) error {
+	return nil
+}
+
+// AfterSequencerPermissionRevoked implements the RollappHooks interface
+func (im IBCMiddleware) AfterSequencerPermissionRevoked(ctx sdk.Context, rollappID string, seqAddr string) error {
+	return nil
+}
+
 // AfterStateFinalized implements the RollappHooks interface
 func (im IBCMiddleware) AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *rollapptypes.StateInfo) error {
 	// Finalize the packets for the rollapp at the given height
@@ -32,24 +71,162 @@ func (im IBCMiddleware) FinalizeRollappPackets(ctx sdk.Context, rollappID string
 	// Get the packets for the rollapp until height
 	logger.Debug("Finalizing IBC rollapp packets", "rollappID", rollappID, "state end height", stateEndHeight, "num packets", len(rollappPendingPackets))
 	for _, rollappPacket := range rollappPendingPackets {
-		logger.Debug("Finalizing IBC rollapp packet", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel())
+		logger.Debug("Finalizing IBC rollapp packet", "rollappID", rollappID, "type", rollappPacket.Type, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel())
+		switch rollappPacket.Type {
+		case types.RollappPacket_ON_RECV:
+			im.finalizeRecvPacket(ctx, rollappID, rollappPacket)
+		case types.RollappPacket_ON_ACK, types.RollappPacket_ON_TIMEOUT:
+			im.finalizeAckOrTimeoutPacket(ctx, rollappID, rollappPacket)
+		default:
+			logger.Error("Unknown rollapp packet type", "rollappID", rollappID, "type", rollappPacket.Type)
+		}
+	}
+}
+
+// finalizeRecvPacket calls the onRecvPacket callback of the underlying app and writes its acknowledgement.
+// The callback runs in a cached context whose state changes are kept only if the packet is acknowledged
+// successfully, as IBC core does.
+// The transfer of a fulfilled demand order is released to its fulfiller. The recipient was already paid by
+// the fulfiller, so if the transfer fails no error acknowledgement is written, which would refund the sender.
+func (im IBCMiddleware) finalizeRecvPacket(ctx sdk.Context, rollappID string, rollappPacket types.RollappPacket) {
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+	packet := *rollappPacket.Packet
+	demandOrder, found := im.keeper.GetDemandOrderOfPacket(ctx, rollappPacket)
+	fulfilled := found && demandOrder.IsFulfilled()
+	if fulfilled {
+		var data transfertypes.FungibleTokenPacketData
+		if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+			logger.Error("Error unmarshalling packet data", "rollappID", rollappID, "sequence", packet.GetSequence(), "error", err.Error())
+			rollappPacket.Error = err.Error()
+			im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_REJECTED)
+			im.keeper.UpdateDemandOrderStatus(ctx, demandOrder, types.RollappPacket_REJECTED)
+			return
+		}
+		data.Receiver = demandOrder.Fulfiller
+		packet.Data = data.GetBytes()
+	}
+	// Call the onRecvPacket callback for each packet
+	cacheCtx, writeCache := ctx.CacheContext()
+	ack := im.app.OnRecvPacket(cacheCtx, packet, rollappPacket.Relayer)
+	newStatus := types.RollappPacket_ACCEPTED
+	if ack == nil || ack.Success() {
+		writeCache()
+	} else {
+		newStatus = types.RollappPacket_REJECTED
+		if errAck, ok := ack.(channeltypes.Acknowledgement); ok {
+			rollappPacket.Error = errAck.GetError()
+		}
+	}
+	// Update the packet status
+	im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, newStatus)
+	if found {
+		im.keeper.UpdateDemandOrderStatus(ctx, demandOrder, newStatus)
+	}
+	if fulfilled && newStatus == types.RollappPacket_REJECTED {
+		logger.Error("Fulfilled IBC rollapp packet failed, the sender is not refunded", "rollappID", rollappID, "sequence", packet.GetSequence(), "error", rollappPacket.Error)
+		return
+	}
+	// Write the acknowledgement to the chain only if it is synchronous
+	if ack != nil {
+		_, chanCap, err := im.keeper.LookupModuleByChannel(ctx, rollappPacket.Packet.DestinationPort, rollappPacket.Packet.DestinationChannel)
+		if err != nil {
+			logger.Error("Error looking up module by channel", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
+			return
+		}
+		err = im.keeper.WriteAcknowledgement(ctx, chanCap, rollappPacket.Packet, ack)
+		if err != nil {
+			logger.Error("Error writing acknowledgement", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
+			return
+		}
+	}
+}
+
+// finalizeAckOrTimeoutPacket calls the onAcknowledgementPacket or onTimeoutPacket callback of the underlying app.
+// The callback runs in a cached context so a failing callback doesn't leave partial state changes behind.
+// IBC core already deleted the packet commitment, so a failed packet is kept pending and retried on the
+// next finalization instead of being dropped with the tokens it escrows.
+func (im IBCMiddleware) finalizeAckOrTimeoutPacket(ctx sdk.Context, rollappID string, rollappPacket types.RollappPacket) {
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+	cacheCtx, writeCache := ctx.CacheContext()
+	var err error
+	if rollappPacket.Type == types.RollappPacket_ON_ACK {
+		err = im.app.OnAcknowledgementPacket(cacheCtx, *rollappPacket.Packet, rollappPacket.Acknowledgement, rollappPacket.Relayer)
+	} else {
+		err = im.app.OnTimeoutPacket(cacheCtx, *rollappPacket.Packet, rollappPacket.Relayer)
+	}
+	if err != nil {
+		logger.Error("Error finalizing IBC rollapp packet", "rollappID", rollappID, "type", rollappPacket.Type, "sequence", rollappPacket.Packet.GetSequence(), "source channel", rollappPacket.Packet.GetSourceChannel(), "error", err.Error())
+		rollappPacket.Error = err.Error()
+		im.keeper.SetRollappPacket(ctx, rollappID, rollappPacket)
+		return
+	}
+	writeCache()
+	im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_ACCEPTED)
+}
+
+// refundAckOrTimeoutPacket rejects an acknowledgement or timeout which can't be trusted. The packet
+// commitment is already deleted by IBC core, so the sender is refunded as if the packet failed on the
+// rollapp: the underlying app gets an error acknowledgement carrying the reject error.
+func (im IBCMiddleware) refundAckOrTimeoutPacket(ctx sdk.Context, rollappID string, rollappPacket types.RollappPacket, rejectErr error) {
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+	cacheCtx, writeCache := ctx.CacheContext()
+	errAck := channeltypes.NewErrorAcknowledgement(rejectErr)
+	if err := im.app.OnAcknowledgementPacket(cacheCtx, *rollappPacket.Packet, errAck.Acknowledgement(), rollappPacket.Relayer); err != nil {
+		logger.Error("Error refunding IBC rollapp packet", "rollappID", rollappID, "type", rollappPacket.Type, "sequence", rollappPacket.Packet.GetSequence(), "source channel", rollappPacket.Packet.GetSourceChannel(), "error", err.Error())
+		rollappPacket.Error = sdkerrors.Wrap(rejectErr, err.Error()).Error()
+		im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_REJECTED)
+		return
+	}
+	writeCache()
+	rollappPacket.Error = rejectErr.Error()
+	im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_REJECTED)
+}
+
+// RejectRollappPackets rejects the pending packets for the given rollapp whose proof height is equal or
+// above the given height, i.e. proven against a reverted rollapp state. Packets above the reverted states
+// are rejected as well, as they were proven against the same untrusted chain. The reject error is
+// recorded on the packets and sent back in the error acknowledgements.
+// An error acknowledgement is written for each received packet so the sender gets refunded, unless its
+// demand order was fulfilled. Delayed acknowledgements and timeouts can't be trusted either, the hub
+// senders are refunded instead.
+func (im IBCMiddleware) RejectRollappPackets(ctx sdk.Context, rollappID string, fromHeight uint64, rejectErr error) {
+	rollappPendingPackets := im.keeper.ListRollappPendingPackets(ctx, rollappID, math.MaxUint64)
+	if len(rollappPendingPackets) == 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+	logger.Debug("Rejecting IBC rollapp packets", "rollappID", rollappID, "from height", fromHeight, "num packets", len(rollappPendingPackets))
+	for _, rollappPacket := range rollappPendingPackets {
+		if rollappPacket.ProofHeight < fromHeight {
+			continue
+		}
+		logger.Debug("Rejecting IBC rollapp packet", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel())
+		if rollappPacket.Type != types.RollappPacket_ON_RECV {
+			im.refundAckOrTimeoutPacket(ctx, rollappID, rollappPacket, rejectErr)
+			continue
+		}
 		// Update the packet status
-		im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_ACCEPTED)
-		// Call the onRecvPacket callback for each packet
-		ack := im.app.OnRecvPacket(ctx, *rollappPacket.Packet, rollappPacket.Relayer)
-		// Write the acknowledgement to the chain only if it is synchronous
-		if ack != nil {
-			_, chanCap, err := im.keeper.LookupModuleByChannel(ctx, rollappPacket.Packet.DestinationPort, rollappPacket.Packet.DestinationChannel)
-			if err != nil {
-				logger.Error("Error looking up module by channel", "rollappID", rollappID, "error", "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
-				continue
-			}
-			err = im.keeper.WriteAcknowledgement(ctx, chanCap, rollappPacket.Packet, ack)
-			if err != nil {
-				logger.Error("Error writing acknowledgement", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
+		rollappPacket.Error = rejectErr.Error()
+		im.keeper.UpdateRollappPacketStatus(ctx, rollappID, rollappPacket, types.RollappPacket_REJECTED)
+		// A fulfilled demand order is not paid back, the fulfiller took the risk of the rollapp being reverted.
+		// The recipient was already paid by the fulfiller, so the sender is not refunded either
+		if demandOrder, found := im.keeper.GetDemandOrderOfPacket(ctx, rollappPacket); found {
+			im.keeper.UpdateDemandOrderStatus(ctx, demandOrder, types.RollappPacket_REJECTED)
+			if demandOrder.IsFulfilled() {
 				continue
 			}
-
+		}
+		// Write an error acknowledgement so the sender gets refunded
+		_, chanCap, err := im.keeper.LookupModuleByChannel(ctx, rollappPacket.Packet.DestinationPort, rollappPacket.Packet.DestinationChannel)
+		if err != nil {
+			logger.Error("Error looking up module by channel", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
+			continue
+		}
+		err = im.keeper.WriteAcknowledgement(ctx, chanCap, rollappPacket.Packet, channeltypes.NewErrorAcknowledgement(rejectErr))
+		if err != nil {
+			logger.Error("Error writing acknowledgement", "rollappID", rollappID, "sequence", rollappPacket.Packet.GetSequence(), "destination channel", rollappPacket.Packet.GetDestChannel(), "error", err.Error())
+			continue
 		}
 	}
 }
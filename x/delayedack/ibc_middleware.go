@@ -2,6 +2,7 @@ package delayedack
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	keeper "github.com/dymensionxyz/dymension/x/delayedack/keeper"
 	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
 )
 
 var _ porttypes.Middleware = &IBCMiddleware{}
@@ -117,63 +119,231 @@ func (im IBCMiddleware) OnRecvPacket(
 	}
 
 	// Check if the packet is destined for a rollapp
-	chainID, err := im.keeper.ExtractChainIDFromChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	chainID, err := im.keeper.ExtractRollappIDFromChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
 	if err != nil {
-		logger.Error("Failed to extract chain id from channel", "err", err)
+		logger.Error("Failed to extract rollapp id from channel", "err", err)
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	_, found := im.keeper.GetRollapp(ctx, chainID)
-	if !found {
+	if chainID == "" {
 		logger.Debug("Skipping IBC transfer OnRecvPacket for non-rollapp chain")
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	// Get the light client height at this block height as a proxy for the packet proof height
-	clientState, err := im.keeper.GetClientState(ctx, packet)
-	if err != nil {
+	// Packets from a frozen rollapp can't be trusted anymore
+	if err := im.checkRollappNotFrozen(ctx, chainID); err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
-	// TODO(omritoptix): Currently we use this height as the proofHeight as the real proofHeight from the ibc lower stack is not available.
-	// using this height is secured but may cause extra delay as at best it will be equal to the proof height (but could be higher).
-	ibcClientLatestHeight := clientState.GetLatestHeight()
-	finalizedHeight, err := im.keeper.GetRollappFinalizedHeight(ctx, chainID)
-	if err == nil && finalizedHeight >= ibcClientLatestHeight.GetRevisionHeight() {
+	proofHeight, finalized, err := im.getProofHeight(ctx, chainID, types.RollappPacket_ON_RECV, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if finalized {
 		logger.Debug("Skipping IBC transfer OnRecvPacket as the packet proof height is already finalized")
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	// Save the packet data to the store for later processing
 	rollappPacket := types.RollappPacket{
+		RollappId:   chainID,
 		Packet:      &packet,
 		Status:      types.RollappPacket_PENDING,
 		Relayer:     relayer,
-		ProofHeight: ibcClientLatestHeight.GetRevisionHeight(),
+		ProofHeight: proofHeight,
+		Type:        types.RollappPacket_ON_RECV,
+	}
+
+	// Let liquidity providers fulfill the transfer before finalization, if requested by the memo
+	if err := im.keeper.CreateDemandOrder(ctx, rollappPacket, data); err != nil {
+		logger.Error("Failed to create demand order", "err", err)
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
+
+	// Save the packet data to the store for later processing
 	im.keeper.SetRollappPacket(ctx, chainID, rollappPacket)
 
 	return nil
 }
 
-// OnAcknowledgementPacket implements the IBCMiddleware interface
+// OnAcknowledgementPacket handles the acknowledgement of a packet sent to a rollapp and puts it into a pending queue
+// until its state is finalized
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if !im.keeper.IsRollappsEnabled(ctx) {
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+
+	// Check if the packet was sent to a rollapp
+	chainID, err := im.keeper.ExtractRollappIDFromChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if sdkerrors.IsOf(err, rollapptypes.ErrRollappDeregistered) {
+		logger.Debug("Refunding IBC transfer OnAcknowledgementPacket for deregistered rollapp", "err", err)
+		return im.refundUntrustedAcknowledgement(ctx, packet, acknowledgement, relayer, err)
+	}
+	// The packet was sent before the channel was bound to the rollapp, or before the channel was dropped
+	// from its binding, so it is settled like the packets of any other chain
+	if sdkerrors.IsOf(err, rollapptypes.ErrRollappChannelNotBound) {
+		logger.Debug("Skipping IBC transfer OnAcknowledgementPacket for channel not bound to the rollapp", "err", err)
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+	if err != nil {
+		logger.Error("Failed to extract rollapp id from channel", "err", err)
+		return err
+	}
+
+	if chainID == "" {
+		logger.Debug("Skipping IBC transfer OnAcknowledgementPacket for non-rollapp chain")
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	// The acknowledgement of a frozen rollapp can't be trusted anymore, the sender is refunded
+	if err := im.checkRollappNotFrozen(ctx, chainID); err != nil {
+		logger.Debug("Refunding IBC transfer OnAcknowledgementPacket for frozen rollapp", "rollappID", chainID)
+		return im.refundUntrustedAcknowledgement(ctx, packet, acknowledgement, relayer, err)
+	}
+
+	proofHeight, finalized, err := im.getProofHeight(ctx, chainID, types.RollappPacket_ON_ACK, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if err != nil {
+		return err
+	}
+	if finalized {
+		logger.Debug("Skipping IBC transfer OnAcknowledgementPacket as the packet proof height is already finalized")
+		return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	}
+
+	// Save the packet data to the store for later processing
+	rollappPacket := types.RollappPacket{
+		Packet:          &packet,
+		Acknowledgement: acknowledgement,
+		Status:          types.RollappPacket_PENDING,
+		Relayer:         relayer,
+		ProofHeight:     proofHeight,
+		Type:            types.RollappPacket_ON_ACK,
+	}
+	im.keeper.SetRollappPacket(ctx, chainID, rollappPacket)
+
+	return nil
 }
 
-// OnTimeoutPacket implements the IBCMiddleware interface
+// OnTimeoutPacket handles the timeout of a packet sent to a rollapp and puts it into a pending queue
+// until its state is finalized
 func (im IBCMiddleware) OnTimeoutPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// call underlying callback
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	if !im.keeper.IsRollappsEnabled(ctx) {
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	logger := ctx.Logger().With("module", "DelayedAckMiddleware")
+
+	// Check if the packet was sent to a rollapp
+	chainID, err := im.keeper.ExtractRollappIDFromChannel(ctx, packet.SourcePort, packet.SourceChannel)
+	if sdkerrors.IsOf(err, rollapptypes.ErrRollappDeregistered) {
+		logger.Debug("Refunding IBC transfer OnTimeoutPacket for deregistered rollapp", "err", err)
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+	// The packet was sent before the channel was bound to the rollapp, or before the channel was dropped
+	// from its binding, so it is settled like the packets of any other chain
+	if sdkerrors.IsOf(err, rollapptypes.ErrRollappChannelNotBound) {
+		logger.Debug("Skipping IBC transfer OnTimeoutPacket for channel not bound to the rollapp", "err", err)
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		logger.Error("Failed to extract rollapp id from channel", "err", err)
+		return err
+	}
+
+	if chainID == "" {
+		logger.Debug("Skipping IBC transfer OnTimeoutPacket for non-rollapp chain")
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	// A frozen rollapp won't finalize the timeout anymore, the sender is refunded right away
+	if err := im.checkRollappNotFrozen(ctx, chainID); err != nil {
+		logger.Debug("Refunding IBC transfer OnTimeoutPacket for frozen rollapp", "rollappID", chainID)
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	proofHeight, finalized, err := im.getProofHeight(ctx, chainID, types.RollappPacket_ON_TIMEOUT, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if err != nil {
+		return err
+	}
+	if finalized {
+		logger.Debug("Skipping IBC transfer OnTimeoutPacket as the packet proof height is already finalized")
+		return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	}
+
+	// Save the packet data to the store for later processing
+	rollappPacket := types.RollappPacket{
+		Packet:      &packet,
+		Status:      types.RollappPacket_PENDING,
+		Relayer:     relayer,
+		ProofHeight: proofHeight,
+		Type:        types.RollappPacket_ON_TIMEOUT,
+	}
+	im.keeper.SetRollappPacket(ctx, chainID, rollappPacket)
+
+	return nil
+}
+
+// checkRollappNotFrozen returns an error if the rollapp was frozen by governance
+func (im IBCMiddleware) checkRollappNotFrozen(ctx sdk.Context, chainID string) error {
+	rollapp, found := im.keeper.GetRollapp(ctx, chainID)
+	if found && rollapp.Frozen {
+		return sdkerrors.Wrapf(types.ErrRollappFrozen, "rollappID %s", chainID)
+	}
+	return nil
+}
+
+// refundUntrustedAcknowledgement refunds the sender of a packet acknowledged by a frozen or deregistered rollapp.
+// An error acknowledgement is passed through to the underlying app as is, while a successful one can't be
+// trusted and is replaced by an error acknowledgement carrying the reject error, as for the pending packets
+// rejected when the rollapp is frozen.
+func (im IBCMiddleware) refundUntrustedAcknowledgement(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+	rejectErr error,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		acknowledgement = channeltypes.NewErrorAcknowledgement(rejectErr).Acknowledgement()
+	}
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// getProofHeight returns the rollapp height the packet is proven against, and whether this height is already finalized.
+// The proof height is recorded by the ProofHeightDecorator. If it is missing, e.g. for packets relayed through
+// authz, the latest height of the light client is used instead, which is secure but may cause extra delay.
+func (im IBCMiddleware) getProofHeight(
+	ctx sdk.Context,
+	chainID string,
+	packetType types.RollappPacket_Type,
+	portID string,
+	channelID string,
+	sequence uint64,
+) (uint64, bool, error) {
+	proofHeight, found := im.keeper.GetPacketProofHeight(ctx, packetType, portID, channelID, sequence)
+	if !found {
+		clientState, err := im.keeper.GetClientState(ctx, portID, channelID)
+		if err != nil {
+			return 0, false, err
+		}
+		proofHeight = clientState.GetLatestHeight().GetRevisionHeight()
+	}
+
+	finalizedHeight, err := im.keeper.GetRollappFinalizedHeight(ctx, chainID)
+	finalized := err == nil && finalizedHeight >= proofHeight
+
+	return proofHeight, finalized, nil
 }
 
 /* ------------------------------- ICS4Wrapper ------------------------------ */
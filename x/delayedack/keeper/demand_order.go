@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// SetDemandOrder set a specific demandOrder in the store from its id, and indexes it by rollapp and status
+func (k Keeper) SetDemandOrder(ctx sdk.Context, demandOrder types.DemandOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderKeyPrefix))
+	b := k.cdc.MustMarshal(&demandOrder)
+	store.Set(types.DemandOrderKey(demandOrder.Id), b)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderIndexKeyPrefix))
+	indexStore.Set(types.DemandOrderIndexKey(demandOrder.RollappId, demandOrder.TrackingPacketStatus, demandOrder.Id), []byte{})
+}
+
+// GetDemandOrder returns a demandOrder from its id
+func (k Keeper) GetDemandOrder(ctx sdk.Context, id string) (val types.DemandOrder, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderKeyPrefix))
+
+	b := store.Get(types.DemandOrderKey(id))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// UpdateDemandOrderStatus updates the tracking packet status of a demandOrder and moves its index accordingly
+func (k Keeper) UpdateDemandOrderStatus(ctx sdk.Context, demandOrder types.DemandOrder, newStatus types.RollappPacket_Status) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderIndexKeyPrefix))
+	indexStore.Delete(types.DemandOrderIndexKey(demandOrder.RollappId, demandOrder.TrackingPacketStatus, demandOrder.Id))
+
+	demandOrder.TrackingPacketStatus = newStatus
+	k.SetDemandOrder(ctx, demandOrder)
+}
+
+// GetAllDemandOrder returns all demandOrder
+func (k Keeper) GetAllDemandOrder(ctx sdk.Context) (list []types.DemandOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DemandOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
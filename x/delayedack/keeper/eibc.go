@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// CreateDemandOrder creates a demand order for a pending rollapp packet, if the memo of the transfer requests one.
+// It returns an error if the eIBC part of the memo is invalid.
+func (k Keeper) CreateDemandOrder(ctx sdk.Context, rollappPacket types.RollappPacket, data transfertypes.FungibleTokenPacketData) error {
+	fee, found, err := types.ParseEIBCFee(data.Memo)
+	if err != nil || !found {
+		return err
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
+	}
+	if fee.GT(amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidEIBCMemo, "fee %s is greater than the transfer amount %s", fee, amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(data.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+
+	denom := hubDenom(*rollappPacket.Packet, data.Denom)
+	trackingPacketKey := types.GetRollappPacketKey(
+		rollappPacket.RollappId,
+		types.RollappPacket_PENDING,
+		rollappPacket.ProofHeight,
+		rollappPacket.Type,
+		*rollappPacket.Packet,
+	)
+	demandOrder := types.DemandOrder{
+		Id:                   types.BuildDemandOrderID(trackingPacketKey),
+		TrackingPacketKey:    string(trackingPacketKey),
+		RollappId:            rollappPacket.RollappId,
+		Price:                sdk.NewCoin(denom, amount.Sub(fee)),
+		Fee:                  sdk.NewCoin(denom, fee),
+		Recipient:            data.Receiver,
+		TrackingPacketStatus: types.RollappPacket_PENDING,
+	}
+	k.SetDemandOrder(ctx, demandOrder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDemandOrderCreated,
+			sdk.NewAttribute(types.AttributeKeyOrderId, demandOrder.Id),
+			sdk.NewAttribute(types.AttributeKeyRollappId, demandOrder.RollappId),
+			sdk.NewAttribute(types.AttributeKeyPrice, demandOrder.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, demandOrder.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, demandOrder.Recipient),
+		),
+	)
+
+	return nil
+}
+
+// GetDemandOrderOfPacket returns the demand order of a rollapp packet, if any
+func (k Keeper) GetDemandOrderOfPacket(ctx sdk.Context, rollappPacket types.RollappPacket) (types.DemandOrder, bool) {
+	trackingPacketKey := types.GetRollappPacketKey(
+		rollappPacket.RollappId,
+		types.RollappPacket_PENDING,
+		rollappPacket.ProofHeight,
+		rollappPacket.Type,
+		*rollappPacket.Packet,
+	)
+	return k.GetDemandOrder(ctx, types.BuildDemandOrderID(trackingPacketKey))
+}
+
+// hubDenom returns the denom the transfer app credits on the hub for a received packet, following ICS20
+func hubDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		// the tokens are coming back to the hub, so the denom is unprefixed
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := denom[len(voucherPrefix):]
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
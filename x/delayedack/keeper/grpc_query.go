@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+var _ types.QueryServer = Keeper{}
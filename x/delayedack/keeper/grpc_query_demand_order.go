@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) DemandOrder(c context.Context, req *types.QueryGetDemandOrderRequest) (*types.QueryGetDemandOrderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetDemandOrder(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetDemandOrderResponse{DemandOrder: val}, nil
+}
+
+func (k Keeper) DemandOrders(c context.Context, req *types.QueryDemandOrdersRequest) (*types.QueryDemandOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DemandOrderIndexKeyPrefix))
+	if req.RollappId != "" {
+		// The index is keyed by rollapp and status, so only the orders of the requested ones are iterated
+		indexStore = prefix.NewStore(indexStore, []byte(req.RollappId+"/"+fmt.Sprint(req.Status)+"/"))
+	}
+
+	var demandOrders []types.DemandOrder
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		// The key is either id/ or, when iterating over all rollapps, rollappId/status/id/
+		rest, id := splitLast(strings.TrimSuffix(string(key), "/"))
+		if req.RollappId == "" && !strings.HasSuffix(rest, "/"+fmt.Sprint(req.Status)) {
+			return false, nil
+		}
+		demandOrder, found := k.GetDemandOrder(ctx, id)
+		if !found {
+			return false, fmt.Errorf("demand order %s not found", id)
+		}
+		if accumulate {
+			demandOrders = append(demandOrders, demandOrder)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDemandOrdersResponse{DemandOrders: demandOrders, Pagination: pageRes}, nil
+}
+
+// splitLast splits s around its last slash
+func splitLast(s string) (string, string) {
+	i := strings.LastIndex(s, "/")
+	if i < 0 {
+		return "", s
+	}
+	return s[:i], s[i+1:]
+}
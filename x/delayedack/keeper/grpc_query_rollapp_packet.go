@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) RollappPackets(c context.Context, req *types.QueryRollappPacketsRequest) (*types.QueryRollappPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id must be set")
+	}
+	if req.MaxProofHeight != 0 && req.MaxProofHeight < req.MinProofHeight {
+		return nil, status.Error(codes.InvalidArgument, "max proof height is lower than min proof height")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The packets are keyed by rollapp and status, so only the packets of the requested status are iterated
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	statusStore := prefix.NewStore(store, []byte(req.RollappId+"/"+fmt.Sprint(req.Status)+"/"))
+
+	var rollappPackets []types.RollappPacket
+	pageRes, err := query.FilteredPaginate(statusStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var rollappPacket types.RollappPacket
+		if err := k.cdc.Unmarshal(value, &rollappPacket); err != nil {
+			return false, err
+		}
+		if req.ChannelId != "" && rollappPacket.HubChannel() != req.ChannelId {
+			return false, nil
+		}
+		if rollappPacket.ProofHeight < req.MinProofHeight {
+			return false, nil
+		}
+		if req.MaxProofHeight != 0 && rollappPacket.ProofHeight > req.MaxProofHeight {
+			return false, nil
+		}
+		if accumulate {
+			rollappPackets = append(rollappPackets, rollappPacket)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRollappPacketsResponse{RollappPackets: rollappPackets, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RollappPacket(c context.Context, req *types.QueryGetRollappPacketRequest) (*types.QueryGetRollappPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Only the transfer stack is wrapped by the delayedack middleware
+	rollappID, err := k.ExtractRollappIDFromChannel(ctx, transfertypes.PortID, req.ChannelId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if rollappID == "" {
+		return nil, status.Error(codes.NotFound, "channel is not connected to a rollapp")
+	}
+
+	rollappPacket, found := k.GetRollappPacket(ctx, rollappID, req.Type, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetRollappPacketResponse{
+		RollappId:     rollappID,
+		RollappPacket: rollappPacket,
+	}, nil
+}
+
+func (k Keeper) PendingPacketsSummary(c context.Context, req *types.QueryPendingPacketsSummaryRequest) (*types.QueryPendingPacketsSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id must be set")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	summaries := []types.PendingPacketsSummary{
+		{Type: types.RollappPacket_ON_RECV},
+		{Type: types.RollappPacket_ON_ACK},
+		{Type: types.RollappPacket_ON_TIMEOUT},
+	}
+	// The pending packets are summed up while iterated, without loading them all
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte(req.RollappId+"/"+fmt.Sprint(types.RollappPacket_PENDING)+"/"))
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rollappPacket types.RollappPacket
+		if err := k.cdc.Unmarshal(iterator.Value(), &rollappPacket); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if int(rollappPacket.Type) >= len(summaries) || rollappPacket.Packet == nil {
+			continue
+		}
+		summary := &summaries[rollappPacket.Type]
+		summary.Count++
+		if amount, ok := transferAmount(rollappPacket); ok {
+			summary.Amount = summary.Amount.Add(amount)
+		}
+	}
+
+	return &types.QueryPendingPacketsSummaryResponse{
+		RollappId: req.RollappId,
+		Summaries: summaries,
+	}, nil
+}
+
+// transferAmount returns the amount transferred by a fungible token packet, as denominated by the sender chain
+func transferAmount(rollappPacket types.RollappPacket) (sdk.Coin, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(rollappPacket.Packet.GetData(), &data); err != nil {
+		return sdk.Coin{}, false
+	}
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, false
+	}
+	coin := sdk.Coin{Denom: data.Denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, false
+	}
+	return coin, true
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func createRollappPackets(packetType types.RollappPacket_Type, n int) []types.RollappPacket {
+	packets := make([]types.RollappPacket, n)
+	for i := range packets {
+		data := transfertypes.NewFungibleTokenPacketData("adym", "100", "sender", "receiver", "")
+		packets[i] = types.RollappPacket{
+			Packet: &channeltypes.Packet{
+				SourcePort:         "transfer",
+				SourceChannel:      "channel-0",
+				DestinationPort:    "transfer",
+				DestinationChannel: "channel-1",
+				Data:               data.GetBytes(),
+				Sequence:           uint64(i + 1),
+			},
+			Status:      types.RollappPacket_PENDING,
+			ProofHeight: uint64((i + 1) * 2),
+			Type:        packetType,
+		}
+	}
+	return packets
+}
+
+func TestRollappPacketsQuery(t *testing.T) {
+	keeper, ctx := keepertest.DelayedackKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	rollappID := "testRollappID"
+
+	recvPackets := createRollappPackets(types.RollappPacket_ON_RECV, 5)
+	ackPackets := createRollappPackets(types.RollappPacket_ON_ACK, 2)
+	for _, packet := range append(recvPackets, ackPackets...) {
+		keeper.SetRollappPacket(ctx, rollappID, packet)
+	}
+	keeper.SetRollappPacket(ctx, "otherRollappID", recvPackets[0])
+	keeper.UpdateRollappPacketStatus(ctx, rollappID, recvPackets[0], types.RollappPacket_ACCEPTED)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryRollappPacketsRequest
+		expected int
+		err      error
+	}{
+		{
+			desc:     "Pending",
+			request:  &types.QueryRollappPacketsRequest{RollappId: rollappID},
+			expected: 6,
+		},
+		{
+			desc:     "Accepted",
+			request:  &types.QueryRollappPacketsRequest{RollappId: rollappID, Status: types.RollappPacket_ACCEPTED},
+			expected: 1,
+		},
+		{
+			desc:     "Channel",
+			request:  &types.QueryRollappPacketsRequest{RollappId: rollappID, ChannelId: "channel-0"},
+			expected: 2,
+		},
+		{
+			desc:     "ProofHeightRange",
+			request:  &types.QueryRollappPacketsRequest{RollappId: rollappID, MinProofHeight: 4, MaxProofHeight: 6},
+			expected: 3,
+		},
+		{
+			desc:     "Paginated",
+			request:  &types.QueryRollappPacketsRequest{RollappId: rollappID, Pagination: &query.PageRequest{Limit: 2}},
+			expected: 2,
+		},
+		{
+			desc:    "InvalidHeightRange",
+			request: &types.QueryRollappPacketsRequest{RollappId: rollappID, MinProofHeight: 6, MaxProofHeight: 4},
+			err:     status.Error(codes.InvalidArgument, "max proof height is lower than min proof height"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.RollappPackets(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, response.RollappPackets, tc.expected)
+			if tc.request.ChannelId != "" {
+				for _, packet := range response.RollappPackets {
+					require.Equal(t, tc.request.ChannelId, packet.HubChannel())
+				}
+			}
+		})
+	}
+}
+
+func TestPendingPacketsSummaryQuery(t *testing.T) {
+	keeper, ctx := keepertest.DelayedackKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	rollappID := "testRollappID"
+
+	recvPackets := createRollappPackets(types.RollappPacket_ON_RECV, 3)
+	timeoutPackets := createRollappPackets(types.RollappPacket_ON_TIMEOUT, 1)
+	for _, packet := range append(recvPackets, timeoutPackets...) {
+		keeper.SetRollappPacket(ctx, rollappID, packet)
+	}
+	keeper.UpdateRollappPacketStatus(ctx, rollappID, recvPackets[0], types.RollappPacket_ACCEPTED)
+	// the packets of a rollapp whose id starts with the queried one are not summed up
+	keeper.SetRollappPacket(ctx, rollappID+"2", recvPackets[1])
+
+	response, err := keeper.PendingPacketsSummary(wctx, &types.QueryPendingPacketsSummaryRequest{RollappId: rollappID})
+	require.NoError(t, err)
+	require.Equal(t, rollappID, response.RollappId)
+	require.Equal(t, []types.PendingPacketsSummary{
+		{Type: types.RollappPacket_ON_RECV, Count: 2, Amount: sdk.NewCoins(sdk.NewInt64Coin("adym", 200))},
+		{Type: types.RollappPacket_ON_ACK},
+		{Type: types.RollappPacket_ON_TIMEOUT, Count: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin("adym", 100))},
+	}, response.Summaries)
+
+	_, err = keeper.PendingPacketsSummary(wctx, &types.QueryPendingPacketsSummaryRequest{})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "rollapp id must be set"))
+}
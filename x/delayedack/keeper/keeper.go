@@ -8,12 +8,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
-	ibctypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 	"github.com/dymensionxyz/dymension/x/delayedack/types"
 	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -21,15 +21,18 @@ import (
 
 type (
 	Keeper struct {
-		cdc      codec.BinaryCodec
-		storeKey storetypes.StoreKey
-		memKey   storetypes.StoreKey
+		cdc        codec.BinaryCodec
+		storeKey   storetypes.StoreKey
+		memKey     storetypes.StoreKey
+		tStoreKey  storetypes.StoreKey
+		paramstore paramtypes.Subspace
 
 		rollappKeeper    types.RollappKeeper
 		ics4Wrapper      porttypes.ICS4Wrapper
 		channelKeeper    types.ChannelKeeper
 		connectionKeeper types.ConnectionKeeper
 		clientKeeper     types.ClientKeeper
+		bankKeeper       types.BankKeeper
 	}
 )
 
@@ -37,23 +40,34 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
 
 	rollappKeeper types.RollappKeeper,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
 	connectionKeeper types.ConnectionKeeper,
 	clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper,
 
 ) *Keeper {
+	// set KeyTable if it has not already been set
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return &Keeper{
 		cdc:              cdc,
 		storeKey:         storeKey,
 		memKey:           memKey,
+		tStoreKey:        tStoreKey,
+		paramstore:       ps,
 		rollappKeeper:    rollappKeeper,
 		ics4Wrapper:      ics4Wrapper,
 		channelKeeper:    channelKeeper,
 		clientKeeper:     clientKeeper,
 		connectionKeeper: connectionKeeper,
+		bankKeeper:       bankKeeper,
 	}
 }
 
@@ -61,18 +75,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) ExtractChainIDFromChannel(ctx sdk.Context, portID string, channelID string) (string, error) {
-	_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
-	if err != nil {
-		return "", fmt.Errorf("failed to extract clientID from channel: %w", err)
-	}
-
-	tmClientState, ok := clientState.(*ibctypes.ClientState)
-	if !ok {
-		return "", nil
+// ExtractRollappIDFromChannel returns the rollapp a hub channel is bound to, or an empty string if the channel
+// doesn't run over the canonical client of a rollapp.
+// It fails if the channel leads to a registered rollapp without being bound to it.
+func (k Keeper) ExtractRollappIDFromChannel(ctx sdk.Context, portID string, channelID string) (string, error) {
+	rollapp, found, err := k.rollappKeeper.GetRollappByPortChannel(ctx, portID, channelID)
+	if err != nil || !found {
+		return "", err
 	}
-
-	return tmClientState.ChainId, nil
+	return rollapp.RollappId, nil
 }
 
 func (k Keeper) IsRollappsEnabled(ctx sdk.Context) bool {
@@ -95,11 +106,11 @@ func (k Keeper) GetRollappFinalizedHeight(ctx sdk.Context, chainID string) (uint
 	return (res.StateInfo.StartHeight + res.StateInfo.NumBlocks - 1), nil
 }
 
-// GetClientState retrieves the client state for a given packet.
-func (k Keeper) GetClientState(ctx sdk.Context, packet channeltypes.Packet) (exported.ClientState, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+// GetClientState retrieves the client state of the counterparty of a given hub channel.
+func (k Keeper) GetClientState(ctx sdk.Context, portID string, channelID string) (exported.ClientState, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
 	if !found {
-		return nil, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, packet.SourceChannel)
+		return nil, sdkerrors.Wrap(channeltypes.ErrChannelNotFound, channelID)
 	}
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
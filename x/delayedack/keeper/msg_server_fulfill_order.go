@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// FulfillOrder defines a method for paying the recipient of a demand order in exchange for its transfer
+func (k msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrder) (*types.MsgFulfillOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	demandOrder, found := k.GetDemandOrder(ctx, msg.OrderId)
+	if !found {
+		return nil, types.ErrDemandOrderNotFound
+	}
+	if demandOrder.TrackingPacketStatus != types.RollappPacket_PENDING {
+		return nil, types.ErrDemandOrderInactive
+	}
+	if demandOrder.IsFulfilled() {
+		return nil, types.ErrDemandOrderAlreadyFulfilled
+	}
+
+	fulfiller, err := sdk.AccAddressFromBech32(msg.Fulfiller)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(demandOrder.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, fulfiller, recipient, sdk.NewCoins(demandOrder.Price)); err != nil {
+		return nil, err
+	}
+
+	// the transfer is released to the fulfiller once the rollapp packet is finalized
+	demandOrder.Fulfiller = msg.Fulfiller
+	k.SetDemandOrder(ctx, demandOrder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDemandOrderFulfilled,
+			sdk.NewAttribute(types.AttributeKeyOrderId, demandOrder.Id),
+			sdk.NewAttribute(types.AttributeKeyRollappId, demandOrder.RollappId),
+			sdk.NewAttribute(types.AttributeKeyPrice, demandOrder.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, demandOrder.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFulfiller, demandOrder.Fulfiller),
+		),
+	)
+
+	return &types.MsgFulfillOrderResponse{}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// UpdateDemandOrder defines a method for the recipient of a demand order to update its fee
+func (k msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdateDemandOrder) (*types.MsgUpdateDemandOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	demandOrder, found := k.GetDemandOrder(ctx, msg.OrderId)
+	if !found {
+		return nil, types.ErrDemandOrderNotFound
+	}
+	if msg.Recipient != demandOrder.Recipient {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can update the demand order")
+	}
+	if demandOrder.TrackingPacketStatus != types.RollappPacket_PENDING {
+		return nil, types.ErrDemandOrderInactive
+	}
+	if demandOrder.IsFulfilled() {
+		return nil, types.ErrDemandOrderAlreadyFulfilled
+	}
+
+	newFee, err := msg.GetNewFeeInt()
+	if err != nil {
+		return nil, err
+	}
+	// the transfer amount is kept, so raising the fee lowers the price
+	amount := demandOrder.Price.Amount.Add(demandOrder.Fee.Amount)
+	if newFee.GT(amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidFee, "fee %s is greater than the transfer amount %s", newFee, amount)
+	}
+	demandOrder.Fee.Amount = newFee
+	demandOrder.Price.Amount = amount.Sub(newFee)
+	k.SetDemandOrder(ctx, demandOrder)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDemandOrderFeeUpdated,
+			sdk.NewAttribute(types.AttributeKeyOrderId, demandOrder.Id),
+			sdk.NewAttribute(types.AttributeKeyPrice, demandOrder.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, demandOrder.Fee.String()),
+		),
+	)
+
+	return &types.MsgUpdateDemandOrderResponse{}, nil
+}
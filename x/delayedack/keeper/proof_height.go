@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// SetPacketProofHeight records the height a relayed packet was proven against in the transient store,
+// so it is available to the IBC middleware when the transaction is executed.
+// The height is bound to the hash of the transaction, as the record outlives the transaction if it fails.
+func (k Keeper) SetPacketProofHeight(
+	ctx sdk.Context,
+	packetType types.RollappPacket_Type,
+	portID string,
+	channelID string,
+	sequence uint64,
+	proofHeight clienttypes.Height,
+) {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), types.KeyPrefix(types.ProofHeightKeyPrefix))
+	value := append(sdk.Uint64ToBigEndian(proofHeight.GetRevisionHeight()), tmhash.Sum(ctx.TxBytes())...)
+	store.Set(types.GetProofHeightKey(packetType, portID, channelID, sequence), value)
+}
+
+// GetPacketProofHeight returns the height a packet relayed in the current transaction was proven against
+func (k Keeper) GetPacketProofHeight(
+	ctx sdk.Context,
+	packetType types.RollappPacket_Type,
+	portID string,
+	channelID string,
+	sequence uint64,
+) (uint64, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.tStoreKey), types.KeyPrefix(types.ProofHeightKeyPrefix))
+	b := store.Get(types.GetProofHeightKey(packetType, portID, channelID, sequence))
+	if len(b) <= 8 || !bytes.Equal(b[8:], tmhash.Sum(ctx.TxBytes())) {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(b[:8]), true
+}
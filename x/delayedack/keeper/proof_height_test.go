@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPacketProofHeight(t *testing.T) {
+	keeper, ctx := keepertest.DelayedackKeeper(t)
+	txCtx := ctx.WithTxBytes([]byte("tx"))
+
+	keeper.SetPacketProofHeight(txCtx, types.RollappPacket_ON_RECV, "transfer", "channel-0", 1, clienttypes.NewHeight(1, 10))
+
+	proofHeight, found := keeper.GetPacketProofHeight(txCtx, types.RollappPacket_ON_RECV, "transfer", "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, uint64(10), proofHeight)
+
+	// the proof height is recorded per packet and callback
+	_, found = keeper.GetPacketProofHeight(txCtx, types.RollappPacket_ON_ACK, "transfer", "channel-0", 1)
+	require.False(t, found)
+	_, found = keeper.GetPacketProofHeight(txCtx, types.RollappPacket_ON_RECV, "transfer", "channel-0", 2)
+	require.False(t, found)
+
+	// the proof height recorded by another transaction is ignored
+	_, found = keeper.GetPacketProofHeight(ctx.WithTxBytes([]byte("other tx")), types.RollappPacket_ON_RECV, "transfer", "channel-0", 1)
+	require.False(t, found)
+}
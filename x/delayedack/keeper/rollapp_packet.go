@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,13 +17,17 @@ func (k Keeper) SetRollappPacket(ctx sdk.Context, rollappID string, rollappPacke
 	logger.Debug("Saving rollapp packet", "rollappID", rollappID, "channel", rollappPacket.Packet.DestinationChannel,
 		"sequence", rollappPacket.Packet.Sequence, "proofHeight", rollappPacket.ProofHeight)
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	rollappPacket.RollappId = rollappID
 	b := k.cdc.MustMarshal(&rollappPacket)
-	store.Set(types.GetRollappPacketKey(
+	key := types.GetRollappPacketKey(
 		rollappID,
 		types.RollappPacket_PENDING,
 		rollappPacket.ProofHeight,
+		rollappPacket.Type,
 		*rollappPacket.Packet,
-	), b)
+	)
+	store.Set(key, b)
+	k.setRollappPacketIndex(ctx, rollappPacket, key)
 }
 
 // UpdateRollappPacketStatus deletes the current rollapp packet and creates a new one with and updated status under a new key.
@@ -31,16 +36,53 @@ func (k Keeper) UpdateRollappPacketStatus(ctx sdk.Context, rollappID string, rol
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
 
 	// Delete the old rollapp packet
-	oldKey := types.GetRollappPacketKey(rollappID, types.RollappPacket_PENDING, rollappPacket.ProofHeight, *rollappPacket.Packet)
+	oldKey := types.GetRollappPacketKey(rollappID, types.RollappPacket_PENDING, rollappPacket.ProofHeight, rollappPacket.Type, *rollappPacket.Packet)
 	store.Delete(oldKey)
 
 	// Update the packet
+	rollappPacket.RollappId = rollappID
 	rollappPacket.Status = newStatus
 
 	// Create a new rollapp packet with the updated status
-	newKey := types.GetRollappPacketKey(rollappID, newStatus, rollappPacket.ProofHeight, *rollappPacket.Packet)
+	newKey := types.GetRollappPacketKey(rollappID, newStatus, rollappPacket.ProofHeight, rollappPacket.Type, *rollappPacket.Packet)
 	b := k.cdc.MustMarshal(&rollappPacket)
 	store.Set(newKey, b)
+	k.setRollappPacketIndex(ctx, rollappPacket, newKey)
+}
+
+// setRollappPacketIndex indexes the store key of a rollapp packet by its hub channel and sequence
+func (k Keeper) setRollappPacketIndex(ctx sdk.Context, rollappPacket types.RollappPacket, key []byte) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketIndexKeyPrefix))
+	indexStore.Set(types.RollappPacketIndexKey(
+		rollappPacket.RollappId,
+		rollappPacket.Type,
+		rollappPacket.HubChannel(),
+		rollappPacket.Packet.Sequence,
+	), key)
+}
+
+// GetRollappPacket returns the rollapp packet of the given type, identified by its hub channel and sequence
+func (k Keeper) GetRollappPacket(
+	ctx sdk.Context,
+	rollappID string,
+	packetType types.RollappPacket_Type,
+	hubChannel string,
+	sequence uint64,
+) (val types.RollappPacket, found bool) {
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketIndexKeyPrefix))
+	key := indexStore.Get(types.RollappPacketIndexKey(rollappID, packetType, hubChannel, sequence))
+	if key == nil {
+		return val, false
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
 }
 
 // ListRollappPendingPackets retrieves a list of pending rollapp packets from the KVStore.
@@ -80,3 +122,22 @@ func (k Keeper) ListRollappPendingPackets(
 
 	return list
 }
+
+// GetAllRollappPackets returns the rollapp packets of all rollapps, in every status.
+func (k Keeper) GetAllRollappPackets(ctx sdk.Context) (list []types.RollappPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RollappPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		// Packets stored before the rollapp ID was part of the packet only have it in their key
+		if val.RollappId == "" {
+			val.RollappId = strings.SplitN(string(iterator.Key()), "/", 2)[0]
+		}
+		list = append(list, val)
+	}
+
+	return list
+}
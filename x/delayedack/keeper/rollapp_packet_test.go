@@ -43,3 +43,31 @@ func TestListRollappPacketsForRollappAtHeight(t *testing.T) {
 	packets = keeper.ListRollappPendingPackets(ctx, rollappID, 14)
 	require.Equal(t, 2, len(packets))
 }
+
+func TestGetRollappPacket(t *testing.T) {
+	keeper, ctx := keepertest.DelayedackKeeper(t)
+	rollappID := "testRollappID"
+
+	recvPackets := createRollappPackets(types.RollappPacket_ON_RECV, 3)
+	ackPackets := createRollappPackets(types.RollappPacket_ON_ACK, 1)
+	for _, packet := range append(recvPackets, ackPackets...) {
+		keeper.SetRollappPacket(ctx, rollappID, packet)
+	}
+
+	// the packet is found by its hub channel and sequence, whatever its status
+	keeper.UpdateRollappPacketStatus(ctx, rollappID, recvPackets[1], types.RollappPacket_ACCEPTED)
+	packet, found := keeper.GetRollappPacket(ctx, rollappID, types.RollappPacket_ON_RECV, "channel-1", 2)
+	require.True(t, found)
+	require.Equal(t, types.RollappPacket_ACCEPTED, packet.Status)
+	require.Equal(t, recvPackets[1].Packet, packet.Packet)
+
+	// acks are looked up by their source channel
+	packet, found = keeper.GetRollappPacket(ctx, rollappID, types.RollappPacket_ON_ACK, "channel-0", 1)
+	require.True(t, found)
+	require.Equal(t, types.RollappPacket_ON_ACK, packet.Type)
+
+	_, found = keeper.GetRollappPacket(ctx, rollappID, types.RollappPacket_ON_ACK, "channel-0", 2)
+	require.False(t, found)
+	_, found = keeper.GetRollappPacket(ctx, "otherRollappID", types.RollappPacket_ON_RECV, "channel-1", 2)
+	require.False(t, found)
+}
@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The rollapp packets were keyed by the decimal string of their sequence, which doesn't keep the packets
+// of a proof height ordered. They are re-keyed by their zero padded sequence, and indexed by their hub
+// channel and sequence, as the packets stored before v2 have no index.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RollappPacketIndexKeyPrefix))
+
+	var keys [][]byte
+	var packets []types.RollappPacket
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.RollappPacket
+		if err := cdc.Unmarshal(iterator.Value(), &packet); err != nil {
+			iterator.Close() // nolint: errcheck
+			return err
+		}
+		// packets stored before the rollapp ID was part of the packet only have it in their key
+		if packet.RollappId == "" {
+			packet.RollappId = strings.SplitN(string(iterator.Key()), "/", 2)[0]
+		}
+		keys = append(keys, iterator.Key())
+		packets = append(packets, packet)
+	}
+	iterator.Close() // nolint: errcheck
+
+	var indexKeys [][]byte
+	indexIterator := indexStore.Iterator(nil, nil)
+	for ; indexIterator.Valid(); indexIterator.Next() {
+		indexKeys = append(indexKeys, indexIterator.Key())
+	}
+	indexIterator.Close() // nolint: errcheck
+	for _, key := range indexKeys {
+		indexStore.Delete(key)
+	}
+
+	for i := range packets {
+		if packets[i].Packet == nil {
+			continue
+		}
+		key := types.GetRollappPacketKey(
+			packets[i].RollappId,
+			packets[i].Status,
+			packets[i].ProofHeight,
+			packets[i].Type,
+			*packets[i].Packet,
+		)
+		store.Delete(keys[i])
+		store.Set(key, cdc.MustMarshal(&packets[i]))
+		indexStore.Set(types.RollappPacketIndexKey(
+			packets[i].RollappId,
+			packets[i].Type,
+			packets[i].HubChannel(),
+			packets[i].Packet.Sequence,
+		), key)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package v2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/dymensionxyz/dymension/x/delayedack/migrations/v2"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+)
+
+// legacyRollappPacketKey is the v1 rollapp packet key, using the decimal string of the sequence
+func legacyRollappPacketKey(packet types.RollappPacket) []byte {
+	packetUID := fmt.Sprintf("%s-%d", packet.Packet.DestinationChannel, packet.Packet.Sequence)
+	if packet.Type != types.RollappPacket_ON_RECV {
+		packetUID = fmt.Sprintf("%s-%s", packet.Type, packetUID)
+	}
+	return []byte(fmt.Sprintf("%s/%s/%020d/%s/", packet.RollappId, packet.Status, packet.ProofHeight, packetUID))
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RollappPacketKeyPrefix))
+	indexStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.RollappPacketIndexKeyPrefix))
+
+	newPacket := func(packetType types.RollappPacket_Type, status types.RollappPacket_Status, sequence uint64) types.RollappPacket {
+		return types.RollappPacket{
+			RollappId:   "rollapp1",
+			Packet:      &channeltypes.Packet{Sequence: sequence, SourceChannel: "channel-0", DestinationChannel: "channel-1"},
+			Status:      status,
+			ProofHeight: 5,
+			Type:        packetType,
+		}
+	}
+	packets := []types.RollappPacket{
+		newPacket(types.RollappPacket_ON_RECV, types.RollappPacket_PENDING, 10),
+		newPacket(types.RollappPacket_ON_RECV, types.RollappPacket_PENDING, 2),
+		newPacket(types.RollappPacket_ON_ACK, types.RollappPacket_PENDING, 2),
+		newPacket(types.RollappPacket_ON_TIMEOUT, types.RollappPacket_ACCEPTED, 3),
+	}
+	for _, packet := range packets {
+		store.Set(legacyRollappPacketKey(packet), cdc.MustMarshal(&packet))
+	}
+	// packets stored before the rollapp ID was part of the packet
+	legacyPacket := newPacket(types.RollappPacket_ON_RECV, types.RollappPacket_ACCEPTED, 1)
+	key := legacyRollappPacketKey(legacyPacket)
+	legacyPacket.RollappId = ""
+	store.Set(key, cdc.MustMarshal(&legacyPacket))
+	legacyPacket.RollappId = "rollapp1"
+	packets = append(packets, legacyPacket)
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, cdc))
+
+	// the packets are re-keyed and indexed
+	var keys [][]byte
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+	require.Len(t, keys, len(packets))
+	for _, packet := range packets {
+		key := types.GetRollappPacketKey(packet.RollappId, packet.Status, packet.ProofHeight, packet.Type, *packet.Packet)
+		var migrated types.RollappPacket
+		require.NoError(t, cdc.Unmarshal(store.Get(key), &migrated))
+		require.Equal(t, packet, migrated)
+		require.Equal(t, key, indexStore.Get(types.RollappPacketIndexKey(packet.RollappId, packet.Type, packet.HubChannel(), packet.Packet.Sequence)))
+	}
+
+	// the pending packets received at a proof height are iterated by sequence
+	pendingPrefix := []byte(fmt.Sprintf("rollapp1/%s/", types.RollappPacket_PENDING))
+	var sequences []uint64
+	iterator = sdk.KVStorePrefixIterator(store, pendingPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.RollappPacket
+		require.NoError(t, cdc.Unmarshal(iterator.Value(), &packet))
+		if packet.Type == types.RollappPacket_ON_RECV {
+			sequences = append(sequences, packet.Packet.Sequence)
+		}
+	}
+	iterator.Close() // nolint: errcheck
+	require.Equal(t, []uint64{2, 10}, sequences)
+}
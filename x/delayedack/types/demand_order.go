@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsFulfilled returns whether a fulfiller already paid the order
+func (o DemandOrder) IsFulfilled() bool {
+	return o.Fulfiller != ""
+}
+
+// Validate performs a stateless validation of the demand order
+func (o DemandOrder) Validate() error {
+	if o.Id == "" {
+		return fmt.Errorf("demand order id cannot be empty")
+	}
+	if o.RollappId == "" {
+		return fmt.Errorf("demand order %s rollapp id cannot be empty", o.Id)
+	}
+	if o.Id != BuildDemandOrderID([]byte(o.TrackingPacketKey)) {
+		return fmt.Errorf("demand order %s doesn't match its tracking packet key", o.Id)
+	}
+	if err := o.Price.Validate(); err != nil {
+		return fmt.Errorf("invalid price of demand order %s: %w", o.Id, err)
+	}
+	if err := o.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee of demand order %s: %w", o.Id, err)
+	}
+	if o.Price.Denom != o.Fee.Denom {
+		return fmt.Errorf("demand order %s price and fee denoms differ", o.Id)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Recipient); err != nil {
+		return fmt.Errorf("invalid recipient of demand order %s: %w", o.Id, err)
+	}
+	if o.IsFulfilled() {
+		if _, err := sdk.AccAddressFromBech32(o.Fulfiller); err != nil {
+			return fmt.Errorf("invalid fulfiller of demand order %s: %w", o.Id, err)
+		}
+	}
+	if _, ok := RollappPacket_Status_name[int32(o.TrackingPacketStatus)]; !ok {
+		return fmt.Errorf("invalid tracking packet status of demand order %s: %d", o.Id, o.TrackingPacketStatus)
+	}
+	return nil
+}
@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymension/delayedack/demand_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DemandOrder is an order to receive the funds of a pending rollapp transfer right away,
+// in exchange for a fee. It is created from the eIBC fee in the memo of the transfer.
+type DemandOrder struct {
+	// id is the hash of the key of the tracked rollapp packet
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tracking_packet_key is the key of the pending rollapp packet the order was created for
+	TrackingPacketKey string `protobuf:"bytes,2,opt,name=tracking_packet_key,json=trackingPacketKey,proto3" json:"tracking_packet_key,omitempty"`
+	RollappId         string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// price is the amount the fulfiller sends to the recipient
+	Price types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	// fee is the amount the fulfiller earns once the rollapp packet is finalized
+	Fee types.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+	// recipient is the receiver of the transfer
+	Recipient string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// fulfiller is the account the transfer is released to on finalization. It is set once the order is fulfilled.
+	Fulfiller string `protobuf:"bytes,7,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// tracking_packet_status is the status of the tracked rollapp packet
+	TrackingPacketStatus RollappPacket_Status `protobuf:"varint,8,opt,name=tracking_packet_status,json=trackingPacketStatus,proto3,enum=dymensionxyz.dymension.delayedack.RollappPacket_Status" json:"tracking_packet_status,omitempty"`
+}
+
+func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
+func (m *DemandOrder) String() string { return proto.CompactTextString(m) }
+func (*DemandOrder) ProtoMessage()    {}
+func (*DemandOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a08b6a99826e0c33, []int{0}
+}
+func (m *DemandOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DemandOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DemandOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DemandOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DemandOrder.Merge(m, src)
+}
+func (m *DemandOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DemandOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DemandOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DemandOrder proto.InternalMessageInfo
+
+func (m *DemandOrder) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetTrackingPacketKey() string {
+	if m != nil {
+		return m.TrackingPacketKey
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *DemandOrder) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *DemandOrder) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *DemandOrder) GetTrackingPacketStatus() RollappPacket_Status {
+	if m != nil {
+		return m.TrackingPacketStatus
+	}
+	return RollappPacket_PENDING
+}
+
+func init() {
+	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.delayedack.DemandOrder")
+}
+
+func init() {
+	proto.RegisterFile("dymension/delayedack/demand_order.proto", fileDescriptor_a08b6a99826e0c33)
+}
+
+var fileDescriptor_a08b6a99826e0c33 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcb, 0xca, 0x13, 0x31,
+	0x14, 0x9e, 0x69, 0xff, 0xbf, 0xda, 0x14, 0x0a, 0xc6, 0x22, 0xb1, 0xe8, 0x58, 0xdd, 0x58, 0x37,
+	0x09, 0xad, 0x8a, 0xfb, 0xea, 0x46, 0x04, 0x95, 0x71, 0xe7, 0xa6, 0x64, 0x92, 0xd3, 0x31, 0xcc,
+	0x25, 0x43, 0x26, 0x95, 0x8e, 0x3b, 0xdf, 0xc0, 0xc7, 0xea, 0xb2, 0x4b, 0x57, 0x22, 0xed, 0x8b,
+	0xc8, 0x4c, 0xa6, 0x17, 0x45, 0xd0, 0x5d, 0xf2, 0x5d, 0x38, 0xdf, 0x77, 0x0e, 0x7a, 0x2c, 0xab,
+	0x0c, 0xf2, 0x52, 0xe9, 0x9c, 0x49, 0x48, 0x79, 0x05, 0x92, 0x8b, 0x84, 0x49, 0xc8, 0x78, 0x2e,
+	0x97, 0xda, 0x48, 0x30, 0xb4, 0x30, 0xda, 0x6a, 0xfc, 0xf0, 0x24, 0xdc, 0x54, 0x5f, 0xe8, 0xe9,
+	0x43, 0xcf, 0xae, 0xf1, 0x28, 0xd6, 0xb1, 0x6e, 0xd4, 0xac, 0x7e, 0x39, 0xe3, 0x38, 0x10, 0xba,
+	0xcc, 0x74, 0xc9, 0x22, 0x5e, 0x02, 0xfb, 0x3c, 0x8b, 0xc0, 0xf2, 0x19, 0x13, 0x5a, 0xe5, 0x2d,
+	0xff, 0xe4, 0xaf, 0x09, 0x8c, 0x4e, 0x53, 0x5e, 0x14, 0xcb, 0x82, 0x8b, 0x04, 0xac, 0x93, 0x3e,
+	0xfa, 0xda, 0x45, 0x83, 0x57, 0x4d, 0xb4, 0x77, 0x75, 0x32, 0x3c, 0x44, 0x1d, 0x25, 0x89, 0x3f,
+	0xf1, 0xa7, 0xfd, 0xb0, 0xa3, 0x24, 0xa6, 0xe8, 0xb6, 0x35, 0x5c, 0x24, 0x2a, 0x8f, 0x5b, 0xe3,
+	0x32, 0x81, 0x8a, 0x74, 0x1a, 0xc1, 0xad, 0x23, 0xf5, 0xbe, 0x61, 0xde, 0x40, 0x85, 0xef, 0x23,
+	0x74, 0x9c, 0xa3, 0x24, 0xe9, 0x36, 0xb2, 0x7e, 0x8b, 0xbc, 0x96, 0xf8, 0x39, 0xba, 0x2e, 0x8c,
+	0x12, 0x40, 0xae, 0x26, 0xfe, 0x74, 0x30, 0xbf, 0x4b, 0x5d, 0x13, 0x5a, 0x37, 0xa1, 0x6d, 0x13,
+	0xfa, 0x52, 0xab, 0x7c, 0x71, 0xb5, 0xfd, 0xf1, 0xc0, 0x0b, 0x9d, 0x1a, 0xcf, 0x50, 0x77, 0x05,
+	0x40, 0xae, 0xff, 0xcf, 0x54, 0x6b, 0xf1, 0x3d, 0xd4, 0x37, 0x20, 0x54, 0xa1, 0x20, 0xb7, 0xa4,
+	0xd7, 0xe6, 0x38, 0x02, 0x35, 0xbb, 0x5a, 0xa7, 0x2b, 0x95, 0xa6, 0x60, 0xc8, 0x0d, 0xc7, 0x9e,
+	0x00, 0x9c, 0xa1, 0x3b, 0x7f, 0x96, 0x2e, 0x2d, 0xb7, 0xeb, 0x92, 0xdc, 0x9c, 0xf8, 0xd3, 0xe1,
+	0xfc, 0x05, 0xfd, 0xe7, 0xe5, 0x68, 0xe8, 0x3a, 0xbb, 0xcd, 0xd0, 0x0f, 0x8d, 0x3d, 0x1c, 0xfd,
+	0xbe, 0x30, 0x87, 0x2e, 0xde, 0x6e, 0xf7, 0x81, 0xbf, 0xdb, 0x07, 0xfe, 0xcf, 0x7d, 0xe0, 0x7f,
+	0x3b, 0x04, 0xde, 0xee, 0x10, 0x78, 0xdf, 0x0f, 0x81, 0xf7, 0xf1, 0x59, 0xac, 0xec, 0xa7, 0x75,
+	0x44, 0x85, 0xce, 0xd8, 0xe5, 0xc8, 0xf3, 0x87, 0x6d, 0x2e, 0x4f, 0x6c, 0xab, 0x02, 0xca, 0xa8,
+	0xd7, 0x9c, 0xf6, 0xe9, 0xaf, 0x01, 0x00, 0xe9, 0x06, 0x60, 0xca, 0x89, 0x02, 0x00, 0x00,
+}
+
+func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrackingPacketStatus != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.TrackingPacketStatus))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrackingPacketKey) > 0 {
+		i -= len(m.TrackingPacketKey)
+		copy(dAtA[i:], m.TrackingPacketKey)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.TrackingPacketKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDemandOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DemandOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.TrackingPacketKey)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	if m.TrackingPacketStatus != 0 {
+		n += 1 + sovDemandOrder(uint64(m.TrackingPacketStatus))
+	}
+	return n
+}
+
+func sovDemandOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDemandOrder(x uint64) (n int) {
+	return sovDemandOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingPacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketStatus", wireType)
+			}
+			m.TrackingPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackingPacketStatus |= RollappPacket_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDemandOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDemandOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDemandOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDemandOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDemandOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDemandOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDemandOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EIBCMemoKey is the key of the eIBC object in the memo of a fungible token packet
+const EIBCMemoKey = "eibc"
+
+// EIBCMemo is the eIBC part of the memo of a fungible token packet, e.g. {"eibc": {"fee": "100"}}
+type EIBCMemo struct {
+	// Fee is the amount, in the denom of the transfer, the fulfiller of the demand order earns
+	Fee string `json:"fee"`
+}
+
+// ParseEIBCFee returns the eIBC fee from the memo of a fungible token packet.
+// It returns false if the memo doesn't request a demand order.
+func ParseEIBCFee(memo string) (sdk.Int, bool, error) {
+	var memoObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoObject); err != nil {
+		// memos which are not JSON objects are not meant for eIBC
+		return sdk.Int{}, false, nil
+	}
+	raw, ok := memoObject[EIBCMemoKey]
+	if !ok {
+		return sdk.Int{}, false, nil
+	}
+
+	var eibcMemo EIBCMemo
+	if err := json.Unmarshal(raw, &eibcMemo); err != nil {
+		return sdk.Int{}, false, sdkerrors.Wrap(ErrInvalidEIBCMemo, err.Error())
+	}
+	fee, ok := sdk.NewIntFromString(eibcMemo.Fee)
+	if !ok || fee.IsNegative() {
+		return sdk.Int{}, false, sdkerrors.Wrapf(ErrInvalidEIBCMemo, "invalid fee: %s", eibcMemo.Fee)
+	}
+	return fee, true, nil
+}
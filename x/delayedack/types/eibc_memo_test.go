@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEIBCFee(t *testing.T) {
+	tests := []struct {
+		name  string
+		memo  string
+		fee   sdk.Int
+		found bool
+		err   error
+	}{
+		{name: "empty memo"},
+		{name: "plain text memo", memo: "hello"},
+		{name: "memo without eibc", memo: `{"wasm":{}}`},
+		{name: "valid fee", memo: `{"eibc":{"fee":"100"}}`, fee: sdk.NewInt(100), found: true},
+		{name: "valid fee with other keys", memo: `{"eibc":{"fee":"0"},"wasm":{}}`, fee: sdk.ZeroInt(), found: true},
+		{name: "negative fee", memo: `{"eibc":{"fee":"-1"}}`, err: ErrInvalidEIBCMemo},
+		{name: "missing fee", memo: `{"eibc":{}}`, err: ErrInvalidEIBCMemo},
+		{name: "malformed eibc object", memo: `{"eibc":"100"}`, err: ErrInvalidEIBCMemo},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fee, found, err := ParseEIBCFee(tt.memo)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.found, found)
+			if tt.found {
+				require.True(t, tt.fee.Equal(fee))
+			}
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/delayedack module sentinel errors
+var (
+	ErrRollappStateReverted        = sdkerrors.Register(ModuleName, 1000, "rollapp state was reverted")
+	ErrInvalidEIBCMemo             = sdkerrors.Register(ModuleName, 1001, "invalid eibc memo")
+	ErrDemandOrderNotFound         = sdkerrors.Register(ModuleName, 1002, "demand order not found")
+	ErrDemandOrderInactive         = sdkerrors.Register(ModuleName, 1003, "demand order is not pending")
+	ErrDemandOrderAlreadyFulfilled = sdkerrors.Register(ModuleName, 1004, "demand order already fulfilled")
+	ErrInvalidFee                  = sdkerrors.Register(ModuleName, 1005, "invalid fee")
+	ErrRollappFrozen               = sdkerrors.Register(ModuleName, 1006, "rollapp is frozen")
+)
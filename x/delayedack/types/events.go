@@ -0,0 +1,14 @@
+package types
+
+const (
+	EventTypeDemandOrderCreated    = "demand_order_created"
+	EventTypeDemandOrderFulfilled  = "demand_order_fulfilled"
+	EventTypeDemandOrderFeeUpdated = "demand_order_fee_updated"
+
+	AttributeKeyOrderId   = "order_id"
+	AttributeKeyRollappId = "rollapp_id"
+	AttributeKeyPrice     = "price"
+	AttributeKeyFee       = "fee"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyFulfiller = "fulfiller"
+)
@@ -30,5 +30,10 @@ type ConnectionKeeper interface {
 type RollappKeeper interface {
 	GetParams(ctx sdk.Context) rollapptypes.Params
 	GetRollapp(ctx sdk.Context, chainID string) (rollapp rollapptypes.Rollapp, found bool)
+	GetRollappByPortChannel(ctx sdk.Context, portID string, channelID string) (rollapp rollapptypes.Rollapp, found bool, err error)
 	StateInfo(c context.Context, req *types.QueryGetStateInfoRequest) (*types.QueryGetStateInfoResponse, error)
 }
+
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
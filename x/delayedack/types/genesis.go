@@ -1,5 +1,9 @@
 package types
 
+import (
+	"fmt"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -7,11 +11,46 @@ const DefaultIndex uint64 = 1
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Params:         DefaultParams(),
+		RollappPackets: []RollappPacket{},
+		DemandOrders:   []DemandOrder{},
+	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return nil
+	// Check for duplicated rollappPackets. A packet is identified by its hub channel and sequence,
+	// whatever its status and proof height
+	rollappPacketKeyMap := make(map[string]struct{})
+	for _, rollappPacket := range gs.RollappPackets {
+		if err := rollappPacket.Validate(); err != nil {
+			return err
+		}
+		key := string(RollappPacketIndexKey(
+			rollappPacket.RollappId,
+			rollappPacket.Type,
+			rollappPacket.HubChannel(),
+			rollappPacket.Packet.Sequence,
+		))
+		if _, ok := rollappPacketKeyMap[key]; ok {
+			return fmt.Errorf("duplicated key for rollappPacket: %s", key)
+		}
+		rollappPacketKeyMap[key] = struct{}{}
+	}
+
+	// Check for duplicated ids in demandOrders
+	demandOrderIdMap := make(map[string]struct{})
+	for _, demandOrder := range gs.DemandOrders {
+		if err := demandOrder.Validate(); err != nil {
+			return err
+		}
+		if _, ok := demandOrderIdMap[demandOrder.Id]; ok {
+			return fmt.Errorf("duplicated id for demandOrder: %s", demandOrder.Id)
+		}
+		demandOrderIdMap[demandOrder.Id] = struct{}{}
+	}
+
+	return gs.Params.Validate()
 }
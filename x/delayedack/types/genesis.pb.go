@@ -25,6 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the delayedack module's genesis state.
 type GenesisState struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// rollappPackets are the delayed packets of all rollapps, in every status
+	RollappPackets []RollappPacket `protobuf:"bytes,2,rep,name=rollappPackets,proto3" json:"rollappPackets"`
+	// demandOrders are the eIBC demand orders of the rollapp packets
+	DemandOrders []DemandOrder `protobuf:"bytes,3,rep,name=demandOrders,proto3" json:"demandOrders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -60,6 +65,27 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRollappPackets() []RollappPacket {
+	if m != nil {
+		return m.RollappPackets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDemandOrders() []DemandOrder {
+	if m != nil {
+		return m.DemandOrders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.delayedack.GenesisState")
 }
@@ -69,17 +95,26 @@ func init() {
 }
 
 var fileDescriptor_2c92ac7c69d987d1 = []byte{
-	// 157 bytes of a gzipped FileDescriptorProto
+	// 289 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x4f, 0x49, 0xcd, 0x49, 0xac, 0x4c, 0x4d, 0x49, 0x4c, 0xce,
 	0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52,
 	0x84, 0xab, 0xa9, 0xa8, 0xac, 0xd2, 0x83, 0x73, 0xf4, 0x10, 0x1a, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xaa, 0xf5, 0x41, 0x2c, 0x88, 0x46, 0x25, 0x3e, 0x2e, 0x1e, 0x77, 0x88, 0x49, 0xc1,
-	0x25, 0x89, 0x25, 0xa9, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x6c, 0x1b, 0x82,
-	0xa3, 0x5f, 0x81, 0xec, 0xc0, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x35, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6a, 0xb2, 0xd4, 0x01, 0xc5, 0x00, 0x00, 0x00,
+	0xf3, 0xc1, 0xaa, 0xf5, 0x41, 0x2c, 0x88, 0x46, 0x29, 0x45, 0xac, 0x86, 0x17, 0x24, 0x16, 0x25,
+	0xe6, 0x42, 0xcd, 0x96, 0xd2, 0xc4, 0xaa, 0xa4, 0x28, 0x3f, 0x27, 0x27, 0xb1, 0xa0, 0x20, 0xbe,
+	0x20, 0x31, 0x39, 0x3b, 0xb5, 0x04, 0xaa, 0x54, 0x1d, 0xab, 0xd2, 0x94, 0xd4, 0xdc, 0xc4, 0xbc,
+	0x94, 0xf8, 0xfc, 0xa2, 0x94, 0xd4, 0x22, 0x88, 0x42, 0xa5, 0x89, 0x4c, 0x5c, 0x3c, 0xee, 0x10,
+	0x1f, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x73, 0xb1, 0x41, 0x2c, 0x95, 0x60, 0x54, 0x60,
+	0xd4, 0xe0, 0x36, 0xd2, 0xd4, 0x23, 0xe8, 0x23, 0xbd, 0x00, 0xb0, 0x06, 0x27, 0x96, 0x13, 0xf7,
+	0xe4, 0x19, 0x82, 0xa0, 0xda, 0x85, 0xe2, 0xb8, 0xf8, 0xa0, 0x4e, 0x0b, 0x00, 0xbb, 0xac, 0x58,
+	0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0xc8, 0x80, 0x08, 0x03, 0x83, 0x90, 0x35, 0x42, 0xcd, 0x45,
+	0x33, 0x4d, 0x28, 0x82, 0x8b, 0x07, 0xe2, 0x1f, 0x7f, 0x90, 0x77, 0x8a, 0x25, 0x98, 0xc1, 0xa6,
+	0xeb, 0x11, 0x61, 0xba, 0x0b, 0x42, 0x1b, 0xd4, 0x6c, 0x14, 0x93, 0x9c, 0xfc, 0x4e, 0x3c, 0x92,
+	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
+	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
+	0x39, 0x3f, 0x57, 0x1f, 0xd9, 0x1e, 0x04, 0x47, 0xbf, 0x02, 0x39, 0xc0, 0x4b, 0x2a, 0x0b, 0x52,
+	0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x6d, 0x0c, 0x18, 0x00, 0x7c, 0x42, 0xff, 0x6b, 0x40, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -102,6 +137,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DemandOrders) > 0 {
+		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DemandOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappPackets) > 0 {
+		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -122,6 +195,20 @@ func (m *GenesisState) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -160,6 +247,107 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappPackets = append(m.RollappPackets, RollappPacket{})
+			if err := m.RollappPackets[len(m.RollappPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,180 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/dymensionxyz/dymension/x/delayedack/types"
+	"github.com/stretchr/testify/require"
+)
+
+func rollappPacket(rollappID string, sequence uint64, status types.RollappPacket_Status) types.RollappPacket {
+	packet := channeltypes.NewPacket([]byte("data"), sequence, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+	return types.RollappPacket{
+		RollappId:   rollappID,
+		Packet:      &packet,
+		Status:      status,
+		ProofHeight: sequence,
+	}
+}
+
+func packetAtProofHeight(rollappPacket types.RollappPacket, proofHeight uint64) types.RollappPacket {
+	rollappPacket.ProofHeight = proofHeight
+	return rollappPacket
+}
+
+func demandOrder(trackingPacketKey string) types.DemandOrder {
+	return types.DemandOrder{
+		Id:                   types.BuildDemandOrderID([]byte(trackingPacketKey)),
+		TrackingPacketKey:    trackingPacketKey,
+		RollappId:            "rollapp_1234-1",
+		Price:                sdk.NewInt64Coin("adym", 90),
+		Fee:                  sdk.NewInt64Coin("adym", 10),
+		Recipient:            sample.AccAddress(),
+		TrackingPacketStatus: types.RollappPacket_PENDING,
+	}
+}
+
+func TestGenesisState_Validate(t *testing.T) {
+	fulfilledOrder := demandOrder("key2")
+	fulfilledOrder.Fulfiller = sample.AccAddress()
+	mismatchingOrder := demandOrder("key1")
+	mismatchingOrder.TrackingPacketKey = "key2"
+	invalidFulfillerOrder := demandOrder("key1")
+	invalidFulfillerOrder.Fulfiller = "invalid_address"
+
+	noIBCPacket := rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING)
+	noIBCPacket.Packet = nil
+	invalidType := rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING)
+	invalidType.Type = 5
+	ackWithoutAcknowledgement := rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING)
+	ackWithoutAcknowledgement.Type = types.RollappPacket_ON_ACK
+	recvAndAck := rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING)
+	recvAndAck.Type = types.RollappPacket_ON_ACK
+	recvAndAck.Acknowledgement = []byte("ack")
+
+	for _, tc := range []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RollappPackets: []types.RollappPacket{
+					rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING),
+					rollappPacket("rollapp_1234-1", 3, types.RollappPacket_ACCEPTED),
+					rollappPacket("rollapp_1234-1", 2, types.RollappPacket_REJECTED),
+					rollappPacket("rollapp_5678-1", 1, types.RollappPacket_PENDING),
+					recvAndAck,
+				},
+				DemandOrders: []types.DemandOrder{
+					demandOrder("key1"),
+					fulfilledOrder,
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated demandOrder",
+			genState: &types.GenesisState{
+				DemandOrders: []types.DemandOrder{
+					demandOrder("key1"),
+					demandOrder("key1"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "demandOrder not matching its tracking packet key",
+			genState: &types.GenesisState{
+				DemandOrders: []types.DemandOrder{mismatchingOrder},
+			},
+			valid: false,
+		},
+		{
+			desc: "demandOrder with invalid fulfiller",
+			genState: &types.GenesisState{
+				DemandOrders: []types.DemandOrder{invalidFulfillerOrder},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated rollappPacket",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{
+					rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING),
+					rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rollappPacket duplicated in another status and proof height",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{
+					rollappPacket("rollapp_1234-1", 1, types.RollappPacket_PENDING),
+					packetAtProofHeight(rollappPacket("rollapp_1234-1", 1, types.RollappPacket_ACCEPTED), 2),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rollappPacket without rollapp id",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{
+					rollappPacket("", 1, types.RollappPacket_PENDING),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rollappPacket without IBC packet",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{noIBCPacket},
+			},
+			valid: false,
+		},
+		{
+			desc: "rollappPacket with malformed IBC packet",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{
+					rollappPacket("rollapp_1234-1", 0, types.RollappPacket_PENDING),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "rollappPacket with invalid type",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{invalidType},
+			},
+			valid: false,
+		},
+		{
+			desc: "acknowledged rollappPacket without acknowledgement",
+			genState: &types.GenesisState{
+				RollappPackets: []types.RollappPacket{ackWithoutAcknowledgement},
+			},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	fmt "fmt"
+)
+
+const (
+	// DemandOrderKeyPrefix is the prefix to retrieve all DemandOrders
+	DemandOrderKeyPrefix = "DemandOrder/value/"
+	// DemandOrderIndexKeyPrefix is the prefix to retrieve the DemandOrders by rollapp and status
+	DemandOrderIndexKeyPrefix = "DemandOrderIndex/value/"
+)
+
+// BuildDemandOrderID returns the id of the demand order of the rollapp packet stored under the given key
+func BuildDemandOrderID(rollappPacketKey []byte) string {
+	hash := sha256.Sum256(rollappPacketKey)
+	return hex.EncodeToString(hash[:])
+}
+
+// DemandOrderKey returns the store key to retrieve a DemandOrder from the id field
+func DemandOrderKey(id string) []byte {
+	return []byte(id + "/")
+}
+
+// DemandOrderIndexKey returns the index key of a DemandOrder by rollapp and status
+func DemandOrderIndexKey(rollappId string, status RollappPacket_Status, id string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", rollappId, status, id))
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	fmt "fmt"
+)
+
+const (
+	// ProofHeightKeyPrefix is the prefix to retrieve the proof heights of the packets relayed in the current block
+	ProofHeightKeyPrefix = "ProofHeight/value/"
+)
+
+// GetProofHeightKey constructs a key for the proof height of a packet handled by the given IBC callback.
+// The port and channel are the hub side ones, as identifying the packet on the hub.
+func GetProofHeightKey(
+	packetType RollappPacket_Type,
+	portID string,
+	channelID string,
+	sequence uint64,
+) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d/", packetType, portID, channelID, sequence))
+}
@@ -12,6 +12,8 @@ var _ binary.ByteOrder
 const (
 	// RollappPacketKeyPrefix is the prefix to retrieve all RollappPackets
 	RollappPacketKeyPrefix = "RollappPacket/value/"
+	// RollappPacketIndexKeyPrefix is the prefix to retrieve the RollappPacket keys by hub channel and sequence
+	RollappPacketIndexKeyPrefix = "RollappPacketIndex/value/"
 )
 
 // GetRollappPacketKey constructs a key for a specific RollappPacket
@@ -19,6 +21,7 @@ func GetRollappPacketKey(
 	rollappId string,
 	status RollappPacket_Status,
 	packetProofHeight uint64,
+	packetType RollappPacket_Type,
 	IBCPacket channeltypes.Packet,
 ) []byte {
 	var key []byte
@@ -40,10 +43,27 @@ func GetRollappPacketKey(
 	key = append(key, packetHeightBytes...)
 	key = append(key, []byte("/")...)
 
-	packetUID := IBCPacket.DestinationChannel + "-" + fmt.Sprint(IBCPacket.Sequence)
+	// the sequence is zero padded like the proof height, so the packets of a channel are iterated in order
+	packetUID := IBCPacket.DestinationChannel + "-" + fmt.Sprintf("%020d", IBCPacket.Sequence)
+	// acks and timeouts are of packets sent from the hub, whose destination channel is on the rollapp,
+	// so the packet type is part of their UID to avoid collisions with received packets
+	if packetType != RollappPacket_ON_RECV {
+		packetUID = fmt.Sprint(packetType) + "-" + packetUID
+	}
 	packetUIDBytes := []byte(packetUID)
 	key = append(key, packetUIDBytes...)
 	key = append(key, []byte("/")...)
 
 	return key
 }
+
+// RollappPacketIndexKey returns the index key of a RollappPacket by the hub side channel and sequence of its packet,
+// which doesn't depend on the packet status and proof height
+func RollappPacketIndexKey(
+	rollappId string,
+	packetType RollappPacket_Type,
+	hubChannel string,
+	sequence uint64,
+) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%020d/", rollappId, packetType, hubChannel, sequence))
+}
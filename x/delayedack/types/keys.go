@@ -12,6 +12,9 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_delayedack"
+
+	// TransientStoreKey defines the transient store key
+	TransientStoreKey = "transient_delayedack"
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFulfillOrder = "fulfill_order"
+
+var _ sdk.Msg = &MsgFulfillOrder{}
+
+func NewMsgFulfillOrder(fulfiller string, orderId string) *MsgFulfillOrder {
+	return &MsgFulfillOrder{
+		Fulfiller: fulfiller,
+		OrderId:   orderId,
+	}
+}
+
+func (msg *MsgFulfillOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFulfillOrder) Type() string {
+	return TypeMsgFulfillOrder
+}
+
+func (msg *MsgFulfillOrder) GetSigners() []sdk.AccAddress {
+	fulfiller, err := sdk.AccAddressFromBech32(msg.Fulfiller)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{fulfiller}
+}
+
+func (msg *MsgFulfillOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFulfillOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Fulfiller)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid fulfiller address (%s)", err)
+	}
+	if msg.OrderId == "" {
+		return sdkerrors.Wrap(ErrDemandOrderNotFound, "order id cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgFulfillOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgFulfillOrder
+		err  error
+	}{
+		{
+			name: "valid",
+			msg: MsgFulfillOrder{
+				Fulfiller: sample.AccAddress(),
+				OrderId:   "order",
+			},
+		}, {
+			name: "invalid address",
+			msg: MsgFulfillOrder{
+				Fulfiller: "invalid_address",
+				OrderId:   "order",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty order id",
+			msg: MsgFulfillOrder{
+				Fulfiller: sample.AccAddress(),
+			},
+			err: ErrDemandOrderNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateDemandOrder = "update_demand_order"
+
+var _ sdk.Msg = &MsgUpdateDemandOrder{}
+
+func NewMsgUpdateDemandOrder(recipient string, orderId string, newFee string) *MsgUpdateDemandOrder {
+	return &MsgUpdateDemandOrder{
+		Recipient: recipient,
+		OrderId:   orderId,
+		NewFee:    newFee,
+	}
+}
+
+func (msg *MsgUpdateDemandOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateDemandOrder) Type() string {
+	return TypeMsgUpdateDemandOrder
+}
+
+func (msg *MsgUpdateDemandOrder) GetSigners() []sdk.AccAddress {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{recipient}
+}
+
+func (msg *MsgUpdateDemandOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateDemandOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+	}
+	if msg.OrderId == "" {
+		return sdkerrors.Wrap(ErrDemandOrderNotFound, "order id cannot be empty")
+	}
+	if _, err := msg.GetNewFeeInt(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetNewFeeInt returns the new fee amount of the order
+func (msg *MsgUpdateDemandOrder) GetNewFeeInt() (sdk.Int, error) {
+	fee, ok := sdk.NewIntFromString(msg.NewFee)
+	if !ok || fee.IsNegative() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalidFee, "fee must be a non-negative integer: %s", msg.NewFee)
+	}
+	return fee, nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateDemandOrder_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateDemandOrder
+		err  error
+	}{
+		{
+			name: "valid",
+			msg: MsgUpdateDemandOrder{
+				Recipient: sample.AccAddress(),
+				OrderId:   "order",
+				NewFee:    "100",
+			},
+		}, {
+			name: "valid zero fee",
+			msg: MsgUpdateDemandOrder{
+				Recipient: sample.AccAddress(),
+				OrderId:   "order",
+				NewFee:    "0",
+			},
+		}, {
+			name: "invalid address",
+			msg: MsgUpdateDemandOrder{
+				Recipient: "invalid_address",
+				OrderId:   "order",
+				NewFee:    "100",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty order id",
+			msg: MsgUpdateDemandOrder{
+				Recipient: sample.AccAddress(),
+				NewFee:    "100",
+			},
+			err: ErrDemandOrderNotFound,
+		}, {
+			name: "negative fee",
+			msg: MsgUpdateDemandOrder{
+				Recipient: sample.AccAddress(),
+				OrderId:   "order",
+				NewFee:    "-1",
+			},
+			err: ErrInvalidFee,
+		}, {
+			name: "non integer fee",
+			msg: MsgUpdateDemandOrder{
+				Recipient: sample.AccAddress(),
+				OrderId:   "order",
+				NewFee:    "1.5",
+			},
+			err: ErrInvalidFee,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
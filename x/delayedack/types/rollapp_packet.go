@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// HubChannel returns the hub side channel of the packet. Received packets were sent by the rollapp
+// to the hub, while acknowledged and timed out packets were sent by the hub to the rollapp.
+func (r RollappPacket) HubChannel() string {
+	if r.Packet == nil {
+		return ""
+	}
+	if r.Type == RollappPacket_ON_RECV {
+		return r.Packet.DestinationChannel
+	}
+	return r.Packet.SourceChannel
+}
+
+// Validate performs a stateless validation of the rollapp packet
+func (r RollappPacket) Validate() error {
+	if r.RollappId == "" {
+		return fmt.Errorf("rollapp packet rollapp id cannot be empty")
+	}
+	if r.Packet == nil {
+		return fmt.Errorf("rollapp packet of rollapp %s has no IBC packet", r.RollappId)
+	}
+	if err := r.Packet.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid IBC packet of rollapp %s: %w", r.RollappId, err)
+	}
+	if _, ok := RollappPacket_Status_name[int32(r.Status)]; !ok {
+		return fmt.Errorf("invalid rollapp packet status: %d", r.Status)
+	}
+	if _, ok := RollappPacket_Type_name[int32(r.Type)]; !ok {
+		return fmt.Errorf("invalid rollapp packet type: %d", r.Type)
+	}
+	if r.Type == RollappPacket_ON_ACK && len(r.Acknowledgement) == 0 {
+		return fmt.Errorf("acknowledged rollapp packet of rollapp %s has no acknowledgement", r.RollappId)
+	}
+	return nil
+}
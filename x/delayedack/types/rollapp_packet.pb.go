@@ -52,12 +52,46 @@ func (RollappPacket_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b801a01bc7222719, []int{0, 0}
 }
 
+type RollappPacket_Type int32
+
+const (
+	RollappPacket_ON_RECV    RollappPacket_Type = 0
+	RollappPacket_ON_ACK     RollappPacket_Type = 1
+	RollappPacket_ON_TIMEOUT RollappPacket_Type = 2
+)
+
+var RollappPacket_Type_name = map[int32]string{
+	0: "ON_RECV",
+	1: "ON_ACK",
+	2: "ON_TIMEOUT",
+}
+
+var RollappPacket_Type_value = map[string]int32{
+	"ON_RECV":    0,
+	"ON_ACK":     1,
+	"ON_TIMEOUT": 2,
+}
+
+func (x RollappPacket_Type) String() string {
+	return proto.EnumName(RollappPacket_Type_name, int32(x))
+}
+
+func (RollappPacket_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b801a01bc7222719, []int{0, 1}
+}
+
 type RollappPacket struct {
 	Packet      *types.Packet        `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet,omitempty"`
 	Status      RollappPacket_Status `protobuf:"varint,2,opt,name=status,proto3,enum=dymensionxyz.dymension.delayedack.RollappPacket_Status" json:"status,omitempty"`
 	ProofHeight uint64               `protobuf:"varint,3,opt,name=ProofHeight,proto3" json:"ProofHeight,omitempty"`
 	Error       string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 	Relayer     []byte               `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// type is the IBC callback the packet is delayed for
+	Type RollappPacket_Type `protobuf:"varint,6,opt,name=type,proto3,enum=dymensionxyz.dymension.delayedack.RollappPacket_Type" json:"type,omitempty"`
+	// acknowledgement is the acknowledgement of an ON_ACK packet
+	Acknowledgement []byte `protobuf:"bytes,7,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// rollapp_id is the rollapp the packet was delayed for
+	RollappId string `protobuf:"bytes,8,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 }
 
 func (m *RollappPacket) Reset()         { *m = RollappPacket{} }
@@ -128,8 +162,30 @@ func (m *RollappPacket) GetRelayer() []byte {
 	return nil
 }
 
+func (m *RollappPacket) GetType() RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return RollappPacket_ON_RECV
+}
+
+func (m *RollappPacket) GetAcknowledgement() []byte {
+	if m != nil {
+		return m.Acknowledgement
+	}
+	return nil
+}
+
+func (m *RollappPacket) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.delayedack.RollappPacket_Status", RollappPacket_Status_name, RollappPacket_Status_value)
+	proto.RegisterEnum("dymensionxyz.dymension.delayedack.RollappPacket_Type", RollappPacket_Type_name, RollappPacket_Type_value)
 	proto.RegisterType((*RollappPacket)(nil), "dymensionxyz.dymension.delayedack.RollappPacket")
 }
 
@@ -138,29 +194,35 @@ func init() {
 }
 
 var fileDescriptor_b801a01bc7222719 = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xbd, 0x6e, 0xea, 0x30,
-	0x18, 0x86, 0x63, 0x0e, 0x84, 0x73, 0x0c, 0xa7, 0x42, 0x16, 0x43, 0x44, 0xa5, 0x28, 0x30, 0xa5,
-	0x8b, 0x2d, 0xa0, 0x52, 0xe7, 0x16, 0xa2, 0xfe, 0x0c, 0x34, 0x4a, 0x3b, 0x75, 0xa9, 0x92, 0xe0,
-	0x86, 0x88, 0x10, 0x47, 0x8e, 0x41, 0xa4, 0x57, 0xd1, 0xb9, 0x57, 0xd4, 0x91, 0xb1, 0x63, 0x05,
-	0x37, 0x52, 0x25, 0xe6, 0xaf, 0x53, 0xb7, 0xef, 0x89, 0xde, 0xf7, 0x7b, 0x62, 0x1b, 0x9e, 0x8d,
-	0xb3, 0x19, 0x8d, 0xd3, 0x90, 0xc5, 0x64, 0x4c, 0x23, 0x37, 0xa3, 0x63, 0xd7, 0x9f, 0x12, 0xce,
-	0xa2, 0xc8, 0x4d, 0x92, 0xe7, 0xc4, 0xf5, 0xa7, 0x54, 0xe0, 0x84, 0x33, 0xc1, 0x50, 0x7b, 0x1f,
-	0x5d, 0x66, 0xaf, 0x78, 0x0f, 0xf8, 0xd0, 0x6b, 0x35, 0x03, 0x16, 0xb0, 0x22, 0x4d, 0xf2, 0x49,
-	0x16, 0x5b, 0xed, 0xd0, 0xf3, 0x89, 0xcf, 0x38, 0x25, 0xfe, 0xc4, 0x8d, 0x63, 0x1a, 0x91, 0x45,
-	0x77, 0x37, 0xca, 0x48, 0xe7, 0xbd, 0x04, 0xff, 0x3b, 0x52, 0x6a, 0x17, 0x4e, 0xd4, 0x87, 0xaa,
-	0xb4, 0x6b, 0xc0, 0x00, 0x66, 0xad, 0x77, 0x8a, 0x43, 0xcf, 0xc7, 0xf9, 0x16, 0xbc, 0xab, 0x2e,
-	0xba, 0x58, 0x86, 0x9d, 0x6d, 0x14, 0xdd, 0x43, 0x35, 0x15, 0xae, 0x98, 0xa7, 0x5a, 0xc9, 0x00,
-	0xe6, 0x49, 0xef, 0x02, 0xff, 0xfa, 0xcf, 0xf8, 0x87, 0x16, 0x3f, 0x14, 0x75, 0x67, 0xbb, 0x06,
-	0x19, 0xb0, 0x66, 0x73, 0xc6, 0x5e, 0x6e, 0x68, 0x18, 0x4c, 0x84, 0xf6, 0xc7, 0x00, 0x66, 0xd9,
-	0x39, 0xfe, 0x84, 0x9a, 0xb0, 0x42, 0x39, 0x67, 0x5c, 0x2b, 0x1b, 0xc0, 0xfc, 0xe7, 0x48, 0x40,
-	0x1a, 0xac, 0xf2, 0x42, 0xc1, 0xb5, 0x8a, 0x01, 0xcc, 0xba, 0xb3, 0xc3, 0x4e, 0x17, 0xaa, 0xd2,
-	0x81, 0x6a, 0xb0, 0x6a, 0x5b, 0xa3, 0xe1, 0xed, 0xe8, 0xba, 0xa1, 0xa0, 0x3a, 0xfc, 0x7b, 0x39,
-	0x18, 0x58, 0xf6, 0xa3, 0x35, 0x6c, 0x80, 0x9c, 0x1c, 0xeb, 0xce, 0x1a, 0xe4, 0x54, 0xba, 0x1a,
-	0x7d, 0xac, 0x75, 0xb0, 0x5a, 0xeb, 0xe0, 0x6b, 0xad, 0x83, 0xb7, 0x8d, 0xae, 0xac, 0x36, 0xba,
-	0xf2, 0xb9, 0xd1, 0x95, 0xa7, 0xf3, 0x20, 0x14, 0x93, 0xb9, 0x87, 0x7d, 0x36, 0x23, 0xc7, 0x27,
-	0x3d, 0x00, 0x59, 0x1e, 0xbf, 0xab, 0xc8, 0x12, 0x9a, 0x7a, 0x6a, 0x71, 0xe7, 0xfd, 0xef, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x8f, 0x3b, 0xbb, 0xf1, 0xfc, 0x01, 0x00, 0x00,
+	// 440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xf5, 0xa6, 0xa9, 0xd3, 0x4e, 0x4a, 0xb1, 0x56, 0x3d, 0x58, 0x45, 0x58, 0x6e, 0x4e, 0xe6,
+	0xb2, 0xab, 0xb4, 0x20, 0xce, 0xc5, 0xb5, 0x20, 0x20, 0xec, 0x68, 0x09, 0x1c, 0xb8, 0x44, 0x8e,
+	0xbd, 0x38, 0x56, 0x1c, 0xaf, 0xb5, 0x71, 0x4b, 0xcd, 0x57, 0xf0, 0x59, 0x1c, 0x7b, 0xe4, 0x06,
+	0x4a, 0x7e, 0x04, 0x79, 0x9d, 0x34, 0x81, 0x0b, 0xea, 0x6d, 0xde, 0xe8, 0xbd, 0x79, 0x6f, 0x76,
+	0x07, 0x9e, 0xc5, 0xd5, 0x9c, 0xe7, 0x8b, 0x54, 0xe4, 0x34, 0xe6, 0x59, 0x58, 0xf1, 0x38, 0x8c,
+	0x66, 0x54, 0x8a, 0x2c, 0x0b, 0x8b, 0x62, 0x5c, 0x84, 0xd1, 0x8c, 0x97, 0xa4, 0x90, 0xa2, 0x14,
+	0xf8, 0xec, 0x9e, 0x7a, 0x5b, 0x7d, 0x23, 0xf7, 0x80, 0x6c, 0x75, 0xa7, 0x27, 0x89, 0x48, 0x84,
+	0x62, 0xd3, 0xba, 0x6a, 0x84, 0xa7, 0x67, 0xe9, 0x24, 0xa2, 0x91, 0x90, 0x9c, 0x46, 0xd3, 0x30,
+	0xcf, 0x79, 0x46, 0x6f, 0xfa, 0x9b, 0xb2, 0xa1, 0xf4, 0x7e, 0xed, 0xc1, 0x23, 0xd6, 0x98, 0x0e,
+	0x95, 0x27, 0xbe, 0x00, 0xbd, 0x71, 0x37, 0x91, 0x8d, 0x9c, 0xee, 0xf9, 0x13, 0x92, 0x4e, 0x22,
+	0x52, 0x4f, 0x21, 0x1b, 0xe9, 0x4d, 0x9f, 0x34, 0x64, 0xb6, 0xa6, 0xe2, 0x00, 0xf4, 0x45, 0x19,
+	0x96, 0xd7, 0x0b, 0xb3, 0x65, 0x23, 0xe7, 0xf8, 0xfc, 0x25, 0xf9, 0x6f, 0x66, 0xf2, 0x97, 0x2d,
+	0xf9, 0xa0, 0xe4, 0x6c, 0x3d, 0x06, 0xdb, 0xd0, 0x1d, 0x4a, 0x21, 0xbe, 0xbc, 0xe1, 0x69, 0x32,
+	0x2d, 0xcd, 0x3d, 0x1b, 0x39, 0x6d, 0xb6, 0xdb, 0xc2, 0x27, 0xb0, 0xcf, 0xa5, 0x14, 0xd2, 0x6c,
+	0xdb, 0xc8, 0x39, 0x64, 0x0d, 0xc0, 0x26, 0x74, 0xa4, 0xb2, 0x90, 0xe6, 0xbe, 0x8d, 0x9c, 0x23,
+	0xb6, 0x81, 0x78, 0x00, 0xed, 0xb2, 0x2a, 0xb8, 0xa9, 0xab, 0x80, 0x2f, 0x1e, 0x1c, 0x70, 0x54,
+	0x15, 0x9c, 0xa9, 0x11, 0xd8, 0x81, 0xc7, 0x61, 0x34, 0xcb, 0xc5, 0xd7, 0x8c, 0xc7, 0x09, 0x9f,
+	0xf3, 0xbc, 0x34, 0x3b, 0xca, 0xec, 0xdf, 0x36, 0x7e, 0x0a, 0xb0, 0xf9, 0xd2, 0x34, 0x36, 0x0f,
+	0x54, 0xd2, 0xc3, 0x75, 0x67, 0x10, 0xf7, 0xfa, 0xa0, 0x37, 0x7b, 0xe3, 0x2e, 0x74, 0x86, 0x9e,
+	0x7f, 0x35, 0xf0, 0x5f, 0x1b, 0x1a, 0x3e, 0x82, 0x83, 0x4b, 0xd7, 0xf5, 0x86, 0x23, 0xef, 0xca,
+	0x40, 0x35, 0x62, 0xde, 0x5b, 0xcf, 0xad, 0x51, 0xab, 0x47, 0xa1, 0x5d, 0x27, 0xa9, 0x05, 0x81,
+	0x3f, 0x66, 0x9e, 0xfb, 0xc9, 0xd0, 0x30, 0x80, 0x1e, 0xf8, 0xe3, 0x4b, 0xf7, 0x9d, 0x81, 0xf0,
+	0x31, 0x40, 0xe0, 0x8f, 0x47, 0x83, 0xf7, 0x5e, 0xf0, 0x71, 0x64, 0xb4, 0x5e, 0xf9, 0x3f, 0x96,
+	0x16, 0xba, 0x5b, 0x5a, 0xe8, 0xf7, 0xd2, 0x42, 0xdf, 0x57, 0x96, 0x76, 0xb7, 0xb2, 0xb4, 0x9f,
+	0x2b, 0x4b, 0xfb, 0xfc, 0x3c, 0x49, 0xcb, 0xe9, 0xf5, 0x84, 0x44, 0x62, 0x4e, 0x77, 0x5f, 0x63,
+	0x0b, 0xe8, 0xed, 0xee, 0x71, 0xd6, 0xbb, 0x2f, 0x26, 0xba, 0x3a, 0x9c, 0x8b, 0x3f, 0x03, 0x00,
+	0xd4, 0x38, 0xcb, 0x38, 0xc1, 0x02, 0x00, 0x00,
 }
 
 func (m *RollappPacket) Marshal() (dAtA []byte, err error) {
@@ -183,6 +245,25 @@ func (m *RollappPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRollappPacket(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Acknowledgement) > 0 {
+		i -= len(m.Acknowledgement)
+		copy(dAtA[i:], m.Acknowledgement)
+		i = encodeVarintRollappPacket(dAtA, i, uint64(len(m.Acknowledgement)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Type != 0 {
+		i = encodeVarintRollappPacket(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Relayer) > 0 {
 		i -= len(m.Relayer)
 		copy(dAtA[i:], m.Relayer)
@@ -257,6 +338,17 @@ func (m *RollappPacket) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRollappPacket(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovRollappPacket(uint64(m.Type))
+	}
+	l = len(m.Acknowledgement)
+	if l > 0 {
+		n += 1 + l + sovRollappPacket(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRollappPacket(uint64(l))
+	}
 	return n
 }
 
@@ -435,6 +527,91 @@ func (m *RollappPacket) Unmarshal(dAtA []byte) error {
 				m.Relayer = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acknowledgement", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRollappPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollappPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Acknowledgement = append(m.Acknowledgement[:0], dAtA[iNdEx:postIndex]...)
+			if m.Acknowledgement == nil {
+				m.Acknowledgement = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollappPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollappPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollappPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollappPacket(dAtA[iNdEx:])
@@ -14,8 +14,6 @@ import (
 	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 	"github.com/dymensionxyz/dymension/x/denommetadata/types"
 	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
-
-	ibctypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 )
 
 var _ porttypes.Middleware = &IBCMiddleware{}
@@ -133,24 +131,17 @@ func (im IBCMiddleware) OnRecvPacket(
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
-	_, clientState, err := im.channelKeeper.GetChannelClientState(ctx, packet.DestinationPort, packet.DestinationChannel)
+	// only packets over the canonical client of a rollapp carry its token metadata
+	rollapp, found, err := im.rollappkeeper.GetRollappByPortChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
 	if err != nil {
-		logger.Error("failed to extract clientID from channel", "err", err)
-		return im.app.OnRecvPacket(ctx, packet, relayer)
-	}
-
-	tmClientState, ok := clientState.(*ibctypes.ClientState)
-	if !ok {
-		logger.Error("failed to extract chainID from clientState")
-		return im.app.OnRecvPacket(ctx, packet, relayer)
+		logger.Error("failed to extract rollapp from channel", "err", err)
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
-
-	chainID := tmClientState.ChainId
-	rollapp, found := im.rollappkeeper.GetRollapp(ctx, chainID)
 	if !found {
-		logger.Debug("Skipping denommetadata middleware. Chain is not a rollapp. ", "chain_id", chainID, "err", err)
+		logger.Debug("Skipping denommetadata middleware. Channel is not bound to a rollapp.", "channel_id", packet.DestinationChannel)
 		return im.app.OnRecvPacket(ctx, packet, relayer)
 	}
+	chainID := rollapp.RollappId
 
 	// since SendPacket did not prefix the denomination, we must prefix denomination here
 	sourcePrefix := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
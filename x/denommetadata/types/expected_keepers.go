@@ -27,4 +27,5 @@ type BankKeeper interface {
 type RollappKeeper interface {
 	GetParams(ctx sdk.Context) rollapptypes.Params
 	GetRollapp(ctx sdk.Context, chainID string) (rollapp rollapptypes.Rollapp, found bool)
+	GetRollappByPortChannel(ctx sdk.Context, portID string, channelID string) (rollapp rollapptypes.Rollapp, found bool, err error)
 }
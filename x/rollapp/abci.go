@@ -15,8 +15,12 @@ import (
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper) {
 }
 
-// Called every block to finalize states that their dispute period over.
+// Called every block to finalize states that their dispute period over,
+// and to prune the finalized states beyond the retention.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// requeue the pending states if the dispute period param was changed
+	k.ApplyDisputePeriodChange(ctx)
+
 	// check to see if there are pending  states to be finalized
 	blockHeightToFinalizationQueue, found := k.GetBlockHeightToFinalizationQueue(ctx, uint64(ctx.BlockHeight()))
 	if !found {
@@ -24,6 +28,8 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// finalize pending states
+	var finalizedRollapps []string
+	finalized := make(map[string]bool)
 	for _, stateInfoIndex := range blockHeightToFinalizationQueue.FinalizationQueue {
 		stateInfo, found := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
 		if !found {
@@ -35,6 +41,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		k.SetStateInfo(ctx, stateInfo)
 		// uppdate the LatestStateInfoIndex of the rollapp
 		k.SetLatestFinalizedStateIndex(ctx, stateInfoIndex)
+		// a finalized state running the version of a pending upgrade applies it
+		k.ApplyFinalizedUpgrade(ctx, stateInfo)
+		if !finalized[stateInfoIndex.RollappId] {
+			finalized[stateInfoIndex.RollappId] = true
+			finalizedRollapps = append(finalizedRollapps, stateInfoIndex.RollappId)
+		}
 		// call the after-update-state hook
 		keeperHooks := k.GetHooks()
 		err := keeperHooks.AfterStateFinalized(ctx, stateInfoIndex.RollappId, &stateInfo)
@@ -54,4 +66,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		)
 
 	}
+
+	k.PruneFinalizedStates(ctx, finalizedRollapps)
 }
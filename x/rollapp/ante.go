@@ -0,0 +1,35 @@
+package rollapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/keeper"
+)
+
+// ClientUpdateDecorator rejects the updates of the canonical client of a rollapp whose headers
+// don't match the state roots posted by the rollapp sequencer
+type ClientUpdateDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewClientUpdateDecorator(keeper keeper.Keeper) ClientUpdateDecorator {
+	return ClientUpdateDecorator{keeper: keeper}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface
+func (d ClientUpdateDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		msg, ok := m.(*clienttypes.MsgUpdateClient)
+		if !ok {
+			continue
+		}
+		header, err := clienttypes.UnpackHeader(msg.Header)
+		if err != nil {
+			return ctx, err
+		}
+		if err := d.keeper.ValidateClientHeader(ctx, msg.ClientId, header); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
@@ -30,6 +30,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListStateInfo())
 	cmd.AddCommand(CmdShowStateInfo())
 	cmd.AddCommand(CmdShowLatestStateIndex())
+	cmd.AddCommand(CmdShowPendingUpgrade())
+	cmd.AddCommand(CmdShowBlockDescriptor())
 
 	// this line is used by starport scaffolding # 1
 
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowBlockDescriptor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-descriptor [rollapp-id] [height]",
+		Short: "Query the block descriptor of a rollapp height and the finalization status of its state",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryGetBlockDescriptorRequest{
+				RollappId: args[0],
+				Height:    argHeight,
+			}
+
+			res, err := queryClient.BlockDescriptor(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
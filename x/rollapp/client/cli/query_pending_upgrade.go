@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdShowPendingUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-upgrade [rollapp-id]",
+		Short: "Query the scheduled upgrade of the rollapp version",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetPendingUpgradeRequest{
+				RollappId: args[0],
+			}
+
+			res, err := queryClient.PendingUpgrade(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,6 +17,9 @@ const (
 	FlagStateIndex    = "index"
 	FlagRollappHeight = "rollapp-height"
 	FlagFinalized     = "finalized"
+	FlagStatus        = "status"
+	FlagMinCreation   = "min-creation-height"
+	FlagMaxCreation   = "max-creation-height"
 )
 
 func CmdListStateInfo() *cobra.Command {
@@ -32,11 +36,35 @@ func CmdListStateInfo() *cobra.Command {
 				return err
 			}
 
+			argStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			stateStatus := types.STATE_STATUS_UNSPECIFIED
+			if argStatus != "" {
+				val, ok := types.StateStatus_value["STATE_STATUS_"+strings.ToUpper(argStatus)]
+				if !ok {
+					return fmt.Errorf("invalid state status: %s", argStatus)
+				}
+				stateStatus = types.StateStatus(val)
+			}
+			argMinCreation, err := cmd.Flags().GetUint64(FlagMinCreation)
+			if err != nil {
+				return err
+			}
+			argMaxCreation, err := cmd.Flags().GetUint64(FlagMaxCreation)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllStateInfoRequest{
-				RollappId:  argRollappId,
-				Pagination: pageReq,
+				RollappId:         argRollappId,
+				Pagination:        pageReq,
+				Status:            stateStatus,
+				MinCreationHeight: argMinCreation,
+				MaxCreationHeight: argMaxCreation,
 			}
 
 			res, err := queryClient.StateInfoAll(context.Background(), params)
@@ -48,6 +76,9 @@ func CmdListStateInfo() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "Filter the states by status (received, finalized or reverted)")
+	cmd.Flags().Uint64(FlagMinCreation, 0, "Filter the states created from this hub height")
+	cmd.Flags().Uint64(FlagMaxCreation, 0, "Filter the states created until this hub height")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -26,6 +26,9 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdCreateRollapp())
 	cmd.AddCommand(CmdUpdateState())
+	cmd.AddCommand(CmdSetCanonicalClient())
+	cmd.AddCommand(CmdUpdateRollapp())
+	cmd.AddCommand(CmdScheduleRollappUpgrade())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
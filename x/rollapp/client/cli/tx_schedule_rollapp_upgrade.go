@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdScheduleRollappUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule-upgrade [rollapp-id] [version] [height]",
+		Short:   "Schedule an upgrade of the rollapp version from the given rollapp height",
+		Example: "dymd tx rollapp schedule-upgrade ROLLAPP_CHAIN_ID 1 1000",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRollappId := args[0]
+
+			argVersion, err := cast.ToUint64E(args[1])
+			if err != nil {
+				return err
+			}
+			argHeight, err := cast.ToUint64E(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgScheduleRollappUpgrade(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				argVersion,
+				argHeight,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetCanonicalClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-canonical-client [rollapp-id] [client-id] [channel-ids...]",
+		Short:   "Bind a rollapp to the IBC client, and optionally the transfer channels, its packets are received through",
+		Example: "dymd tx rollapp set-canonical-client ROLLAPP_CHAIN_ID 07-tendermint-0 channel-0",
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCanonicalClient(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2:],
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
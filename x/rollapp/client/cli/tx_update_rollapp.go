@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagAddPermissioned    = "add-permissioned"
+	FlagRemovePermissioned = "remove-permissioned"
+	FlagMaxSequencers      = "max-sequencers"
+	FlagAddMetadata        = "add-metadata"
+	FlagDisputePeriod      = "dispute-period"
+)
+
+func CmdUpdateRollapp() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-rollapp [rollapp-id]",
+		Short:   "Update the permissioned sequencers, the max sequencers, the token metadata or the dispute period of a rollapp",
+		Example: "dymd tx rollapp update-rollapp ROLLAPP_CHAIN_ID --add-permissioned dym1... --max-sequencers 10 --add-metadata metadata.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argRollappId := args[0]
+
+			addPermissioned, err := cmd.Flags().GetStringSlice(FlagAddPermissioned)
+			if err != nil {
+				return err
+			}
+			removePermissioned, err := cmd.Flags().GetStringSlice(FlagRemovePermissioned)
+			if err != nil {
+				return err
+			}
+			maxSequencers, err := cmd.Flags().GetUint64(FlagMaxSequencers)
+			if err != nil {
+				return err
+			}
+			metadataPath, err := cmd.Flags().GetString(FlagAddMetadata)
+			if err != nil {
+				return err
+			}
+			disputePeriod, err := cmd.Flags().GetUint64(FlagDisputePeriod)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var metadatas []types.TokenMetadata
+			if metadataPath != "" {
+				metadatas, err = parseTokenMetadata(clientCtx.Codec, metadataPath)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgUpdateRollapp(
+				clientCtx.GetFromAddress().String(),
+				argRollappId,
+				addPermissioned,
+				removePermissioned,
+				maxSequencers,
+				metadatas,
+				disputePeriod,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddPermissioned, nil, "Addresses of the sequencers to permission")
+	cmd.Flags().StringSlice(FlagRemovePermissioned, nil, "Addresses of the sequencers to revoke")
+	cmd.Flags().Uint64(FlagMaxSequencers, 0, "New maximum number of sequencers (can only be raised)")
+	cmd.Flags().String(FlagAddMetadata, "", "Path to a json file with the metadata of new tokens")
+	cmd.Flags().Uint64(FlagDisputePeriod, 0, "New dispute period in blocks, within the gov-set bounds")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -29,8 +29,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.BlockHeightToFinalizationQueueList {
 		k.SetBlockHeightToFinalizationQueue(ctx, elem)
 	}
+	// Set all the oldestStateInfoIndex
+	for _, elem := range genState.OldestStateInfoIndexList {
+		k.SetOldestStateInfoIndex(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+	k.SetAppliedDisputePeriod(ctx, genState.Params.DisputePeriodInBlocks)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -43,6 +48,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.LatestStateInfoIndexList = k.GetAllLatestStateInfoIndex(ctx)
 	genesis.LatestFinalizedStateIndexList = k.GetAllLatestFinalizedStateIndex(ctx)
 	genesis.BlockHeightToFinalizationQueueList = k.GetAllBlockHeightToFinalizationQueue(ctx)
+	genesis.OldestStateInfoIndexList = k.GetAllOldestStateInfoIndex(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -52,6 +52,12 @@ func TestGenesis(t *testing.T) {
 				FinalizationHeight: 1,
 			},
 		},
+		OldestStateInfoIndexList: []types.StateInfoIndex{
+			{
+				RollappId: "0",
+				Index:     2,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -67,5 +73,6 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StateInfoList, got.StateInfoList)
 	require.ElementsMatch(t, genesisState.LatestStateInfoIndexList, got.LatestStateInfoIndexList)
 	require.ElementsMatch(t, genesisState.BlockHeightToFinalizationQueueList, got.BlockHeightToFinalizationQueueList)
+	require.ElementsMatch(t, genesisState.OldestStateInfoIndexList, got.OldestStateInfoIndexList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -23,6 +23,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUpdateState:
 			res, err := msgServer.UpdateState(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSubmitFraud:
+			res, err := msgServer.SubmitFraud(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetCanonicalClient:
+			res, err := msgServer.SetCanonicalClient(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUpdateRollapp:
+			res, err := msgServer.UpdateRollapp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgScheduleRollappUpgrade:
+			res, err := msgServer.ScheduleRollappUpgrade(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgFreezeRollapp:
+			res, err := msgServer.FreezeRollapp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeregisterRollapp:
+			res, err := msgServer.DeregisterRollapp(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -0,0 +1,68 @@
+package rollapp
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	ibc "github.com/cosmos/ibc-go/v6/modules/core"
+	clientkeeper "github.com/cosmos/ibc-go/v6/modules/core/02-client/keeper"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+	ibckeeper "github.com/cosmos/ibc-go/v6/modules/core/keeper"
+	ibctypes "github.com/cosmos/ibc-go/v6/modules/core/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/keeper"
+)
+
+// IBCAppModule wraps the IBC core module so the updates of the canonical client of a rollapp are checked
+// against the state roots posted by the rollapp sequencer, however the MsgUpdateClient is executed
+// (e.g. through authz), and not only in the ante handler
+type IBCAppModule struct {
+	ibc.AppModule
+	ibcKeeper *ibckeeper.Keeper
+	keeper    keeper.Keeper
+}
+
+func NewIBCAppModule(ibcKeeper *ibckeeper.Keeper, keeper keeper.Keeper) IBCAppModule {
+	return IBCAppModule{
+		AppModule: ibc.NewAppModule(ibcKeeper),
+		ibcKeeper: ibcKeeper,
+		keeper:    keeper,
+	}
+}
+
+// RegisterServices registers the IBC core services, with the client update checked
+func (am IBCAppModule) RegisterServices(cfg module.Configurator) {
+	clienttypes.RegisterMsgServer(cfg.MsgServer(), clientMsgServer{MsgServer: am.ibcKeeper, keeper: am.keeper})
+	connectiontypes.RegisterMsgServer(cfg.MsgServer(), am.ibcKeeper)
+	channeltypes.RegisterMsgServer(cfg.MsgServer(), am.ibcKeeper)
+	ibctypes.RegisterQueryService(cfg.QueryServer(), am.ibcKeeper)
+
+	m := clientkeeper.NewMigrator(am.ibcKeeper.ClientKeeper)
+	err := cfg.RegisterMigration(host.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// clientMsgServer checks the headers of the canonical client updates before applying them
+type clientMsgServer struct {
+	clienttypes.MsgServer
+	keeper keeper.Keeper
+}
+
+// UpdateClient implements the clienttypes.MsgServer interface
+func (s clientMsgServer) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateClient) (*clienttypes.MsgUpdateClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	header, err := clienttypes.UnpackHeader(msg.Header)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keeper.ValidateClientHeader(ctx, msg.ClientId, header); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.UpdateClient(goCtx, msg)
+}
@@ -61,3 +61,38 @@ func (k Keeper) GetAllBlockHeightToFinalizationQueue(ctx sdk.Context) (list []ty
 
 	return
 }
+
+// RemoveStatesFromFinalizationQueue removes the states of the rollapp, starting from fromIndex,
+// from every finalization queue that was not processed yet (current block height and onwards)
+func (k Keeper) RemoveStatesFromFinalizationQueue(ctx sdk.Context, rollappId string, fromIndex uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	iterator := store.Iterator(types.BlockHeightToFinalizationQueueKey(uint64(ctx.BlockHeight())), nil)
+
+	var pendingQueues []types.BlockHeightToFinalizationQueue
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlockHeightToFinalizationQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		pendingQueues = append(pendingQueues, val)
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, queue := range pendingQueues {
+		newQueue := make([]types.StateInfoIndex, 0, len(queue.FinalizationQueue))
+		for _, stateInfoIndex := range queue.FinalizationQueue {
+			if stateInfoIndex.RollappId == rollappId && stateInfoIndex.Index >= fromIndex {
+				continue
+			}
+			newQueue = append(newQueue, stateInfoIndex)
+		}
+
+		if len(newQueue) == len(queue.FinalizationQueue) {
+			continue
+		}
+		if len(newQueue) == 0 {
+			k.RemoveBlockHeightToFinalizationQueue(ctx, queue.FinalizationHeight)
+			continue
+		}
+		queue.FinalizationQueue = newQueue
+		k.SetBlockHeightToFinalizationQueue(ctx, queue)
+	}
+}
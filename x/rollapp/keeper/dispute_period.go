@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// RollappDisputePeriodInBlocks returns the dispute period applied to the states of the rollapp.
+// A rollapp without its own dispute period follows the DisputePeriodInBlocks param, otherwise its
+// dispute period is kept within the current MinDisputePeriodInBlocks and MaxDisputePeriodInBlocks params.
+func (k Keeper) RollappDisputePeriodInBlocks(ctx sdk.Context, rollapp types.Rollapp) uint64 {
+	if rollapp.DisputePeriodInBlocks == 0 {
+		return k.DisputePeriodInBlocks(ctx)
+	}
+	if min := k.MinDisputePeriodInBlocks(ctx); rollapp.DisputePeriodInBlocks < min {
+		return min
+	}
+	if max := k.MaxDisputePeriodInBlocks(ctx); rollapp.DisputePeriodInBlocks > max {
+		return max
+	}
+	return rollapp.DisputePeriodInBlocks
+}
+
+// SetAppliedDisputePeriod stores the global dispute period the finalization queues were built with
+func (k Keeper) SetAppliedDisputePeriod(ctx sdk.Context, disputePeriodInBlocks uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.AppliedDisputePeriodKey), sdk.Uint64ToBigEndian(disputePeriodInBlocks))
+}
+
+// GetAppliedDisputePeriod returns the global dispute period the finalization queues were built with
+func (k Keeper) GetAppliedDisputePeriod(ctx sdk.Context) (val uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.KeyPrefix(types.AppliedDisputePeriodKey))
+	if b == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(b), true
+}
+
+// ApplyDisputePeriodChange extends the dispute period of the pending states of the rollapps following the
+// global dispute period once the DisputePeriodInBlocks param was increased. A decreased dispute period, and changes
+// of the MinDisputePeriodInBlocks and MaxDisputePeriodInBlocks params, only apply to the states submitted after the change.
+func (k Keeper) ApplyDisputePeriodChange(ctx sdk.Context) {
+	disputePeriod := k.DisputePeriodInBlocks(ctx)
+	applied, found := k.GetAppliedDisputePeriod(ctx)
+	if found && applied != disputePeriod {
+		k.requeuePendingStates(ctx, "")
+	}
+	if !found || applied != disputePeriod {
+		k.SetAppliedDisputePeriod(ctx, disputePeriod)
+	}
+}
+
+// requeuePendingStates moves the pending states to the finalization height of their rollapp's current dispute
+// period, counted from the state creation height, if it is later than their current one. The dispute period of
+// a pending state is never shortened, so it can still be disputed for as long as it was announced when submitted.
+// An empty rollappId requeues the states of all the rollapps following the global dispute period.
+func (k Keeper) requeuePendingStates(ctx sdk.Context, rollappId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	iterator := store.Iterator(types.BlockHeightToFinalizationQueueKey(uint64(ctx.BlockHeight())), nil)
+
+	var pendingQueues []types.BlockHeightToFinalizationQueue
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BlockHeightToFinalizationQueue
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		pendingQueues = append(pendingQueues, val)
+	}
+	iterator.Close() // nolint: errcheck
+
+	// the dispute period of each requeued rollapp, rollapps not requeued are mapped to zero
+	disputePeriods := make(map[string]uint64)
+	disputePeriodOf := func(id string) uint64 {
+		if period, ok := disputePeriods[id]; ok {
+			return period
+		}
+		var period uint64
+		rollapp, found := k.GetRollapp(ctx, id)
+		if found && (id == rollappId || (rollappId == "" && rollapp.DisputePeriodInBlocks == 0)) {
+			period = k.RollappDisputePeriodInBlocks(ctx, rollapp)
+		}
+		disputePeriods[id] = period
+		return period
+	}
+
+	requeued := make(map[uint64][]types.StateInfoIndex)
+	for _, queue := range pendingQueues {
+		newQueue := make([]types.StateInfoIndex, 0, len(queue.FinalizationQueue))
+		for _, stateInfoIndex := range queue.FinalizationQueue {
+			period := disputePeriodOf(stateInfoIndex.RollappId)
+			stateInfo, found := k.GetStateInfo(ctx, stateInfoIndex.RollappId, stateInfoIndex.Index)
+			if period == 0 || !found {
+				newQueue = append(newQueue, stateInfoIndex)
+				continue
+			}
+			finalizationHeight := stateInfo.CreationHeight + period
+			if finalizationHeight <= queue.FinalizationHeight {
+				newQueue = append(newQueue, stateInfoIndex)
+				continue
+			}
+			stateInfo.FinalizationHeight = finalizationHeight
+			k.SetStateInfo(ctx, stateInfo)
+			requeued[finalizationHeight] = append(requeued[finalizationHeight], stateInfoIndex)
+		}
+
+		if len(newQueue) == len(queue.FinalizationQueue) {
+			continue
+		}
+		if len(newQueue) == 0 {
+			k.RemoveBlockHeightToFinalizationQueue(ctx, queue.FinalizationHeight)
+			continue
+		}
+		queue.FinalizationQueue = newQueue
+		k.SetBlockHeightToFinalizationQueue(ctx, queue)
+	}
+
+	// append to the target queues in a deterministic order
+	heights := make([]uint64, 0, len(requeued))
+	for height := range requeued {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	for _, height := range heights {
+		queue, found := k.GetBlockHeightToFinalizationQueue(ctx, height)
+		if !found {
+			queue = types.BlockHeightToFinalizationQueue{FinalizationHeight: height}
+		}
+		queue.FinalizationQueue = append(queue.FinalizationQueue, requeued[height]...)
+		// the states of a rollapp are finalized in the order of their index
+		sort.SliceStable(queue.FinalizationQueue, func(i, j int) bool {
+			return queue.FinalizationQueue[i].Index < queue.FinalizationQueue[j].Index
+		})
+		k.SetBlockHeightToFinalizationQueue(ctx, queue)
+	}
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// setRollappWithProposer sets the rollapp and registers the sequencer as its proposer
+func (suite *RollappTestSuite) setRollappWithProposer(rollapp types.Rollapp, proposer string) {
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencertypes.Sequencer{
+		SequencerAddress: proposer,
+		RollappIDs:       []string{rollapp.RollappId},
+	})
+	suite.app.SequencerKeeper.SetScheduler(suite.ctx, sequencertypes.Scheduler{
+		SequencerAddress: proposer,
+		Status:           sequencertypes.Proposer,
+	})
+}
+
+// requireFinalizationHeight checks the state is queued for finalization at the given height
+func (suite *RollappTestSuite) requireFinalizationHeight(stateInfoIndex types.StateInfoIndex, height uint64) {
+	queue, found := suite.app.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.ctx, height)
+	suite.Require().True(found)
+	suite.Require().Contains(queue.FinalizationQueue, stateInfoIndex)
+}
+
+func (suite *RollappTestSuite) TestRollappDisputePeriod() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	params := suite.app.RollappKeeper.GetParams(suite.ctx)
+	params.MaxDisputePeriodInBlocks = 100
+	suite.app.RollappKeeper.SetParams(suite.ctx, params)
+
+	suite.setRollappWithProposer(types.Rollapp{
+		RollappId:             "rollapp1",
+		Creator:               alice,
+		Version:               3,
+		MaxSequencers:         1,
+		DisputePeriodInBlocks: 10,
+	}, bob)
+
+	// the state is finalized after the dispute period of the rollapp
+	_, err := suite.msgServer.UpdateState(goCtx, &types.MsgUpdateState{
+		Creator:     bob,
+		RollappId:   "rollapp1",
+		StartHeight: 1,
+		NumBlocks:   1,
+		Version:     3,
+		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1}}},
+	})
+	suite.Require().Nil(err)
+	stateInfoIndex := types.StateInfoIndex{RollappId: "rollapp1", Index: 1}
+	suite.requireFinalizationHeight(stateInfoIndex, 20)
+
+	// the dispute period must be within the bounds
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, nil, 0, nil, 101))
+	suite.Require().ErrorIs(err, types.ErrInvalidDisputePeriod)
+
+	// a shortened dispute period doesn't apply to the pending states
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, nil, 0, nil, 5))
+	suite.Require().Nil(err)
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().Equal(uint64(5), rollapp.DisputePeriodInBlocks)
+	suite.requireFinalizationHeight(stateInfoIndex, 20)
+	_, found := suite.app.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.ctx, 15)
+	suite.Require().False(found)
+
+	// a state submitted after the change isn't finalized before the pending ones
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.msgServer.UpdateState(goCtx, &types.MsgUpdateState{
+		Creator:     bob,
+		RollappId:   "rollapp1",
+		StartHeight: 2,
+		NumBlocks:   1,
+		Version:     3,
+		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 2}}},
+	})
+	suite.Require().Nil(err)
+	nextStateInfoIndex := types.StateInfoIndex{RollappId: "rollapp1", Index: 2}
+	suite.requireFinalizationHeight(nextStateInfoIndex, 20)
+	suite.ctx = suite.ctx.WithBlockHeight(16)
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	stateInfo, _ := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", 2)
+	suite.Require().Equal(types.STATE_STATUS_RECEIVED, stateInfo.Status)
+
+	// a longer dispute period requeues the pending states
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, nil, 0, nil, 30))
+	suite.Require().Nil(err)
+	suite.requireFinalizationHeight(stateInfoIndex, 40)
+	suite.requireFinalizationHeight(nextStateInfoIndex, 41)
+	_, found = suite.app.RollappKeeper.GetBlockHeightToFinalizationQueue(suite.ctx, 20)
+	suite.Require().False(found)
+	stateInfo, _ = suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", 1)
+	suite.Require().Equal(uint64(40), stateInfo.FinalizationHeight)
+}
+
+func (suite *RollappTestSuite) TestDisputePeriodParamChange() {
+	suite.SetupTest()
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// rollapp1 follows the global dispute period, rollapp2 has its own
+	suite.setRollappWithProposer(types.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		Version:       3,
+		MaxSequencers: 1,
+	}, bob)
+	suite.setRollappWithProposer(types.Rollapp{
+		RollappId:             "rollapp2",
+		Creator:               alice,
+		Version:               3,
+		MaxSequencers:         1,
+		DisputePeriodInBlocks: 10,
+	}, carol)
+
+	for rollappId, sequencer := range map[string]string{"rollapp1": bob, "rollapp2": carol} {
+		_, err := suite.msgServer.UpdateState(goCtx, &types.MsgUpdateState{
+			Creator:     sequencer,
+			RollappId:   rollappId,
+			StartHeight: 1,
+			NumBlocks:   1,
+			Version:     3,
+			BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1}}},
+		})
+		suite.Require().Nil(err)
+	}
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	suite.requireFinalizationHeight(types.StateInfoIndex{RollappId: "rollapp1", Index: 1}, 12)
+	suite.requireFinalizationHeight(types.StateInfoIndex{RollappId: "rollapp2", Index: 1}, 20)
+
+	// the param change requeues the states of the rollapps following the global dispute period
+	params := suite.app.RollappKeeper.GetParams(suite.ctx)
+	params.DisputePeriodInBlocks = 50
+	suite.app.RollappKeeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockHeight(11)
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	suite.requireFinalizationHeight(types.StateInfoIndex{RollappId: "rollapp1", Index: 1}, 60)
+	suite.requireFinalizationHeight(types.StateInfoIndex{RollappId: "rollapp2", Index: 1}, 20)
+	applied, found := suite.app.RollappKeeper.GetAppliedDisputePeriod(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(50), applied)
+
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	stateInfo, _ := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", 1)
+	suite.Require().Equal(types.STATE_STATUS_RECEIVED, stateInfo.Status)
+
+	// shortening the param back doesn't apply to the pending states
+	params.DisputePeriodInBlocks = 2
+	suite.app.RollappKeeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockHeight(13)
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	suite.requireFinalizationHeight(types.StateInfoIndex{RollappId: "rollapp1", Index: 1}, 60)
+}
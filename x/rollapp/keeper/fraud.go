@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// RevertPendingStates reverts the state at fromIndex and every state that followed it.
+// All the reverted states must still be in their dispute period (STATE_STATUS_RECEIVED).
+// The reverted states are pulled from the finalization queue and the LatestStateInfoIndex
+// of the rollapp is set back to the last state preceding fromIndex, so the next
+// state update is expected to start from the first reverted height.
+func (k Keeper) RevertPendingStates(ctx sdk.Context, rollappId string, fromIndex uint64) ([]types.StateInfo, error) {
+	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollappId)
+	if !found || fromIndex == 0 || fromIndex > latestStateInfoIndex.Index {
+		return nil, sdkerrors.Wrapf(types.ErrStateNotExists,
+			"rollappId=%s, index=%d", rollappId, fromIndex)
+	}
+
+	// load and validate all the states before changing anything
+	revertedStates := make([]types.StateInfo, 0, latestStateInfoIndex.Index-fromIndex+1)
+	for index := fromIndex; index <= latestStateInfoIndex.Index; index++ {
+		stateInfo, found := k.GetStateInfo(ctx, rollappId, index)
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+				"StateInfo wasn't found for rollappId=%s, index=%d",
+				rollappId, index)
+		}
+		if stateInfo.Status != types.STATE_STATUS_RECEIVED {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStateStatus,
+				"rollappId=%s, index=%d, status=%s",
+				rollappId, index, stateInfo.Status)
+		}
+		revertedStates = append(revertedStates, stateInfo)
+	}
+
+	for i := range revertedStates {
+		revertedStates[i].Status = types.STATE_STATUS_REVERTED
+		k.SetStateInfo(ctx, revertedStates[i])
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeStatusChange,
+				sdk.NewAttribute(types.AttributeKeyRollappId, rollappId),
+				sdk.NewAttribute(types.AttributeKeyStateInfoIndex, strconv.FormatUint(revertedStates[i].StateInfoIndex.Index, 10)),
+				sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatUint(revertedStates[i].StartHeight, 10)),
+				sdk.NewAttribute(types.AttributeKeyNumBlocks, strconv.FormatUint(revertedStates[i].NumBlocks, 10)),
+				sdk.NewAttribute(types.AttributeKeyStatus, revertedStates[i].Status.String()),
+			),
+		)
+	}
+
+	k.RemoveStatesFromFinalizationQueue(ctx, rollappId, fromIndex)
+
+	// roll back the latest index to the last valid state
+	if fromIndex == 1 {
+		k.RemoveLatestStateInfoIndex(ctx, rollappId)
+	} else {
+		k.SetLatestStateInfoIndex(ctx, types.StateInfoIndex{
+			RollappId: rollappId,
+			Index:     fromIndex - 1,
+		})
+	}
+
+	return revertedStates, nil
+}
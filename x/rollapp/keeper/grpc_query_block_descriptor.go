@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockDescriptor returns the BlockDescriptor of a rollapp height, with the index, the sequencer and the
+// finalization status of the state that posted it
+func (k Keeper) BlockDescriptor(c context.Context, req *types.QueryGetBlockDescriptorRequest) (*types.QueryGetBlockDescriptorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stateInfo, err := k.FindStateInfoByHeight(ctx, req.RollappId, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	bd, found := getBlockDescriptor(stateInfo, req.Height)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetBlockDescriptorResponse{
+		BlockDescriptor: bd,
+		StateInfoIndex:  stateInfo.StateInfoIndex,
+		Sequencer:       stateInfo.Sequencer,
+		Status:          stateInfo.Status,
+	}, nil
+}
+
+// getBlockDescriptor returns the BlockDescriptor of the height from the state
+func getBlockDescriptor(stateInfo *types.StateInfo, height uint64) (types.BlockDescriptor, bool) {
+	for _, bd := range stateInfo.BDs.BD {
+		if bd.Height == height {
+			return bd, true
+		}
+	}
+	return types.BlockDescriptor{}, false
+}
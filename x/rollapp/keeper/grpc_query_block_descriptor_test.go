@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBlockDescriptorQuery(t *testing.T) {
+	keeper, ctx := keepertest.RollappKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	rollappId := "rollappId"
+
+	keeper.SetRollapp(ctx, types.Rollapp{RollappId: rollappId})
+	stateInfos := []types.StateInfo{
+		{
+			StateInfoIndex: types.StateInfoIndex{RollappId: rollappId, Index: 1},
+			Sequencer:      "sequencer1",
+			StartHeight:    1,
+			NumBlocks:      2,
+			Status:         types.STATE_STATUS_FINALIZED,
+			BDs:            types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 1, StateRoot: []byte{1}}, {Height: 2, StateRoot: []byte{2}}}},
+		},
+		{
+			StateInfoIndex: types.StateInfoIndex{RollappId: rollappId, Index: 2},
+			Sequencer:      "sequencer2",
+			StartHeight:    3,
+			NumBlocks:      2,
+			Status:         types.STATE_STATUS_RECEIVED,
+			BDs:            types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 3, StateRoot: []byte{3}}}},
+		},
+	}
+	for _, stateInfo := range stateInfos {
+		keeper.SetStateInfo(ctx, stateInfo)
+	}
+	keeper.SetLatestStateInfoIndex(ctx, stateInfos[1].StateInfoIndex)
+
+	for _, tc := range []struct {
+		desc     string
+		request  *types.QueryGetBlockDescriptorRequest
+		response *types.QueryGetBlockDescriptorResponse
+		err      error
+	}{
+		{
+			desc:    "Finalized",
+			request: &types.QueryGetBlockDescriptorRequest{RollappId: rollappId, Height: 2},
+			response: &types.QueryGetBlockDescriptorResponse{
+				BlockDescriptor: stateInfos[0].BDs.BD[1],
+				StateInfoIndex:  stateInfos[0].StateInfoIndex,
+				Sequencer:       "sequencer1",
+				Status:          types.STATE_STATUS_FINALIZED,
+			},
+		},
+		{
+			desc:    "Pending",
+			request: &types.QueryGetBlockDescriptorRequest{RollappId: rollappId, Height: 3},
+			response: &types.QueryGetBlockDescriptorResponse{
+				BlockDescriptor: stateInfos[1].BDs.BD[0],
+				StateInfoIndex:  stateInfos[1].StateInfoIndex,
+				Sequencer:       "sequencer2",
+				Status:          types.STATE_STATUS_RECEIVED,
+			},
+		},
+		{
+			desc:    "MissingDescriptor",
+			request: &types.QueryGetBlockDescriptorRequest{RollappId: rollappId, Height: 4},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "HeightNotPosted",
+			request: &types.QueryGetBlockDescriptorRequest{RollappId: rollappId, Height: 5},
+			err:     types.ErrStateNotExists,
+		},
+		{
+			desc:    "UnknownRollapp",
+			request: &types.QueryGetBlockDescriptorRequest{RollappId: "unknown", Height: 1},
+			err:     types.ErrUnknownRollappID,
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := keeper.BlockDescriptor(wctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
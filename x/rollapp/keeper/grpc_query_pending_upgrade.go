@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PendingUpgrade(c context.Context, req *types.QueryGetPendingUpgradeRequest) (*types.QueryGetPendingUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rollapp, found := k.GetRollapp(ctx, req.RollappId)
+	if !found || rollapp.PendingUpgrade == nil {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetPendingUpgradeResponse{
+		Version: rollapp.Version,
+		Upgrade: *rollapp.PendingUpgrade,
+	}, nil
+}
@@ -12,8 +12,9 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// StateInfoAll returns the states of a rollapp, filtered by status and creation height
 func (k Keeper) StateInfoAll(c context.Context, req *types.QueryAllStateInfoRequest) (*types.QueryAllStateInfoResponse, error) {
-	if req == nil {
+	if req == nil || req.RollappId == "" {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
@@ -21,22 +22,30 @@ func (k Keeper) StateInfoAll(c context.Context, req *types.QueryAllStateInfoRequ
 	ctx := sdk.UnwrapSDKContext(c)
 
 	store := ctx.KVStore(k.storeKey)
-	stateInfoStore := prefix.NewStore(store, types.KeyPrefix(types.StateInfoKeyPrefix))
+	stateInfoStore := prefix.NewStore(store, append(types.KeyPrefix(types.StateInfoKeyPrefix), types.StateInfoByRollappKey(req.RollappId)...))
 
-	pageRes, err := query.Paginate(stateInfoStore, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(stateInfoStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var stateInfo types.StateInfo
 		if err := k.cdc.Unmarshal(value, &stateInfo); err != nil {
-			return err
+			return false, err
+		}
+		if req.Status != types.STATE_STATUS_UNSPECIFIED && stateInfo.Status != req.Status {
+			return false, nil
+		}
+		if stateInfo.CreationHeight < req.MinCreationHeight {
+			return false, nil
 		}
-		if stateInfo.StateInfoIndex.RollappId == req.RollappId {
-			stateInfoSummary := types.StateInfoSummary{
+		if req.MaxCreationHeight != 0 && stateInfo.CreationHeight > req.MaxCreationHeight {
+			return false, nil
+		}
+		if accumulate {
+			stateInfos = append(stateInfos, types.StateInfoSummary{
 				StateInfoIndex: stateInfo.StateInfoIndex,
 				Status:         stateInfo.Status,
 				CreationHeight: stateInfo.CreationHeight,
-			}
-			stateInfos = append(stateInfos, stateInfoSummary)
+			})
 		}
-		return nil
+		return true, nil
 	})
 
 	if err != nil {
@@ -73,6 +82,11 @@ func (k Keeper) StateInfo(c context.Context, req *types.QueryGetStateInfoRequest
 
 	var stateInfo types.StateInfo
 	if req.Index != 0 {
+		if oldest, found := k.GetOldestStateInfoIndex(ctx, req.RollappId); found && req.Index < oldest.Index {
+			return nil, sdkerrors.Wrapf(types.ErrStatePruned,
+				"rollappId=%s, index=%d, oldest available index=%d",
+				req.RollappId, req.Index, oldest.Index)
+		}
 		val, found := k.GetStateInfo(ctx, req.RollappId, req.Index)
 		if !found {
 			return nil, status.Error(codes.NotFound, "not found")
@@ -106,8 +120,8 @@ func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height
 			"LatestStateInfoIndex wasn't found for rollappId=%s",
 			rollappId)
 	}
-	// initial interval to search in
-	startInfoIndex := uint64(1) // see TODO bellow
+	// initial interval to search in, the states before the oldest index were pruned
+	startInfoIndex := k.oldestStateIndex(ctx, rollappId)
 	endInfoIndex := stateInfoIndex.Index
 
 	// get state info
@@ -130,6 +144,19 @@ func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height
 		return &LatestStateInfo, nil
 	}
 
+	// check that height was not pruned
+	oldestStateInfo, found := k.GetStateInfo(ctx, rollappId, startInfoIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			"StateInfo wasn't found for rollappId=%s, index=%d",
+			rollappId, startInfoIndex)
+	}
+	if height < oldestStateInfo.StartHeight {
+		return nil, sdkerrors.Wrapf(types.ErrStatePruned,
+			"rollappId=%s, height=%d, oldest available height=%d",
+			rollappId, height, oldestStateInfo.StartHeight)
+	}
+
 	maxNumberOfSteps := endInfoIndex - startInfoIndex + 1
 	stepNum := uint64(0)
 	for ; stepNum < maxNumberOfSteps; stepNum += 1 {
@@ -143,9 +170,6 @@ func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height
 		// 1. get state info
 		startStateInfo, found := k.GetStateInfo(ctx, rollappId, startInfoIndex)
 		if !found {
-			// TODO:
-			// if stateInfo is missing it won't be logic error if history deletion be implemented
-			// for that we will have to check the oldest we have
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"StateInfo wasn't found for rollappId=%s, index=%d",
 				rollappId, startInfoIndex)
@@ -12,6 +12,7 @@ import (
 
 	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
 	"github.com/dymensionxyz/dymension/testutil/nullify"
+	"github.com/dymensionxyz/dymension/x/rollapp/keeper"
 	"github.com/dymensionxyz/dymension/x/rollapp/types"
 )
 
@@ -80,13 +81,37 @@ func TestStateInfoQuerySingle(t *testing.T) {
 	}
 }
 
+// createNRollappStateInfo creates n states of the rollapp, alternating their status,
+// and states of another rollapp sharing the rollapp id as a prefix
+func createNRollappStateInfo(keeper *keeper.Keeper, ctx sdk.Context, n int, rollappId string) []types.StateInfoSummary {
+	var summaries []types.StateInfoSummary
+	for i := 1; i <= n; i++ {
+		stateInfo := types.StateInfo{
+			StateInfoIndex: types.StateInfoIndex{RollappId: rollappId, Index: uint64(i)},
+			CreationHeight: uint64(10 * i),
+			Status:         types.StateStatus(1 + i%2),
+		}
+		keeper.SetStateInfo(ctx, stateInfo)
+		// states of rollapps whose ids are prefixed by the rollapp id
+		keeper.SetStateInfo(ctx, types.StateInfo{StateInfoIndex: types.StateInfoIndex{RollappId: rollappId + "0", Index: uint64(i)}})
+		keeper.SetStateInfo(ctx, types.StateInfo{StateInfoIndex: types.StateInfoIndex{RollappId: rollappId + "/0", Index: uint64(i)}})
+		summaries = append(summaries, types.StateInfoSummary{
+			StateInfoIndex: stateInfo.StateInfoIndex,
+			Status:         stateInfo.Status,
+			CreationHeight: stateInfo.CreationHeight,
+		})
+	}
+	return summaries
+}
+
 func TestStateInfoQueryPaginated(t *testing.T) {
 	keeper, ctx := keepertest.RollappKeeper(t)
 	wctx := sdk.WrapSDKContext(ctx)
-	_, msgs := createNStateInfo(keeper, ctx, 5)
+	msgs := createNRollappStateInfo(keeper, ctx, 5, "rollapp1")
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QueryAllStateInfoRequest {
 		return &types.QueryAllStateInfoRequest{
+			RollappId: "rollapp1",
 			Pagination: &query.PageRequest{
 				Key:        next,
 				Offset:     offset,
@@ -125,9 +150,40 @@ func TestStateInfoQueryPaginated(t *testing.T) {
 		resp, err := keeper.StateInfoAll(wctx, request(nil, 0, 0, true))
 		require.NoError(t, err)
 		require.Equal(t, len(msgs), int(resp.Pagination.Total))
+		require.Equal(t, msgs, resp.StateInfo)
+	})
+	t.Run("Reverse", func(t *testing.T) {
+		req := request(nil, 0, 2, false)
+		req.Pagination.Reverse = true
+		resp, err := keeper.StateInfoAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []types.StateInfoSummary{msgs[4], msgs[3]}, resp.StateInfo)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		req := request(nil, 0, 0, false)
+		req.Status = types.STATE_STATUS_RECEIVED
+		resp, err := keeper.StateInfoAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []types.StateInfoSummary{msgs[1], msgs[3]}, resp.StateInfo)
+
+		req = request(nil, 0, 0, false)
+		req.MinCreationHeight = 20
+		req.MaxCreationHeight = 40
+		resp, err = keeper.StateInfoAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, msgs[1:4], resp.StateInfo)
+
+		// the pages are filled with the matching states
+		req = request(nil, 0, 2, false)
+		req.Status = types.STATE_STATUS_FINALIZED
+		resp, err = keeper.StateInfoAll(wctx, req)
+		require.NoError(t, err)
+		require.Equal(t, []types.StateInfoSummary{msgs[0], msgs[2]}, resp.StateInfo)
 	})
 	t.Run("InvalidRequest", func(t *testing.T) {
 		_, err := keeper.StateInfoAll(wctx, nil)
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+		_, err = keeper.StateInfoAll(wctx, &types.QueryAllStateInfoRequest{})
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
@@ -19,6 +19,16 @@ type (
 		memKey     storetypes.StoreKey
 		hooks      types.MultiRollappHooks
 		paramstore paramtypes.Subspace
+
+		channelKeeper   types.ChannelKeeper
+		clientKeeper    types.ClientKeeper
+		bankKeeper      types.BankKeeper
+		distrKeeper     types.DistrKeeper
+		sequencerKeeper types.SequencerKeeper
+
+		// the address capable of executing privileged messages (e.g. MsgSubmitFraud).
+		// Typically, this should be the x/gov module account.
+		authority string
 	}
 )
 
@@ -27,7 +37,11 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	ps paramtypes.Subspace,
-
+	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistrKeeper,
+	authority string,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -41,9 +55,20 @@ func NewKeeper(
 		memKey:     memKey,
 		paramstore: ps,
 		hooks:      nil,
+		authority:  authority,
+
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
 	}
 }
 
+// GetAuthority returns the address allowed to execute privileged messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -56,6 +81,14 @@ func (k *Keeper) SetHooks(sh types.MultiRollappHooks) {
 	k.hooks = sh
 }
 
+// SetSequencerKeeper sets the sequencer keeper, which is created after the rollapp keeper
+func (k *Keeper) SetSequencerKeeper(sk types.SequencerKeeper) {
+	if k.sequencerKeeper != nil {
+		panic("cannot set sequencer keeper twice")
+	}
+	k.sequencerKeeper = sk
+}
+
 func (k *Keeper) GetHooks() types.MultiRollappHooks {
 	return k.hooks
 }
@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// ValidateClientHeader checks the header of an update of the canonical client of a rollapp against the
+// state root posted by the rollapp sequencer for the same height.
+// Headers of clients which are not bound to a rollapp are not checked, and headers of heights without a posted
+// state yet are checked once the state is posted (see checkClientConsensusStates).
+// The client of a frozen or deregistered rollapp can not be updated.
+func (k Keeper) ValidateClientHeader(ctx sdk.Context, clientID string, header exported.Header) error {
+	rollappId, found := k.getRollappIdByClientID(ctx, clientID)
+	if !found {
+		return nil
+	}
+	rollapp, found := k.GetRollapp(ctx, rollappId)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRollappDeregistered, "rollappId=%s", rollappId)
+	}
+	if rollapp.Frozen {
+		return sdkerrors.Wrapf(types.ErrRollappFrozen, "rollappId=%s", rollappId)
+	}
+
+	tmHeader, ok := header.(*ibctmtypes.Header)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidClientType, "unexpected header type %T for rollapp %s", header, rollapp.RollappId)
+	}
+	height := uint64(tmHeader.Header.Height)
+
+	stateRoot, found, err := k.getStateRoot(ctx, rollapp.RollappId, height)
+	if err != nil || !found {
+		return err
+	}
+
+	if !bytes.Equal(stateRoot, tmHeader.Header.AppHash) {
+		return sdkerrors.Wrapf(types.ErrInvalidAppHash, "rollappId=%s, height=%d", rollapp.RollappId, height)
+	}
+	return nil
+}
+
+// getStateRoot returns the state root posted for a rollapp height, if any.
+// The blocks of reverted states are ignored.
+func (k Keeper) getStateRoot(ctx sdk.Context, rollappId string, height uint64) ([]byte, bool, error) {
+	if _, found := k.GetLatestStateInfoIndex(ctx, rollappId); !found {
+		return nil, false, nil
+	}
+	stateInfo, err := k.FindStateInfoByHeight(ctx, rollappId, height)
+	if errors.Is(err, types.ErrStateNotExists) || errors.Is(err, types.ErrStatePruned) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if stateInfo.Status == types.STATE_STATUS_REVERTED {
+		return nil, false, nil
+	}
+
+	bd, found := getBlockDescriptor(stateInfo, height)
+	if !found || len(bd.StateRoot) == 0 {
+		return nil, false, nil
+	}
+	return bd.StateRoot, true, nil
+}
+
+// checkClientConsensusStates checks the state roots of a posted state against the consensus states the canonical
+// client of the rollapp got for the same heights before the state was posted.
+// Both are signed by the rollapp sequencer, so a mismatch is a fraud and the rollapp is frozen.
+func (k Keeper) checkClientConsensusStates(ctx sdk.Context, rollapp types.Rollapp, stateInfo types.StateInfo) error {
+	if rollapp.ClientId == "" {
+		return nil
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, rollapp.ClientId)
+	if !found {
+		return nil
+	}
+	revision := clientState.GetLatestHeight().GetRevisionNumber()
+
+	for _, bd := range stateInfo.BDs.BD {
+		consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, rollapp.ClientId, clienttypes.NewHeight(revision, bd.Height))
+		if !found || bytes.Equal(consensusState.GetRoot().GetHash(), bd.StateRoot) {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeFraud,
+				sdk.NewAttribute(types.AttributeKeyRollappId, rollapp.RollappId),
+				sdk.NewAttribute(types.AttributeKeyStateInfoIndex, strconv.FormatUint(stateInfo.StateInfoIndex.Index, 10)),
+				sdk.NewAttribute(types.AttributeKeyClientId, rollapp.ClientId),
+				sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatUint(bd.Height, 10)),
+			),
+		)
+		return k.freezeRollapp(ctx, rollapp)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	v3 "github.com/dymensionxyz/dymension/x/rollapp/migrations/v3"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if err := v3.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore); err != nil {
+		return err
+	}
+	m.bindCanonicalClients(ctx)
+	return nil
+}
+
+// bindCanonicalClients binds the existing rollapps to their canonical client.
+// A rollapp is bound only if a single tendermint client tracks its chain-id,
+// otherwise the canonical client must be set by the rollapp creator.
+func (m Migrator) bindCanonicalClients(ctx sdk.Context) {
+	clientsByChainID := make(map[string][]string)
+	m.keeper.clientKeeper.IterateClients(ctx, func(clientID string, cs exported.ClientState) bool {
+		if tmClientState, ok := cs.(*ibctmtypes.ClientState); ok {
+			clientsByChainID[tmClientState.ChainId] = append(clientsByChainID[tmClientState.ChainId], clientID)
+		}
+		return false
+	})
+
+	for _, rollapp := range m.keeper.GetAllRollapp(ctx) {
+		if rollapp.ClientId != "" {
+			continue
+		}
+		clientIDs := clientsByChainID[rollapp.RollappId]
+		if len(clientIDs) != 1 {
+			continue
+		}
+		if _, found := m.keeper.getRollappIdByClientID(ctx, clientIDs[0]); found {
+			continue
+		}
+		rollapp.ClientId = clientIDs[0]
+		m.keeper.SetRollapp(ctx, rollapp)
+	}
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+func (suite *RollappTestSuite) TestMigrate2to3BindsCanonicalClients() {
+	suite.SetupTest()
+	ctx := suite.ctx
+	clientKeeper := suite.app.IBCKeeper.ClientKeeper
+
+	// a rollapp tracked by a single client, and a rollapp tracked by two clients
+	clientKeeper.SetClientState(ctx, "07-tendermint-0", &ibctmtypes.ClientState{ChainId: "rollapp_1234-1"})
+	clientKeeper.SetClientState(ctx, "07-tendermint-1", &ibctmtypes.ClientState{ChainId: "rollapp_2345-1"})
+	clientKeeper.SetClientState(ctx, "07-tendermint-2", &ibctmtypes.ClientState{ChainId: "rollapp_2345-1"})
+	suite.app.RollappKeeper.SetRollapp(ctx, types.Rollapp{RollappId: "rollapp_1234-1", Creator: alice})
+	suite.app.RollappKeeper.SetRollapp(ctx, types.Rollapp{RollappId: "rollapp_2345-1", Creator: alice})
+
+	err := keeper.NewMigrator(suite.app.RollappKeeper).Migrate2to3(ctx)
+	suite.Require().NoError(err)
+
+	rollapp, found := suite.app.RollappKeeper.GetRollapp(ctx, "rollapp_1234-1")
+	suite.Require().True(found)
+	suite.Require().Equal("07-tendermint-0", rollapp.ClientId)
+	rollapp, found = suite.app.RollappKeeper.GetRollappByClientID(ctx, "07-tendermint-0")
+	suite.Require().True(found)
+	suite.Require().Equal("rollapp_1234-1", rollapp.RollappId)
+
+	// the canonical client of an ambiguous rollapp is left to its creator
+	rollapp, found = suite.app.RollappKeeper.GetRollapp(ctx, "rollapp_2345-1")
+	suite.Require().True(found)
+	suite.Require().Empty(rollapp.ClientId)
+}
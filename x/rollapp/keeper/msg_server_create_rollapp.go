@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dymensionxyz/dymension/x/rollapp/types"
 )
 
@@ -19,6 +20,10 @@ func (k msgServer) CreateRollapp(goCtx context.Context, msg *types.MsgCreateRoll
 		return nil, types.ErrRollappExists
 	}
 
+	if err := k.validateRollappId(ctx, msg.RollappId); err != nil {
+		return nil, err
+	}
+
 	// check to see if there is an active whitelist
 	if whitelist := k.DeployerWhitelist(ctx); len(whitelist) > 0 {
 		bInWhitelist := false
@@ -70,8 +75,64 @@ func (k msgServer) CreateRollapp(goCtx context.Context, msg *types.MsgCreateRoll
 	if len(msg.Metadatas) == 0 {
 		ctx.Logger().Info("No token metadata provided")
 	}
+
+	if err := k.chargeRegistrationFee(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
 	// Write rollapp information to the store
 	k.SetRollapp(ctx, rollapp)
 
+	// call the after-rollapp-registered hook
+	if err := k.hooks.AfterRollappRegistered(ctx, msg.RollappId); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateRollappResponse{}, nil
 }
+
+// validateRollappId checks the rollapp-id is a valid chain-id, with an EIP155 number which is
+// not used by another rollapp.
+func (k msgServer) validateRollappId(ctx sdk.Context, rollappId string) error {
+	if !types.IsValidChainID(rollappId) {
+		return sdkerrors.Wrapf(types.ErrInvalidRollappID, "rollapp-id must be formatted as name_eip155-epoch: %s", rollappId)
+	}
+	eip155, err := types.ParseChainID(rollappId)
+	if err != nil {
+		return err
+	}
+	if !eip155.IsUint64() {
+		return sdkerrors.Wrapf(types.ErrInvalidRollappID, "EIP155 number is too large: %s", eip155)
+	}
+	if existing, found := k.GetRollappByEIP155(ctx, eip155.Uint64()); found {
+		return sdkerrors.Wrapf(types.ErrEIP155Exists, "EIP155 %s is used by rollapp %s", eip155, existing.RollappId)
+	}
+	return nil
+}
+
+// chargeRegistrationFee takes the registration fee from the rollapp creator.
+// The fee is either burned or sent to the community pool.
+func (k msgServer) chargeRegistrationFee(ctx sdk.Context, creator string) error {
+	fee := k.RegistrationFee(ctx)
+	if fee.IsNil() || fee.IsZero() {
+		return nil
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return err
+	}
+	fees := sdk.NewCoins(fee)
+
+	if !k.BurnRegistrationFee(ctx) {
+		if err := k.distrKeeper.FundCommunityPool(ctx, fees, creatorAddr); err != nil {
+			return sdkerrors.Wrap(err, "registration fee")
+		}
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, fees); err != nil {
+		return sdkerrors.Wrap(err, "registration fee")
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fees)
+}
@@ -2,12 +2,16 @@ package keeper_test
 
 import (
 	fmt "fmt"
+	"math"
 	"math/rand"
+	"strings"
 
+	"github.com/dymensionxyz/dymension/app"
 	"github.com/dymensionxyz/dymension/testutil/sample"
 	"github.com/dymensionxyz/dymension/x/rollapp/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (suite *RollappTestSuite) createRollappAndVerify(numOfAddresses int, expectedErr error) types.RollappSummary {
@@ -17,7 +21,7 @@ func (suite *RollappTestSuite) createRollappAndVerify(numOfAddresses int, expect
 	// rollapp is the rollapp to create
 	rollapp := types.MsgCreateRollapp{
 		Creator:               alice,
-		RollappId:             fmt.Sprintf("%s%d", "rollapp", rand.Int63()),
+		RollappId:             fmt.Sprintf("rollapp_%d-1", rand.Int63n(math.MaxInt64-1)+1),
 		MaxSequencers:         1,
 		PermissionedAddresses: addresses,
 	}
@@ -96,7 +100,7 @@ func (suite *RollappTestSuite) TestCreateRollappAlreadyExists() {
 	// rollapp is the rollapp to create
 	rollapp := types.MsgCreateRollapp{
 		Creator:               alice,
-		RollappId:             "rollapp1",
+		RollappId:             "rollapp_1-1",
 		MaxSequencers:         1,
 		PermissionedAddresses: []string{},
 	}
@@ -139,3 +143,84 @@ func (suite *RollappTestSuite) TestCreateRollappWhenDisabled() {
 	suite.app.RollappKeeper.SetParams(suite.ctx, params)
 	suite.createRollappAndVerify(1, types.ErrRollappsDisabled)
 }
+
+func (suite *RollappTestSuite) TestCreateRollappInvalidChainID() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	for _, rollappId := range []string{"", "rollapp1", "rollapp/1_1-1", "evmos_9000", "evmos_0-1", "evmos_" + strings.Repeat("1", 43) + "-1"} {
+		_, err := suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+			Creator:       alice,
+			RollappId:     rollappId,
+			MaxSequencers: 1,
+		})
+		suite.Require().ErrorIs(err, types.ErrInvalidRollappID, rollappId)
+	}
+}
+
+func (suite *RollappTestSuite) TestCreateRollappEIP155Exists() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+		Creator:       alice,
+		RollappId:     "evmos_9000-1",
+		MaxSequencers: 1,
+	})
+	suite.Require().Nil(err)
+
+	// the EIP155 number can not be used by another rollapp
+	_, err = suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+		Creator:       bob,
+		RollappId:     "other_9000-2",
+		MaxSequencers: 1,
+	})
+	suite.Require().ErrorIs(err, types.ErrEIP155Exists)
+	rollapp, found := suite.app.RollappKeeper.GetRollappByEIP155(suite.ctx, 9000)
+	suite.Require().True(found)
+	suite.Require().Equal("evmos_9000-1", rollapp.RollappId)
+}
+
+func (suite *RollappTestSuite) TestCreateRollappRegistrationFee() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	params := suite.app.RollappKeeper.GetParams(suite.ctx)
+	params.RegistrationFee = fee
+	suite.app.RollappKeeper.SetParams(suite.ctx, params)
+
+	// the creator must afford the fee
+	_, err := suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+		Creator:       alice,
+		RollappId:     "rollapp_1-1",
+		MaxSequencers: 1,
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	aliceAddr := sdk.MustAccAddressFromBech32(alice)
+	app.FundAccount(suite.app, suite.ctx, aliceAddr, sdk.NewCoins(fee.Add(fee)))
+
+	// the fee is sent to the community pool
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	_, err = suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+		Creator:       alice,
+		RollappId:     "rollapp_1-1",
+		MaxSequencers: 1,
+	})
+	suite.Require().Nil(err)
+	suite.Require().Equal(fee.Amount, suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(fee.Denom).Sub(communityPool.AmountOf(fee.Denom)).TruncateInt())
+
+	// or burned
+	params.BurnRegistrationFee = true
+	suite.app.RollappKeeper.SetParams(suite.ctx, params)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, fee.Denom)
+	_, err = suite.msgServer.CreateRollapp(goCtx, &types.MsgCreateRollapp{
+		Creator:       alice,
+		RollappId:     "rollapp_2-1",
+		MaxSequencers: 1,
+	})
+	suite.Require().Nil(err)
+	suite.Require().Equal(supply.Sub(fee), suite.app.BankKeeper.GetSupply(suite.ctx, fee.Denom))
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, aliceAddr, fee.Denom).IsZero())
+}
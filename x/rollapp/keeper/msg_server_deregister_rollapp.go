@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// DeregisterRollapp removes a frozen rollapp with its states and indexes, and releases its sequencers.
+// The canonical client stays bound to the rollapp id, so it can't be trusted by another rollapp.
+func (k msgServer) DeregisterRollapp(goCtx context.Context, msg *types.MsgDeregisterRollapp) (*types.MsgDeregisterRollappResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	rollapp, isFound := k.GetRollapp(ctx, msg.RollappId)
+	if !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+	if !rollapp.Frozen {
+		return nil, types.ErrRollappNotFrozen
+	}
+
+	k.removeRollappStates(ctx, msg.RollappId)
+	if eip155, err := types.ParseChainID(msg.RollappId); err == nil && eip155 != nil {
+		if indexed, found := k.GetRollappByEIP155(ctx, eip155.Uint64()); found && indexed.RollappId == msg.RollappId {
+			k.RemoveRollappByEIP155(ctx, eip155.Uint64())
+		}
+	}
+	k.RemoveRollapp(ctx, msg.RollappId)
+
+	// call the after-rollapp-deregistered hook
+	if err := k.hooks.AfterRollappDeregistered(ctx, msg.RollappId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRollappDeregistered,
+			sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+		),
+	)
+
+	return &types.MsgDeregisterRollappResponse{}, nil
+}
+
+// removeRollappStates removes the states of the rollapp and their indexes
+func (k Keeper) removeRollappStates(ctx sdk.Context, rollappId string) {
+	k.RemoveStatesFromFinalizationQueue(ctx, rollappId, 1)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefix(types.StateInfoKeyPrefix), types.StateInfoByRollappKey(rollappId)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	k.RemoveLatestStateInfoIndex(ctx, rollappId)
+	k.RemoveLatestFinalizedStateIndex(ctx, rollappId)
+	k.RemoveOldestStateInfoIndex(ctx, rollappId)
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *RollappTestSuite) TestDeregisterRollapp() {
+	suite.SetupTest()
+	authority := suite.app.RollappKeeper.GetAuthority()
+	rollappId := "rollapp_1234-1"
+	suite.createRollappWithStates(rollappId, bob, 2)
+	suite.createRollappWithStates("rollapp2", carol, 1)
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, rollappId)
+	rollapp.ClientId = "07-tendermint-0"
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// only the authority can deregister a rollapp
+	_, err := suite.msgServer.DeregisterRollapp(goCtx, types.NewMsgDeregisterRollapp(alice, rollappId))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.DeregisterRollapp(goCtx, types.NewMsgDeregisterRollapp(authority, "unknown"))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+
+	// the rollapp must be frozen first
+	_, err = suite.msgServer.DeregisterRollapp(goCtx, types.NewMsgDeregisterRollapp(authority, rollappId))
+	suite.Require().ErrorIs(err, types.ErrRollappNotFrozen)
+
+	_, err = suite.msgServer.FreezeRollapp(goCtx, types.NewMsgFreezeRollapp(authority, rollappId))
+	suite.Require().Nil(err)
+	_, err = suite.msgServer.DeregisterRollapp(goCtx, types.NewMsgDeregisterRollapp(authority, rollappId))
+	suite.Require().Nil(err)
+
+	// the rollapp, its states and its indexes are removed
+	_, found := suite.app.RollappKeeper.GetRollapp(suite.ctx, rollappId)
+	suite.Require().False(found)
+	_, found = suite.app.RollappKeeper.GetRollappByEIP155(suite.ctx, 1234)
+	suite.Require().False(found)
+	for index := uint64(1); index <= 2; index++ {
+		_, found = suite.app.RollappKeeper.GetStateInfo(suite.ctx, rollappId, index)
+		suite.Require().False(found)
+	}
+	_, found = suite.app.RollappKeeper.GetLatestStateInfoIndex(suite.ctx, rollappId)
+	suite.Require().False(found)
+	_, found = suite.app.RollappKeeper.GetLatestFinalizedStateIndex(suite.ctx, rollappId)
+	suite.Require().False(found)
+
+	// the canonical client doesn't resolve to a rollapp anymore
+	_, found = suite.app.RollappKeeper.GetRollappByClientID(suite.ctx, "07-tendermint-0")
+	suite.Require().False(found)
+
+	// the other rollapps are untouched
+	_, found = suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp2", 1)
+	suite.Require().True(found)
+	queue := suite.app.RollappKeeper.GetAllBlockHeightToFinalizationQueue(suite.ctx)
+	suite.Require().Len(queue, 1)
+	suite.Require().Equal([]types.StateInfoIndex{{RollappId: "rollapp2", Index: 1}}, queue[0].FinalizationQueue)
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// FreezeRollapp halts a rollapp. A frozen rollapp can not update its state nor its canonical client,
+// and its pending states are pulled from the finalization queue.
+func (k msgServer) FreezeRollapp(goCtx context.Context, msg *types.MsgFreezeRollapp) (*types.MsgFreezeRollappResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	rollapp, isFound := k.GetRollapp(ctx, msg.RollappId)
+	if !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+	if rollapp.Frozen {
+		return nil, types.ErrRollappFrozen
+	}
+
+	if err := k.freezeRollapp(ctx, rollapp); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeRollappResponse{}, nil
+}
+
+// freezeRollapp halts a rollapp and pulls its pending states from the finalization queue
+func (k Keeper) freezeRollapp(ctx sdk.Context, rollapp types.Rollapp) error {
+	rollapp.Frozen = true
+	k.SetRollapp(ctx, rollapp)
+
+	// stop the finalization of the pending states
+	k.RemoveStatesFromFinalizationQueue(ctx, rollapp.RollappId, 1)
+
+	// call the after-rollapp-frozen hook
+	if err := k.hooks.AfterRollappFrozen(ctx, rollapp.RollappId); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRollappFrozen,
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollapp.RollappId),
+		),
+	)
+	return nil
+}
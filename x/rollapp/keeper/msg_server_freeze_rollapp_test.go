@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func (suite *RollappTestSuite) TestFreezeRollapp() {
+	suite.SetupTest()
+	authority := suite.app.RollappKeeper.GetAuthority()
+	suite.createRollappWithStates("rollapp1", bob, 2)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	// only the authority can freeze a rollapp
+	_, err := suite.msgServer.FreezeRollapp(goCtx, types.NewMsgFreezeRollapp(alice, "rollapp1"))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.FreezeRollapp(goCtx, types.NewMsgFreezeRollapp(authority, "unknown"))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+
+	_, err = suite.msgServer.FreezeRollapp(goCtx, types.NewMsgFreezeRollapp(authority, "rollapp1"))
+	suite.Require().Nil(err)
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().True(rollapp.Frozen)
+
+	// the pending states are not finalized anymore
+	suite.Require().Empty(suite.app.RollappKeeper.GetAllBlockHeightToFinalizationQueue(suite.ctx))
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(suite.app.RollappKeeper.DisputePeriodInBlocks(suite.ctx)))
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	for index := uint64(1); index <= 2; index++ {
+		stateInfo, _ := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", index)
+		suite.Require().Equal(types.STATE_STATUS_RECEIVED, stateInfo.Status)
+	}
+
+	// a frozen rollapp can't update its state
+	goCtx = sdk.WrapSDKContext(suite.ctx)
+	_, err = suite.msgServer.UpdateState(goCtx, &types.MsgUpdateState{
+		Creator:     bob,
+		RollappId:   "rollapp1",
+		StartHeight: 21,
+		NumBlocks:   1,
+		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 21}}},
+	})
+	suite.Require().ErrorIs(err, types.ErrRollappFrozen)
+
+	// can't freeze twice
+	_, err = suite.msgServer.FreezeRollapp(goCtx, types.NewMsgFreezeRollapp(authority, "rollapp1"))
+	suite.Require().ErrorIs(err, types.ErrRollappFrozen)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// ScheduleRollappUpgrade records the version the rollapp runs from the given rollapp height.
+// State updates from that height must carry the new version, and the rollapp version is bumped once the
+// first of them is finalized.
+func (k msgServer) ScheduleRollappUpgrade(goCtx context.Context, msg *types.MsgScheduleRollappUpgrade) (*types.MsgScheduleRollappUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.RollappsEnabled(ctx) {
+		return nil, types.ErrRollappsDisabled
+	}
+
+	rollapp, isFound := k.GetRollapp(ctx, msg.RollappId)
+	if !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	// only the rollapp creator or the gov module can upgrade the rollapp
+	if msg.Creator != rollapp.Creator && msg.Creator != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only the rollapp creator or the gov module can upgrade the rollapp")
+	}
+
+	if msg.Version <= rollapp.Version {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidUpgrade, "version must be greater than the current version %d", rollapp.Version)
+	}
+	nextStartHeight := k.nextStartHeight(ctx, msg.RollappId)
+	if msg.Height < nextStartHeight {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidUpgrade, "height must not be lower than the next state update height %d", nextStartHeight)
+	}
+	// the rollapp already runs the pending upgrade, it is applied once its first state is finalized
+	if upgrade := rollapp.PendingUpgrade; upgrade != nil && nextStartHeight > upgrade.Height {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidUpgrade, "the upgrade to version %d is in progress", upgrade.Version)
+	}
+
+	rollapp.PendingUpgrade = &types.RollappUpgrade{
+		Version: msg.Version,
+		Height:  msg.Height,
+	}
+	k.SetRollapp(ctx, rollapp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpgradeScheduled,
+			sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(msg.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatUint(msg.Height, 10)),
+		),
+	)
+
+	return &types.MsgScheduleRollappUpgradeResponse{}, nil
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func (suite *RollappTestSuite) TestScheduleRollappUpgrade() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		Version:       1,
+		MaxSequencers: 1,
+	})
+	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencertypes.Sequencer{
+		SequencerAddress: bob,
+		RollappIDs:       []string{"rollapp1"},
+	})
+	suite.app.SequencerKeeper.SetScheduler(suite.ctx, sequencertypes.Scheduler{
+		SequencerAddress: bob,
+		Status:           sequencertypes.Proposer,
+	})
+	updateState := func(startHeight, numBlocks, version uint64) error {
+		var bds []types.BlockDescriptor
+		for h := startHeight; h < startHeight+numBlocks; h++ {
+			bds = append(bds, types.BlockDescriptor{Height: h})
+		}
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		_, err := suite.msgServer.UpdateState(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateState{
+			Creator:     bob,
+			RollappId:   "rollapp1",
+			StartHeight: startHeight,
+			NumBlocks:   numBlocks,
+			Version:     version,
+			BDs:         types.BlockDescriptors{BD: bds},
+		})
+		return err
+	}
+	suite.Require().Nil(updateState(1, 3, 1))
+
+	_, err := suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(alice, "rollapp2", 2, 10))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(bob, "rollapp1", 2, 10))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(alice, "rollapp1", 1, 10))
+	suite.Require().ErrorIs(err, types.ErrInvalidUpgrade)
+	// the rollapp already updated its state until height 3
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(alice, "rollapp1", 2, 3))
+	suite.Require().ErrorIs(err, types.ErrInvalidUpgrade)
+
+	_, err = suite.queryClient.PendingUpgrade(goCtx, &types.QueryGetPendingUpgradeRequest{RollappId: "rollapp1"})
+	suite.Require().Error(err)
+
+	// the gov module can upgrade the rollapp, the creator can reschedule it
+	authority := suite.app.RollappKeeper.GetAuthority()
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(authority, "rollapp1", 3, 20))
+	suite.Require().Nil(err)
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(alice, "rollapp1", 2, 10))
+	suite.Require().Nil(err)
+
+	res, err := suite.queryClient.PendingUpgrade(goCtx, &types.QueryGetPendingUpgradeRequest{RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(uint64(1), res.Version)
+	suite.Require().Equal(types.RollappUpgrade{Version: 2, Height: 10}, res.Upgrade)
+
+	// the new version is rejected before the upgrade height
+	suite.Require().ErrorIs(updateState(4, 3, 2), types.ErrVersionMismatch)
+	suite.Require().Nil(updateState(4, 3, 1))
+	// a batch can not span both versions
+	suite.Require().ErrorIs(updateState(7, 5, 1), types.ErrVersionMismatch)
+	suite.Require().ErrorIs(updateState(7, 5, 2), types.ErrVersionMismatch)
+	suite.Require().Nil(updateState(7, 3, 1))
+
+	// the old version is rejected from the upgrade height
+	suite.Require().ErrorIs(updateState(10, 2, 1), types.ErrVersionMismatch)
+	suite.Require().Nil(updateState(10, 2, 2))
+
+	// the upgrade is applied once the first state running the new version is finalized
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().Equal(uint64(1), rollapp.Version)
+	suite.Require().Equal(&types.RollappUpgrade{Version: 2, Height: 10}, rollapp.PendingUpgrade)
+	suite.Require().Nil(updateState(12, 2, 2))
+	// an upgrade in progress can not be rescheduled
+	_, err = suite.msgServer.ScheduleRollappUpgrade(goCtx, types.NewMsgScheduleRollappUpgrade(alice, "rollapp1", 3, 20))
+	suite.Require().ErrorIs(err, types.ErrInvalidUpgrade)
+
+	// a fraud reverting the states from before the upgrade height brings back the old version
+	_, err = suite.app.RollappKeeper.RevertPendingStates(suite.ctx, "rollapp1", 3)
+	suite.Require().Nil(err)
+	suite.Require().ErrorIs(updateState(7, 3, 2), types.ErrVersionMismatch)
+	suite.Require().Nil(updateState(7, 3, 1))
+	suite.Require().Nil(updateState(10, 2, 2))
+
+	latestStateInfoIndex, _ := suite.app.RollappKeeper.GetLatestStateInfoIndex(suite.ctx, "rollapp1")
+	lastState, _ := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", latestStateInfoIndex.Index)
+	for suite.ctx.BlockHeight() < int64(lastState.FinalizationHeight) {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	}
+	rollapp, _ = suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().Equal(uint64(2), rollapp.Version)
+	suite.Require().Nil(rollapp.PendingUpgrade)
+	suite.Require().Nil(updateState(12, 2, 2))
+}
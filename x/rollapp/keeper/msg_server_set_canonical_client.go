@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// SetCanonicalClient binds a rollapp to the IBC client its packets must be received through
+func (k msgServer) SetCanonicalClient(goCtx context.Context, msg *types.MsgSetCanonicalClient) (*types.MsgSetCanonicalClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.RollappsEnabled(ctx) {
+		return nil, types.ErrRollappsDisabled
+	}
+
+	rollapp, isFound := k.GetRollapp(ctx, msg.RollappId)
+	if !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	// only the rollapp creator or its current proposer can bind the rollapp
+	if msg.Creator != rollapp.Creator {
+		proposer, found := k.sequencerKeeper.GetRollappProposer(ctx, msg.RollappId)
+		if !found || proposer != msg.Creator {
+			return nil, types.ErrUnauthorizedSigner
+		}
+	}
+
+	// the client must track the rollapp chain
+	clientState, found := k.clientKeeper.GetClientState(ctx, msg.ClientId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidClientID, "client %s not found", msg.ClientId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidClientType, "client %s is not a tendermint client", msg.ClientId)
+	}
+	if tmClientState.ChainId != msg.RollappId {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidClientID, "client %s tracks chain %s", msg.ClientId, tmClientState.ChainId)
+	}
+
+	if bound, found := k.getRollappIdByClientID(ctx, msg.ClientId); found && bound != msg.RollappId {
+		return nil, sdkerrors.Wrapf(types.ErrClientAlreadyBound, "client %s is bound to %s", msg.ClientId, bound)
+	}
+
+	// the channels must run over the client
+	for _, channelId := range msg.ChannelIds {
+		clientId, _, err := k.channelKeeper.GetChannelClientState(ctx, transfertypes.PortID, channelId)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidChannelID, "channel %s: %s", channelId, err)
+		}
+		if clientId != msg.ClientId {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidChannelID, "channel %s runs over client %s", channelId, clientId)
+		}
+	}
+
+	if rollapp.ClientId != "" && rollapp.ClientId != msg.ClientId {
+		k.RemoveRollappByClientID(ctx, rollapp.ClientId)
+	}
+	rollapp.ClientId = msg.ClientId
+	rollapp.ChannelIds = msg.ChannelIds
+	k.SetRollapp(ctx, rollapp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClientBound,
+			sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+			sdk.NewAttribute(types.AttributeKeyClientId, msg.ClientId),
+			sdk.NewAttribute(types.AttributeKeyChannelIds, strings.Join(msg.ChannelIds, ",")),
+		),
+	)
+
+	return &types.MsgSetCanonicalClientResponse{}, nil
+}
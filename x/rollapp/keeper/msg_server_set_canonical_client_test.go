@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *RollappTestSuite) TestSetCanonicalClient_Authorization() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		MaxSequencers: 1,
+	})
+	suite.app.SequencerKeeper.SetSequencersByRollapp(suite.ctx, sequencertypes.SequencersByRollapp{
+		RollappId: "rollapp1",
+		Proposer:  bob,
+	})
+
+	_, err := suite.msgServer.SetCanonicalClient(goCtx, types.NewMsgSetCanonicalClient(alice, "rollapp2", "07-tendermint-0", nil))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+
+	// neither the creator nor the proposer
+	_, err = suite.msgServer.SetCanonicalClient(goCtx, types.NewMsgSetCanonicalClient(carol, "rollapp1", "07-tendermint-0", nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+	// the creator and the proposer are authorized, but the client must exist
+	_, err = suite.msgServer.SetCanonicalClient(goCtx, types.NewMsgSetCanonicalClient(alice, "rollapp1", "07-tendermint-0", nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidClientID)
+	_, err = suite.msgServer.SetCanonicalClient(goCtx, types.NewMsgSetCanonicalClient(bob, "rollapp1", "07-tendermint-0", nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidClientID)
+
+	rollapp, found := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().True(found)
+	suite.Require().Empty(rollapp.ClientId)
+}
+
+func (suite *RollappTestSuite) TestGetRollappByClientID() {
+	suite.SetupTest()
+
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{RollappId: "rollapp1", Creator: alice, ClientId: "07-tendermint-0"})
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{RollappId: "rollapp2", Creator: alice})
+
+	rollapp, found := suite.app.RollappKeeper.GetRollappByClientID(suite.ctx, "07-tendermint-0")
+	suite.Require().True(found)
+	suite.Require().Equal("rollapp1", rollapp.RollappId)
+	_, found = suite.app.RollappKeeper.GetRollappByClientID(suite.ctx, "07-tendermint-1")
+	suite.Require().False(found)
+
+	suite.app.RollappKeeper.RemoveRollappByClientID(suite.ctx, "07-tendermint-0")
+	_, found = suite.app.RollappKeeper.GetRollappByClientID(suite.ctx, "07-tendermint-0")
+	suite.Require().False(found)
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// SubmitFraud reverts a disputed rollapp state, and every state that followed it,
+// while it is still in its dispute period
+func (k msgServer) SubmitFraud(goCtx context.Context, msg *types.MsgSubmitFraud) (*types.MsgSubmitFraudResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if _, isFound := k.GetRollapp(ctx, msg.RollappId); !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	revertedStates, err := k.RevertPendingStates(ctx, msg.RollappId, msg.StateIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	// call the after-states-reverted hook
+	err = k.hooks.AfterStatesReverted(ctx, msg.RollappId, revertedStates)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFraud,
+			sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+			sdk.NewAttribute(types.AttributeKeyStateInfoIndex, strconv.FormatUint(msg.StateIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyStartHeight, strconv.FormatUint(revertedStates[0].StartHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyFraudProof, msg.FraudProof),
+		),
+	)
+
+	return &types.MsgSubmitFraudResponse{}, nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// createRollappWithStates registers a rollapp with the given proposer and sends numOfStates
+// state updates of 10 blocks each, one per hub block
+func (suite *RollappTestSuite) createRollappWithStates(rollappId string, proposer string, numOfStates int) {
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{
+		RollappId:     rollappId,
+		Creator:       alice,
+		MaxSequencers: 1,
+	})
+	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencertypes.Sequencer{
+		SequencerAddress: proposer,
+		RollappIDs:       []string{rollappId},
+	})
+	suite.app.SequencerKeeper.SetScheduler(suite.ctx, sequencertypes.Scheduler{
+		SequencerAddress: proposer,
+		Status:           sequencertypes.Proposer,
+	})
+
+	for i := 0; i < numOfStates; i++ {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		startHeight := uint64(i*10 + 1)
+		bds := types.BlockDescriptors{}
+		for h := startHeight; h < startHeight+10; h++ {
+			bds.BD = append(bds.BD, types.BlockDescriptor{Height: h})
+		}
+		_, err := suite.msgServer.UpdateState(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateState{
+			Creator:     proposer,
+			RollappId:   rollappId,
+			StartHeight: startHeight,
+			NumBlocks:   10,
+			BDs:         bds,
+		})
+		suite.Require().Nil(err)
+	}
+}
+
+func (suite *RollappTestSuite) TestSubmitFraud() {
+	suite.SetupTest()
+	authority := suite.app.RollappKeeper.GetAuthority()
+	// keep all the states in their dispute period
+	suite.app.RollappKeeper.SetParams(suite.ctx, types.NewParams(true, 10, nil, 0, types.MinDisputePeriodInBlocks, types.DefaultMaxDisputePeriodInBlocks, types.DefaultRegistrationFee, false))
+
+	suite.createRollappWithStates("rollapp1", bob, 4)
+	suite.createRollappWithStates("rollapp2", carol, 1)
+
+	_, err := suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(authority, "rollapp1", 2, "fraud"))
+	suite.Require().Nil(err)
+
+	// the disputed state and all the states after it are reverted
+	for index := uint64(1); index <= 4; index++ {
+		stateInfo, found := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", index)
+		suite.Require().True(found)
+		if index < 2 {
+			suite.Require().Equal(types.STATE_STATUS_RECEIVED, stateInfo.Status)
+		} else {
+			suite.Require().Equal(types.STATE_STATUS_REVERTED, stateInfo.Status)
+		}
+	}
+
+	latestStateInfoIndex, found := suite.app.RollappKeeper.GetLatestStateInfoIndex(suite.ctx, "rollapp1")
+	suite.Require().True(found)
+	suite.Require().EqualValues(1, latestStateInfoIndex.Index)
+
+	// only the non reverted states are left in the finalization queues
+	var queued []types.StateInfoIndex
+	for _, queue := range suite.app.RollappKeeper.GetAllBlockHeightToFinalizationQueue(suite.ctx) {
+		queued = append(queued, queue.FinalizationQueue...)
+	}
+	suite.Require().ElementsMatch([]types.StateInfoIndex{
+		{RollappId: "rollapp1", Index: 1},
+		{RollappId: "rollapp2", Index: 1},
+	}, queued)
+
+	// the next update must start from the first reverted height
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	_, err = suite.msgServer.UpdateState(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateState{
+		Creator:     bob,
+		RollappId:   "rollapp1",
+		StartHeight: 11,
+		NumBlocks:   1,
+		BDs:         types.BlockDescriptors{BD: []types.BlockDescriptor{{Height: 11}}},
+	})
+	suite.Require().Nil(err)
+	stateInfo, found := suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.STATE_STATUS_RECEIVED, stateInfo.Status)
+
+	// reverted states are never finalized
+	for i := 0; i < 12; i++ {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+	}
+	stateInfo, found = suite.app.RollappKeeper.GetStateInfo(suite.ctx, "rollapp1", 3)
+	suite.Require().True(found)
+	suite.Require().Equal(types.STATE_STATUS_REVERTED, stateInfo.Status)
+	latestFinalizedStateIndex, found := suite.app.RollappKeeper.GetLatestFinalizedStateIndex(suite.ctx, "rollapp1")
+	suite.Require().True(found)
+	suite.Require().EqualValues(2, latestFinalizedStateIndex.Index)
+}
+
+func (suite *RollappTestSuite) TestSubmitFraudFirstState() {
+	suite.SetupTest()
+	authority := suite.app.RollappKeeper.GetAuthority()
+
+	suite.createRollappWithStates("rollapp1", bob, 2)
+
+	_, err := suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(authority, "rollapp1", 1, ""))
+	suite.Require().Nil(err)
+
+	_, found := suite.app.RollappKeeper.GetLatestStateInfoIndex(suite.ctx, "rollapp1")
+	suite.Require().False(found)
+	suite.Require().Empty(suite.app.RollappKeeper.GetAllBlockHeightToFinalizationQueue(suite.ctx))
+}
+
+func (suite *RollappTestSuite) TestSubmitFraudErrors() {
+	suite.SetupTest()
+	authority := suite.app.RollappKeeper.GetAuthority()
+
+	suite.createRollappWithStates("rollapp1", bob, 2)
+
+	// only the authority can submit fraud
+	_, err := suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(alice, "rollapp1", 1, ""))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(authority, "unknown", 1, ""))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+
+	_, err = suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(authority, "rollapp1", 3, ""))
+	suite.Require().ErrorIs(err, types.ErrStateNotExists)
+
+	// finalize the first state
+	disputePeriodInBlocks := suite.app.RollappKeeper.DisputePeriodInBlocks(suite.ctx)
+	suite.ctx = suite.ctx.WithBlockHeight(1 + int64(disputePeriodInBlocks))
+	suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+
+	_, err = suite.msgServer.SubmitFraud(sdk.WrapSDKContext(suite.ctx), types.NewMsgSubmitFraud(authority, "rollapp1", 1, ""))
+	suite.Require().ErrorIs(err, types.ErrInvalidStateStatus)
+
+	// nothing was reverted
+	latestStateInfoIndex, found := suite.app.RollappKeeper.GetLatestStateInfoIndex(suite.ctx, "rollapp1")
+	suite.Require().True(found)
+	suite.Require().EqualValues(2, latestStateInfoIndex.Index)
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// UpdateRollapp updates the permissioned sequencers, the max sequencers, the token metadata and the dispute period of a rollapp.
+// Sequencers that lose their permission stay registered and bonded, but can no longer update the rollapp
+// state nor be elected as its proposer. A current proposer losing its permission is rotated out.
+// A permissionless rollapp given its first permissioned addresses revokes the registered sequencers left out.
+// A new dispute period also applies to the pending states, counted from their creation height.
+func (k msgServer) UpdateRollapp(goCtx context.Context, msg *types.MsgUpdateRollapp) (*types.MsgUpdateRollappResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.RollappsEnabled(ctx) {
+		return nil, types.ErrRollappsDisabled
+	}
+
+	rollapp, isFound := k.GetRollapp(ctx, msg.RollappId)
+	if !isFound {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	// only the rollapp creator or the gov module can update the rollapp
+	if msg.Creator != rollapp.Creator && msg.Creator != k.authority {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "only the rollapp creator or the gov module can update the rollapp")
+	}
+
+	permissioned := make(map[string]bool)
+	for _, addr := range rollapp.PermissionedAddresses {
+		permissioned[addr] = true
+	}
+	for _, addr := range msg.AddPermissionedAddresses {
+		if permissioned[addr] {
+			return nil, sdkerrors.Wrapf(types.ErrPermissionedAddressesDuplicate, "address: %s", addr)
+		}
+	}
+	for _, addr := range msg.RemovePermissionedAddresses {
+		if !permissioned[addr] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidPermissionedAddress, "address is not permissioned: %s", addr)
+		}
+	}
+	// an empty list means permissionless, so a permissioned rollapp must keep at least one address
+	if len(msg.RemovePermissionedAddresses) > 0 &&
+		len(rollapp.PermissionedAddresses)+len(msg.AddPermissionedAddresses) == len(msg.RemovePermissionedAddresses) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPermissionedAddress, "can not remove all permissioned addresses")
+	}
+
+	if msg.MaxSequencers != 0 && msg.MaxSequencers < rollapp.MaxSequencers {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMaxSequencers, "max-sequencers can only be raised; current: %d", rollapp.MaxSequencers)
+	}
+
+	if msg.DisputePeriodInBlocks != 0 {
+		min, max := k.MinDisputePeriodInBlocks(ctx), k.MaxDisputePeriodInBlocks(ctx)
+		if msg.DisputePeriodInBlocks < min || msg.DisputePeriodInBlocks > max {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidDisputePeriod, "dispute period must be between %d and %d blocks", min, max)
+		}
+	}
+
+	denoms := make(map[string]bool)
+	for _, metadata := range rollapp.TokenMetadata {
+		denoms[metadata.Base] = true
+	}
+	for _, metadata := range msg.AddMetadatas {
+		if denoms[metadata.Base] {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidTokenMetadata, "metadata already exists for denom: %s", metadata.Base)
+		}
+	}
+
+	// apply the changes
+	wasPermissionless := len(rollapp.PermissionedAddresses) == 0
+	for _, addr := range msg.RemovePermissionedAddresses {
+		delete(permissioned, addr)
+	}
+	var permissionedAddresses []string
+	for _, addr := range rollapp.PermissionedAddresses {
+		if permissioned[addr] {
+			permissionedAddresses = append(permissionedAddresses, addr)
+		}
+	}
+	rollapp.PermissionedAddresses = append(permissionedAddresses, msg.AddPermissionedAddresses...)
+	if msg.MaxSequencers != 0 {
+		rollapp.MaxSequencers = msg.MaxSequencers
+	}
+	for i := range msg.AddMetadatas {
+		rollapp.TokenMetadata = append(rollapp.TokenMetadata, &msg.AddMetadatas[i])
+	}
+	if msg.DisputePeriodInBlocks != 0 {
+		rollapp.DisputePeriodInBlocks = msg.DisputePeriodInBlocks
+	}
+	k.SetRollapp(ctx, rollapp)
+
+	if msg.DisputePeriodInBlocks != 0 {
+		k.requeuePendingStates(ctx, msg.RollappId)
+	}
+
+	revoked := msg.RemovePermissionedAddresses
+	if wasPermissionless && len(rollapp.PermissionedAddresses) > 0 {
+		for _, addr := range msg.AddPermissionedAddresses {
+			permissioned[addr] = true
+		}
+		for _, addr := range k.sequencerKeeper.GetRollappSequencers(ctx, msg.RollappId) {
+			if !permissioned[addr] {
+				revoked = append(revoked, addr)
+			}
+		}
+	}
+	for _, addr := range revoked {
+		if err := k.hooks.AfterSequencerPermissionRevoked(ctx, msg.RollappId, addr); err != nil {
+			return nil, err
+		}
+	}
+
+	k.emitRollappUpdateEvents(ctx, msg)
+
+	return &types.MsgUpdateRollappResponse{}, nil
+}
+
+// emitRollappUpdateEvents emits an event for each change made by the rollapp update
+func (k msgServer) emitRollappUpdateEvents(ctx sdk.Context, msg *types.MsgUpdateRollapp) {
+	for _, addr := range msg.AddPermissionedAddresses {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypePermissionGranted,
+				sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+				sdk.NewAttribute(types.AttributeKeySequencer, addr),
+			),
+		)
+	}
+	for _, addr := range msg.RemovePermissionedAddresses {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypePermissionRevoked,
+				sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+				sdk.NewAttribute(types.AttributeKeySequencer, addr),
+			),
+		)
+	}
+	if msg.MaxSequencers != 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeMaxSequencersUpdated,
+				sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+				sdk.NewAttribute(types.AttributeKeyMaxSequencers, strconv.FormatUint(msg.MaxSequencers, 10)),
+			),
+		)
+	}
+	for _, metadata := range msg.AddMetadatas {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeTokenMetadataAdded,
+				sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+				sdk.NewAttribute(types.AttributeKeyDenom, metadata.Base),
+			),
+		)
+	}
+	if msg.DisputePeriodInBlocks != 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeDisputePeriodUpdated,
+				sdk.NewAttribute(types.AttributeKeyRollappId, msg.RollappId),
+				sdk.NewAttribute(types.AttributeKeyDisputePeriod, strconv.FormatUint(msg.DisputePeriodInBlocks, 10)),
+			),
+		)
+	}
+}
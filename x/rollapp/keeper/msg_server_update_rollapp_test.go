@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (suite *RollappTestSuite) TestUpdateRollapp() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, types.Rollapp{
+		RollappId:             "rollapp1",
+		Creator:               alice,
+		MaxSequencers:         2,
+		PermissionedAddresses: []string{alice, bob},
+		TokenMetadata:         []*types.TokenMetadata{{Base: "aRAX"}},
+	})
+
+	_, err := suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp2", nil, nil, 3, nil, 0))
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(bob, "rollapp1", nil, nil, 3, nil, 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the changes are checked against the stored rollapp
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", []string{bob}, nil, 0, nil, 0))
+	suite.Require().ErrorIs(err, types.ErrPermissionedAddressesDuplicate)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, []string{carol}, 0, nil, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidPermissionedAddress)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, []string{alice, bob}, 0, nil, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidPermissionedAddress)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, nil, 1, nil, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidMaxSequencers)
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", nil, nil, 0, []types.TokenMetadata{{Base: "aRAX"}}, 0))
+	suite.Require().ErrorIs(err, types.ErrInvalidTokenMetadata)
+
+	// the creator updates the rollapp
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(alice, "rollapp1", []string{carol}, []string{bob}, 3, []types.TokenMetadata{{Base: "aFOO"}}, 0))
+	suite.Require().Nil(err)
+	rollapp, found := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().True(found)
+	suite.Require().Equal([]string{alice, carol}, rollapp.PermissionedAddresses)
+	suite.Require().Equal(uint64(3), rollapp.MaxSequencers)
+	suite.Require().Len(rollapp.TokenMetadata, 2)
+	suite.Require().Equal("aFOO", rollapp.TokenMetadata[1].Base)
+
+	// each change is announced
+	var eventTypes []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	suite.Require().Subset(eventTypes, []string{
+		types.EventTypePermissionGranted,
+		types.EventTypePermissionRevoked,
+		types.EventTypeMaxSequencersUpdated,
+		types.EventTypeTokenMetadataAdded,
+	})
+
+	// the gov module can update the rollapp as well
+	authority := suite.app.RollappKeeper.GetAuthority()
+	_, err = suite.msgServer.UpdateRollapp(goCtx, types.NewMsgUpdateRollapp(authority, "rollapp1", []string{bob}, nil, 0, nil, 0))
+	suite.Require().Nil(err)
+	rollapp, _ = suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	suite.Require().Equal([]string{alice, carol, bob}, rollapp.PermissionedAddresses)
+}
@@ -21,19 +21,26 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 	if !isFound {
 		return nil, types.ErrUnknownRollappID
 	}
+	if rollapp.Frozen {
+		return nil, types.ErrRollappFrozen
+	}
 
-	// check rollapp version
-	if rollapp.Version != msg.Version {
-		return nil, sdkerrors.Wrapf(types.ErrVersionMismatch, "rollappId(%s) current version is %d, but got %d", msg.RollappId, rollapp.Version, msg.Version)
+	// check rollapp version, a pending upgrade sets the version from its height
+	version, err := expectedVersion(rollapp, msg.StartHeight, msg.NumBlocks)
+	if err != nil {
+		return nil, err
+	}
+	if version != msg.Version {
+		return nil, sdkerrors.Wrapf(types.ErrVersionMismatch, "rollappId(%s) expected version is %d, but got %d", msg.RollappId, version, msg.Version)
 	}
 
 	// call the before-update-state hook
-	err := k.hooks.BeforeUpdateState(ctx, msg.Creator, msg.RollappId)
+	err = k.hooks.BeforeUpdateState(ctx, msg.Creator, msg.RollappId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Logic Error check - must be done after BeforeUpdateStateRecoverable
+	// must be done after BeforeUpdateState
 	// check if there are permissionedAddresses.
 	// if the list is not empty, it means that only premissioned sequencers can be added
 	permissionedAddresses := rollapp.PermissionedAddresses
@@ -47,13 +54,11 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 				break
 			}
 		}
-		// Check Error: only permissioned sequencers allowed to update and this one is not in the list
+		// Check Error: only permissioned sequencers allowed to update and this one is not in the list.
+		// A registered sequencer can lose its permission through MsgUpdateRollapp
 		if !bPermissioned {
-			// this is a logic error, as the sequencer modules' BeforeUpdateState hook
-			// should check that the sequencer exists and register for serving this rollapp
-			// so if this check passed, an unpermissioned sequencer is registered
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
-				"unpermissioned sequencer (%s) is registered for rollappId(%s)",
+			return nil, sdkerrors.Wrapf(types.ErrUnpermissionedSequencer,
+				"sequencer (%s) is not permissioned for rollappId(%s)",
 				msg.Creator, msg.RollappId)
 		}
 	}
@@ -61,6 +66,7 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 	// retrieve last updating index
 	latestStateInfoIndex, isFound := k.GetLatestStateInfoIndex(ctx, msg.RollappId)
 	var newIndex uint64
+	var prevFinalizationHeight uint64
 	if !isFound {
 		// check to see if it's the first update
 		if msg.StartHeight != 1 {
@@ -99,6 +105,10 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 			}
 		}
 
+		if stateInfo.Status == types.STATE_STATUS_RECEIVED {
+			prevFinalizationHeight = stateInfo.FinalizationHeight
+		}
+
 		// bump state index
 		newIndex = latestStateInfoIndex.Index + 1
 	}
@@ -109,22 +119,29 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		Index:     newIndex,
 	})
 
+	// calculate finalization, the states of a rollapp are finalized in the order of their index
+	// even if its dispute period was shortened while the previous state is pending
+	finalizationHeight := uint64(ctx.BlockHeight()) + k.RollappDisputePeriodInBlocks(ctx, rollapp)
+	if finalizationHeight < prevFinalizationHeight {
+		finalizationHeight = prevFinalizationHeight
+	}
+
 	// Write new state information to the store indexed by <RollappId,LatestStateInfoIndex>
 	stateInfoIndex := types.StateInfoIndex{RollappId: msg.RollappId, Index: newIndex}
-	k.SetStateInfo(ctx, types.StateInfo{
-		StateInfoIndex: stateInfoIndex,
-		Sequencer:      msg.Creator,
-		StartHeight:    msg.StartHeight,
-		NumBlocks:      msg.NumBlocks,
-		DAPath:         msg.DAPath,
-		Version:        msg.Version,
-		CreationHeight: uint64(ctx.BlockHeight()),
-		Status:         types.STATE_STATUS_RECEIVED,
-		BDs:            msg.BDs},
-	)
+	stateInfo := types.StateInfo{
+		StateInfoIndex:     stateInfoIndex,
+		Sequencer:          msg.Creator,
+		StartHeight:        msg.StartHeight,
+		NumBlocks:          msg.NumBlocks,
+		DAPath:             msg.DAPath,
+		Version:            msg.Version,
+		CreationHeight:     uint64(ctx.BlockHeight()),
+		Status:             types.STATE_STATUS_RECEIVED,
+		BDs:                msg.BDs,
+		FinalizationHeight: finalizationHeight,
+	}
+	k.SetStateInfo(ctx, stateInfo)
 
-	// calculate finalization
-	finalizationHeight := uint64(ctx.BlockHeight()) + k.DisputePeriodInBlocks(ctx)
 	newFinalizationQueue := []types.StateInfoIndex{stateInfoIndex}
 
 	// load FinalizationQueue and update
@@ -149,5 +166,11 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		),
 	)
 
+	// the client headers received before the state was posted couldn't be checked yet
+	rollapp, _ = k.GetRollapp(ctx, msg.RollappId)
+	if err := k.checkClientConsensusStates(ctx, rollapp, stateInfo); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgUpdateStateResponse{}, nil
 }
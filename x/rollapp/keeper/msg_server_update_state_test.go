@@ -300,7 +300,7 @@ func (suite *RollappTestSuite) TestUpdateStateSequencerRollappMismatch() {
 	suite.ErrorIs(err, sequencertypes.ErrSequencerRollappMismatch)
 }
 
-func (suite *RollappTestSuite) TestUpdateStateErrLogicUnpermissioned() {
+func (suite *RollappTestSuite) TestUpdateStateErrUnpermissioned() {
 	suite.SetupTest()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 
@@ -314,12 +314,16 @@ func (suite *RollappTestSuite) TestUpdateStateErrLogicUnpermissioned() {
 	}
 	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
 
-	// set unpermissioned sequencer
+	// set unpermissioned sequencer, e.g. after its permission was revoked
 	sequencer := sequencertypes.Sequencer{
 		SequencerAddress: bob,
 		RollappIDs:       []string{"rollapp1"},
 	}
 	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencer)
+	suite.app.SequencerKeeper.SetScheduler(suite.ctx, sequencertypes.Scheduler{
+		SequencerAddress: bob,
+		Status:           sequencertypes.Proposer,
+	})
 
 	// update state
 	updateState := types.MsgUpdateState{
@@ -333,7 +337,7 @@ func (suite *RollappTestSuite) TestUpdateStateErrLogicUnpermissioned() {
 	}
 
 	_, err := suite.msgServer.UpdateState(goCtx, &updateState)
-	suite.ErrorIs(err, sdkerrors.ErrLogic)
+	suite.ErrorIs(err, types.ErrUnpermissionedSequencer)
 }
 
 func (suite *RollappTestSuite) TestFirstUpdateStateErrWrongBlockHeightInitial() {
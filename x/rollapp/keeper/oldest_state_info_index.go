@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// OldestStateInfoIndex defines the rollapps' index of the oldest StateInfo that was not pruned
+
+// SetOldestStateInfoIndex set a specific oldestStateInfoIndex in the store from its index
+func (k Keeper) SetOldestStateInfoIndex(ctx sdk.Context, oldestStateInfoIndex types.StateInfoIndex) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OldestStateInfoIndexKeyPrefix))
+	b := k.cdc.MustMarshal(&oldestStateInfoIndex)
+	store.Set(types.OldestStateInfoIndexKey(
+		oldestStateInfoIndex.RollappId,
+	), b)
+}
+
+// GetOldestStateInfoIndex returns a oldestStateInfoIndex from its index.
+// It is not found as long as no state of the rollapp was pruned
+func (k Keeper) GetOldestStateInfoIndex(
+	ctx sdk.Context,
+	rollappId string,
+
+) (val types.StateInfoIndex, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OldestStateInfoIndexKeyPrefix))
+
+	b := store.Get(types.OldestStateInfoIndexKey(
+		rollappId,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveOldestStateInfoIndex removes a oldestStateInfoIndex from the store
+func (k Keeper) RemoveOldestStateInfoIndex(
+	ctx sdk.Context,
+	rollappId string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OldestStateInfoIndexKeyPrefix))
+	store.Delete(types.OldestStateInfoIndexKey(
+		rollappId,
+	))
+}
+
+// GetAllOldestStateInfoIndex returns all oldestStateInfoIndex
+func (k Keeper) GetAllOldestStateInfoIndex(ctx sdk.Context) (list []types.StateInfoIndex) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OldestStateInfoIndexKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StateInfoIndex
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// oldestStateIndex returns the index of the oldest StateInfo of the rollapp that was not pruned
+func (k Keeper) oldestStateIndex(ctx sdk.Context, rollappId string) uint64 {
+	oldestStateInfoIndex, found := k.GetOldestStateInfoIndex(ctx, rollappId)
+	if !found {
+		return 1
+	}
+	return oldestStateInfoIndex.Index
+}
@@ -11,6 +11,11 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.RollappsEnabled(ctx),
 		k.DisputePeriodInBlocks(ctx),
 		k.DeployerWhitelist(ctx),
+		k.StateInfoRetention(ctx),
+		k.MinDisputePeriodInBlocks(ctx),
+		k.MaxDisputePeriodInBlocks(ctx),
+		k.RegistrationFee(ctx),
+		k.BurnRegistrationFee(ctx),
 	)
 }
 
@@ -35,3 +40,33 @@ func (k Keeper) RollappsEnabled(ctx sdk.Context) (res bool) {
 	k.paramstore.Get(ctx, types.KeyRollappsEnabled, &res)
 	return
 }
+
+// StateInfoRetention returns the StateInfoRetention param
+func (k Keeper) StateInfoRetention(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyStateInfoRetention, &res)
+	return
+}
+
+// MinDisputePeriodInBlocks returns the MinDisputePeriodInBlocks param
+func (k Keeper) MinDisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMinDisputePeriodInBlocks, &res)
+	return
+}
+
+// MaxDisputePeriodInBlocks returns the MaxDisputePeriodInBlocks param
+func (k Keeper) MaxDisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxDisputePeriodInBlocks, &res)
+	return
+}
+
+// RegistrationFee returns the RegistrationFee param
+func (k Keeper) RegistrationFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyRegistrationFee, &res)
+	return
+}
+
+// BurnRegistrationFee returns the BurnRegistrationFee param
+func (k Keeper) BurnRegistrationFee(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyBurnRegistrationFee, &res)
+	return
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// maxPrunedStatesPerBlock bounds the number of states pruned in a single block
+const maxPrunedStatesPerBlock = 100
+
+// PruneFinalizedStates removes the finalized states of the rollapps beyond the StateInfoRetention param.
+// At most maxPrunedStatesPerBlock states are removed, the remaining ones are pruned on the next finalizations.
+func (k Keeper) PruneFinalizedStates(ctx sdk.Context, rollappIds []string) {
+	retention := k.StateInfoRetention(ctx)
+	if retention == 0 {
+		return
+	}
+
+	budget := uint64(maxPrunedStatesPerBlock)
+	for _, rollappId := range rollappIds {
+		if budget == 0 {
+			return
+		}
+		budget -= k.pruneFinalizedStates(ctx, rollappId, retention, budget)
+	}
+}
+
+// pruneFinalizedStates removes up to limit finalized states of the rollapp, keeping the latest retention ones.
+// It returns the number of removed states.
+func (k Keeper) pruneFinalizedStates(ctx sdk.Context, rollappId string, retention uint64, limit uint64) uint64 {
+	latestFinalizedStateIndex, found := k.GetLatestFinalizedStateIndex(ctx, rollappId)
+	if !found || latestFinalizedStateIndex.Index <= retention {
+		return 0
+	}
+	// states up to this index are pruned
+	pruneUntil := latestFinalizedStateIndex.Index - retention
+
+	oldest := k.oldestStateIndex(ctx, rollappId)
+	pruned := uint64(0)
+	for ; oldest <= pruneUntil && pruned < limit; oldest++ {
+		k.RemoveStateInfo(ctx, rollappId, oldest)
+		pruned++
+	}
+	if pruned == 0 {
+		return 0
+	}
+
+	k.SetOldestStateInfoIndex(ctx, types.StateInfoIndex{
+		RollappId: rollappId,
+		Index:     oldest,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeStatesPruned,
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollappId),
+			sdk.NewAttribute(types.AttributeKeyStateInfoIndex, strconv.FormatUint(oldest, 10)),
+		),
+	)
+
+	return pruned
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/dymensionxyz/dymension/testutil/keeper"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneFinalizedStates(t *testing.T) {
+	keeper, ctx := keepertest.RollappKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	rollappId := "rollappId"
+	stateInfos := createNStateInfoAndIndex(keeper, ctx, 150, rollappId)
+
+	// nothing is pruned without retention
+	keeper.PruneFinalizedStates(ctx, []string{rollappId})
+	_, found := keeper.GetOldestStateInfoIndex(ctx, rollappId)
+	require.False(t, found)
+
+	params := types.DefaultParams()
+	params.StateInfoRetention = 10
+	keeper.SetParams(ctx, params)
+
+	// the pruning is bounded per block
+	keeper.PruneFinalizedStates(ctx, []string{rollappId})
+	oldest, found := keeper.GetOldestStateInfoIndex(ctx, rollappId)
+	require.True(t, found)
+	require.Equal(t, uint64(101), oldest.Index)
+	keeper.PruneFinalizedStates(ctx, []string{rollappId})
+	oldest, _ = keeper.GetOldestStateInfoIndex(ctx, rollappId)
+	require.Equal(t, uint64(141), oldest.Index)
+	_, found = keeper.GetStateInfo(ctx, rollappId, 140)
+	require.False(t, found)
+	_, found = keeper.GetStateInfo(ctx, rollappId, 141)
+	require.True(t, found)
+
+	// pruned heights are reported as such
+	_, err := keeper.FindStateInfoByHeight(ctx, rollappId, stateInfos[139].StartHeight)
+	require.ErrorIs(t, err, types.ErrStatePruned)
+	stateInfo, err := keeper.FindStateInfoByHeight(ctx, rollappId, stateInfos[140].StartHeight)
+	require.NoError(t, err)
+	require.Equal(t, stateInfos[140], *stateInfo)
+	_, err = keeper.StateInfo(wctx, &types.QueryGetStateInfoRequest{RollappId: rollappId, Index: 1})
+	require.ErrorIs(t, err, types.ErrStatePruned)
+
+	// non finalized states are not pruned
+	keeper.SetLatestFinalizedStateIndex(ctx, types.StateInfoIndex{RollappId: rollappId, Index: 145})
+	keeper.PruneFinalizedStates(ctx, []string{rollappId})
+	oldest, _ = keeper.GetOldestStateInfoIndex(ctx, rollappId)
+	require.Equal(t, uint64(141), oldest.Index)
+}
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v6/modules/light-clients/07-tendermint/types"
 	"github.com/dymensionxyz/dymension/x/rollapp/types"
 )
 
@@ -14,6 +19,14 @@ func (k Keeper) SetRollapp(ctx sdk.Context, rollapp types.Rollapp) {
 		rollapp.RollappId,
 	), b)
 
+	// index the rollapp by its canonical client
+	if rollapp.ClientId != "" {
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappByClientIDKeyPrefix))
+		store.Set(types.RollappByClientIDKey(
+			rollapp.ClientId,
+		), []byte(rollapp.RollappId))
+	}
+
 	// check if chain-id is EVM compatible
 	eip155, err := types.ParseChainID(rollapp.RollappId)
 	if err != nil || eip155 == nil {
@@ -27,6 +40,14 @@ func (k Keeper) SetRollapp(ctx sdk.Context, rollapp types.Rollapp) {
 	), b)
 }
 
+// RemoveRollappByEIP155 removes the EIP155 index of a rollapp
+func (k Keeper) RemoveRollappByEIP155(ctx sdk.Context, eip155 uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappByEIP155KeyPrefix))
+	store.Delete(types.RollappByEIP155Key(
+		eip155,
+	))
+}
+
 // GetRollappByEIP155 returns a rollapp from its index
 func (k Keeper) GetRollappByEIP155(
 	ctx sdk.Context,
@@ -45,6 +66,87 @@ func (k Keeper) GetRollappByEIP155(
 	return val, true
 }
 
+// RemoveRollappByClientID removes the canonical client index of a rollapp
+func (k Keeper) RemoveRollappByClientID(ctx sdk.Context, clientID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappByClientIDKeyPrefix))
+	store.Delete(types.RollappByClientIDKey(
+		clientID,
+	))
+}
+
+// GetRollappByClientID returns the rollapp bound to an IBC client
+func (k Keeper) GetRollappByClientID(
+	ctx sdk.Context,
+	clientID string,
+) (val types.Rollapp, found bool) {
+	rollappId, found := k.getRollappIdByClientID(ctx, clientID)
+	if !found {
+		return val, false
+	}
+
+	return k.GetRollapp(ctx, rollappId)
+}
+
+// getRollappIdByClientID returns the id of the rollapp bound to an IBC client.
+// The client of a deregistered rollapp stays bound to its id, so it can't be trusted again.
+func (k Keeper) getRollappIdByClientID(ctx sdk.Context, clientID string) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappByClientIDKeyPrefix))
+
+	b := store.Get(types.RollappByClientIDKey(
+		clientID,
+	))
+	if b == nil {
+		return "", false
+	}
+	return string(b), true
+}
+
+// GetRollappByPortChannel returns the rollapp a hub channel is bound to.
+// A channel belongs to a rollapp only if it runs over the rollapp canonical client,
+// and is one of the rollapp channels if the rollapp restricts them.
+// A channel to a chain that claims the id of a registered rollapp without being bound to it is rejected,
+// so its packets can't bypass the rollapp finalization.
+func (k Keeper) GetRollappByPortChannel(
+	ctx sdk.Context,
+	portID string,
+	channelID string,
+) (val types.Rollapp, found bool, err error) {
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return val, false, fmt.Errorf("failed to extract clientID from channel: %w", err)
+	}
+
+	rollappId, found := k.getRollappIdByClientID(ctx, clientID)
+	if !found {
+		return val, false, k.checkNotRollappChain(ctx, clientState, channelID)
+	}
+	rollapp, found := k.GetRollapp(ctx, rollappId)
+	if !found {
+		return val, false, sdkerrors.Wrapf(types.ErrRollappDeregistered, "rollappId=%s", rollappId)
+	}
+	if len(rollapp.ChannelIds) == 0 {
+		return rollapp, true, nil
+	}
+	for _, id := range rollapp.ChannelIds {
+		if id == channelID {
+			return rollapp, true, nil
+		}
+	}
+	return val, false, k.checkNotRollappChain(ctx, clientState, channelID)
+}
+
+// checkNotRollappChain returns an error if the chain behind a client claims the id of a registered rollapp.
+func (k Keeper) checkNotRollappChain(ctx sdk.Context, clientState exported.ClientState, channelID string) error {
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil
+	}
+	if _, found := k.GetRollapp(ctx, tmClientState.ChainId); found {
+		return sdkerrors.Wrapf(types.ErrRollappChannelNotBound, "rollappId=%s, channelId=%s", tmClientState.ChainId, channelID)
+	}
+	return nil
+}
+
 // GetRollapp returns a rollapp from its index
 func (k Keeper) GetRollapp(
 	ctx sdk.Context,
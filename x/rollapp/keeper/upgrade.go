@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// expectedVersion returns the version a state update of the rollapp starting at startHeight must carry.
+// A batch running the new version of a pending upgrade must start from the upgrade height, so a batch
+// can not span both versions.
+func expectedVersion(rollapp types.Rollapp, startHeight uint64, numBlocks uint64) (uint64, error) {
+	upgrade := rollapp.PendingUpgrade
+	if upgrade == nil || startHeight+numBlocks <= upgrade.Height {
+		return rollapp.Version, nil
+	}
+	if startHeight < upgrade.Height {
+		return 0, sdkerrors.Wrapf(types.ErrVersionMismatch,
+			"rollappId(%s) upgrades to version %d at height %d, the batch must end before it",
+			rollapp.RollappId, upgrade.Version, upgrade.Height)
+	}
+	return upgrade.Version, nil
+}
+
+// ApplyFinalizedUpgrade bumps the rollapp version to the one of its pending upgrade once a state running
+// the new version is finalized. Until then a fraud can revert the rollapp to before the upgrade height,
+// so the pending upgrade is kept to check the version of the states submitted again.
+func (k Keeper) ApplyFinalizedUpgrade(ctx sdk.Context, stateInfo types.StateInfo) {
+	rollapp, found := k.GetRollapp(ctx, stateInfo.StateInfoIndex.RollappId)
+	if !found || rollapp.PendingUpgrade == nil || stateInfo.StartHeight < rollapp.PendingUpgrade.Height {
+		return
+	}
+
+	upgrade := rollapp.PendingUpgrade
+	rollapp.Version = upgrade.Version
+	rollapp.PendingUpgrade = nil
+	k.SetRollapp(ctx, rollapp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpgradeApplied,
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollapp.RollappId),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(upgrade.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpgradeHeight, strconv.FormatUint(upgrade.Height, 10)),
+		),
+	)
+}
+
+// nextStartHeight returns the rollapp height the next state update is expected to start from
+func (k Keeper) nextStartHeight(ctx sdk.Context, rollappId string) uint64 {
+	latestStateInfoIndex, found := k.GetLatestStateInfoIndex(ctx, rollappId)
+	if !found {
+		return 1
+	}
+	stateInfo, found := k.GetStateInfo(ctx, rollappId, latestStateInfoIndex.Index)
+	if !found {
+		return 1
+	}
+	return stateInfo.StartHeight + stateInfo.NumBlocks
+}
@@ -0,0 +1,100 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// stateInfoMigrationBatchSize is the number of StateInfos rewritten at once
+const stateInfoMigrationBatchSize = 1000
+
+// legacyStateInfoKeyStart is the first possible legacy StateInfo key, following all the new keys
+var legacyStateInfoKeyStart = []byte{0x01}
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The params added since v2 are set to their defaults, and the StateInfos are re-keyed
+// and given the finalization height they are queued for.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	paramstore.Set(ctx, types.KeyStateInfoRetention, types.DefaultStateInfoRetention)
+	paramstore.Set(ctx, types.KeyMinDisputePeriodInBlocks, types.MinDisputePeriodInBlocks)
+	paramstore.Set(ctx, types.KeyMaxDisputePeriodInBlocks, types.DefaultMaxDisputePeriodInBlocks)
+	paramstore.Set(ctx, types.KeyRegistrationFee, types.DefaultRegistrationFee)
+	paramstore.Set(ctx, types.KeyBurnRegistrationFee, false)
+
+	if err := migrateStateInfos(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	return migrateFinalizationHeights(ctx, storeKey, cdc)
+}
+
+// migrateStateInfos re-keys the StateInfos. They were keyed by the rollapp id and the decimal string
+// of their index, which doesn't keep the states of a rollapp ordered nor separates the states of rollapps
+// whose ids prefix each other. They are re-keyed by the length prefixed rollapp id and the big endian
+// encoded index.
+// The states are rewritten by batches to bound the memory used. The new keys start with the zero byte
+// of the rollapp id length, so the legacy keys, starting with the rollapp id, are the keys that follow.
+func migrateStateInfos(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.StateInfoKeyPrefix))
+
+	for {
+		var keys [][]byte
+		var stateInfos []types.StateInfo
+		iterator := store.Iterator(legacyStateInfoKeyStart, nil)
+		for ; iterator.Valid() && len(keys) < stateInfoMigrationBatchSize; iterator.Next() {
+			var stateInfo types.StateInfo
+			if err := cdc.Unmarshal(iterator.Value(), &stateInfo); err != nil {
+				iterator.Close() // nolint: errcheck
+				return err
+			}
+			keys = append(keys, iterator.Key())
+			stateInfos = append(stateInfos, stateInfo)
+		}
+		iterator.Close() // nolint: errcheck
+
+		if len(keys) == 0 {
+			return nil
+		}
+		for i := range keys {
+			store.Delete(keys[i])
+			store.Set(types.StateInfoKey(stateInfos[i].StateInfoIndex), cdc.MustMarshal(&stateInfos[i]))
+		}
+	}
+}
+
+// migrateFinalizationHeights records the finalization height of the pending states from the finalization queues
+func migrateFinalizationHeights(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	queueStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.StateInfoKeyPrefix))
+
+	var queues []types.BlockHeightToFinalizationQueue
+	iterator := queueStore.Iterator(types.BlockHeightToFinalizationQueueKey(uint64(ctx.BlockHeight())), nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var queue types.BlockHeightToFinalizationQueue
+		if err := cdc.Unmarshal(iterator.Value(), &queue); err != nil {
+			iterator.Close() // nolint: errcheck
+			return err
+		}
+		queues = append(queues, queue)
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, queue := range queues {
+		for _, stateInfoIndex := range queue.FinalizationQueue {
+			b := store.Get(types.StateInfoKey(stateInfoIndex))
+			if b == nil {
+				continue
+			}
+			var stateInfo types.StateInfo
+			if err := cdc.Unmarshal(b, &stateInfo); err != nil {
+				return err
+			}
+			stateInfo.FinalizationHeight = queue.FinalizationHeight
+			store.Set(types.StateInfoKey(stateInfoIndex), cdc.MustMarshal(&stateInfo))
+		}
+	}
+	return nil
+}
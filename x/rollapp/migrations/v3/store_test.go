@@ -0,0 +1,95 @@
+package v3_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/dymensionxyz/dymension/x/rollapp/migrations/v3"
+	"github.com/dymensionxyz/dymension/x/rollapp/types"
+)
+
+// legacyStateInfoKey is the v2 StateInfo key, using the decimal string of the index
+func legacyStateInfoKey(stateInfoIndex types.StateInfoIndex) []byte {
+	return []byte(fmt.Sprintf("%s/%d/", stateInfoIndex.RollappId, stateInfoIndex.Index))
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	// the params of v2
+	paramstore.Set(ctx, types.KeyRollappsEnabled, true)
+	paramstore.Set(ctx, types.KeyDeployerWhitelist, []types.DeployerParams{})
+	paramstore.Set(ctx, types.KeyDisputePeriodInBlocks, types.DefaultDisputePeriodInBlocks)
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.StateInfoKeyPrefix))
+
+	// rollapp ids prefixing each other, and more states than a migration batch
+	rollapps := []struct {
+		rollappId string
+		numStates uint64
+	}{
+		{"rollapp1", 11},
+		{"rollapp10", 11},
+		{"rollapp1/1", 11},
+		{"rollapp2", 2500},
+	}
+	var stateInfos []types.StateInfo
+	for _, rollapp := range rollapps {
+		for i := uint64(1); i <= rollapp.numStates; i++ {
+			stateInfo := types.StateInfo{
+				StateInfoIndex: types.StateInfoIndex{RollappId: rollapp.rollappId, Index: i},
+				StartHeight:    i,
+				NumBlocks:      1,
+			}
+			store.Set(legacyStateInfoKey(stateInfo.StateInfoIndex), cdc.MustMarshal(&stateInfo))
+			stateInfos = append(stateInfos, stateInfo)
+		}
+	}
+
+	// the last state of rollapp1 is pending
+	queueStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.BlockHeightToFinalizationQueueKeyPrefix))
+	queue := types.BlockHeightToFinalizationQueue{FinalizationHeight: 20, FinalizationQueue: []types.StateInfoIndex{stateInfos[10].StateInfoIndex}}
+	queueStore.Set(types.BlockHeightToFinalizationQueueKey(queue.FinalizationHeight), cdc.MustMarshal(&queue))
+	stateInfos[10].FinalizationHeight = 20
+
+	require.False(t, paramstore.Has(ctx, types.KeyStateInfoRetention))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc, paramstore))
+
+	// the new params are set to their defaults
+	var params types.Params
+	require.NotPanics(t, func() { paramstore.GetParamSet(ctx, &params) })
+	require.Equal(t, types.DefaultStateInfoRetention, params.StateInfoRetention)
+	require.Equal(t, types.MinDisputePeriodInBlocks, params.MinDisputePeriodInBlocks)
+	require.Equal(t, types.DefaultMaxDisputePeriodInBlocks, params.MaxDisputePeriodInBlocks)
+	require.Equal(t, types.DefaultRegistrationFee, params.RegistrationFee)
+	require.False(t, params.BurnRegistrationFee)
+
+	// the states of a rollapp are iterated by index, without the states of other rollapps
+	rollappStore := prefix.NewStore(store, types.StateInfoByRollappKey("rollapp1"))
+	iterator := rollappStore.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+	var migrated []types.StateInfo
+	for ; iterator.Valid(); iterator.Next() {
+		var stateInfo types.StateInfo
+		cdc.MustUnmarshal(iterator.Value(), &stateInfo)
+		migrated = append(migrated, stateInfo)
+	}
+	require.Equal(t, stateInfos[:11], migrated)
+
+	for _, stateInfo := range stateInfos {
+		require.False(t, store.Has(legacyStateInfoKey(stateInfo.StateInfoIndex)))
+		require.True(t, store.Has(types.StateInfoKey(stateInfo.StateInfoIndex)))
+	}
+}
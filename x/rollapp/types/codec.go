@@ -10,6 +10,12 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRollapp{}, "rollapp/CreateRollapp", nil)
 	cdc.RegisterConcrete(&MsgUpdateState{}, "rollapp/UpdateState", nil)
+	cdc.RegisterConcrete(&MsgSubmitFraud{}, "rollapp/SubmitFraud", nil)
+	cdc.RegisterConcrete(&MsgSetCanonicalClient{}, "rollapp/SetCanonicalClient", nil)
+	cdc.RegisterConcrete(&MsgUpdateRollapp{}, "rollapp/UpdateRollapp", nil)
+	cdc.RegisterConcrete(&MsgScheduleRollappUpgrade{}, "rollapp/ScheduleRollappUpgrade", nil)
+	cdc.RegisterConcrete(&MsgFreezeRollapp{}, "rollapp/FreezeRollapp", nil)
+	cdc.RegisterConcrete(&MsgDeregisterRollapp{}, "rollapp/DeregisterRollapp", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -20,6 +26,24 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateState{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSubmitFraud{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetCanonicalClient{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateRollapp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgScheduleRollappUpgrade{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFreezeRollapp{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeregisterRollapp{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
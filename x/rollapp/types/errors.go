@@ -30,4 +30,19 @@ var (
 	ErrInvalidRollappID                    = sdkerrors.Register(ModuleName, 1020, "invalid rollapp-id")
 	ErrEIP155Exists                        = sdkerrors.Register(ModuleName, 1021, "EIP155 already exist; must use unique EIP155 identifier")
 	ErrRollappsDisabled                    = sdkerrors.Register(ModuleName, 1022, "rollapps are disabled")
+	ErrInvalidStateStatus                  = sdkerrors.Register(ModuleName, 1023, "state status does not allow this operation")
+	ErrUnauthorizedSigner                  = sdkerrors.Register(ModuleName, 1024, "signer is neither the rollapp creator nor its proposer")
+	ErrInvalidClientID                     = sdkerrors.Register(ModuleName, 1025, "invalid client-id")
+	ErrClientAlreadyBound                  = sdkerrors.Register(ModuleName, 1026, "client is already bound to another rollapp")
+	ErrInvalidChannelID                    = sdkerrors.Register(ModuleName, 1027, "invalid channel-id")
+	ErrInvalidTokenMetadata                = sdkerrors.Register(ModuleName, 1028, "invalid token metadata")
+	ErrEmptyRollappUpdate                  = sdkerrors.Register(ModuleName, 1029, "rollapp update has no changes")
+	ErrUnpermissionedSequencer             = sdkerrors.Register(ModuleName, 1030, "sequencer is not permissioned for this rollapp")
+	ErrInvalidUpgrade                      = sdkerrors.Register(ModuleName, 1031, "invalid rollapp upgrade")
+	ErrStatePruned                         = sdkerrors.Register(ModuleName, 1032, "state was pruned")
+	ErrInvalidDisputePeriod                = sdkerrors.Register(ModuleName, 1033, "dispute period is out of the allowed bounds")
+	ErrRollappFrozen                       = sdkerrors.Register(ModuleName, 1034, "rollapp is frozen")
+	ErrRollappNotFrozen                    = sdkerrors.Register(ModuleName, 1035, "rollapp is not frozen")
+	ErrRollappDeregistered                 = sdkerrors.Register(ModuleName, 1036, "rollapp was deregistered")
+	ErrRollappChannelNotBound              = sdkerrors.Register(ModuleName, 1037, "channel is not bound to the rollapp")
 )
@@ -3,6 +3,19 @@ package types
 const (
 	EventTypeStateUpdate  = "state_update"
 	EventTypeStatusChange = "status_change"
+	EventTypeFraud        = "fraud"
+	EventTypeStatesPruned = "states_pruned"
+	EventTypeClientBound  = "canonical_client_bound"
+
+	EventTypePermissionGranted    = "sequencer_permission_granted"
+	EventTypePermissionRevoked    = "sequencer_permission_revoked"
+	EventTypeMaxSequencersUpdated = "max_sequencers_updated"
+	EventTypeTokenMetadataAdded   = "token_metadata_added"
+	EventTypeUpgradeScheduled     = "rollapp_upgrade_scheduled"
+	EventTypeUpgradeApplied       = "rollapp_upgrade_applied"
+	EventTypeDisputePeriodUpdated = "dispute_period_updated"
+	EventTypeRollappFrozen        = "rollapp_frozen"
+	EventTypeRollappDeregistered  = "rollapp_deregistered"
 
 	AttributeKeyRollappId      = "rollapp_id"
 	AttributeKeyStateInfoIndex = "state_info_index"
@@ -10,4 +23,14 @@ const (
 	AttributeKeyNumBlocks      = "num_blocks"
 	AttributeKeyDAPath         = "da_path"
 	AttributeKeyStatus         = "status"
+	AttributeKeyFraudProof     = "fraud_proof"
+	AttributeKeyClientId       = "client_id"
+	AttributeKeyChannelIds     = "channel_ids"
+	AttributeKeySequencer      = "sequencer"
+	AttributeKeyMaxSequencers  = "max_sequencers"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyVersion        = "version"
+	AttributeKeyUpgradeHeight  = "upgrade_height"
+	AttributeKeyDisputePeriod  = "dispute_period"
+	AttributeKeyHeight         = "height"
 )
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -14,5 +15,30 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
+
+// DistrKeeper defines the expected distribution keeper used to fund the community pool
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error)
+}
+
+// ClientKeeper defines the expected IBC client keeper
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+	GetClientConsensusState(ctx sdk.Context, clientID string, height exported.Height) (exported.ConsensusState, bool)
+	IterateClients(ctx sdk.Context, cb func(clientID string, cs exported.ClientState) bool)
+}
+
+// SequencerKeeper defines the expected sequencer keeper used to authorize the rollapp proposer and sequencers
+type SequencerKeeper interface {
+	GetRollappProposer(ctx sdk.Context, rollappId string) (string, bool)
+	GetRollappSequencers(ctx sdk.Context, rollappId string) []string
+}
@@ -15,6 +15,7 @@ func DefaultGenesis() *GenesisState {
 		LatestStateInfoIndexList:           []StateInfoIndex{},
 		LatestFinalizedStateIndexList:      []StateInfoIndex{},
 		BlockHeightToFinalizationQueueList: []BlockHeightToFinalizationQueue{},
+		OldestStateInfoIndexList:           []StateInfoIndex{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -25,6 +26,8 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// Check for duplicated index in rollapp
 	rollappIndexMap := make(map[string]struct{})
+	rollappClientIDMap := make(map[string]struct{})
+	rollappEIP155Map := make(map[string]struct{})
 
 	for _, elem := range gs.RollappList {
 		index := string(RollappKey(elem.RollappId))
@@ -32,6 +35,21 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for rollapp")
 		}
 		rollappIndexMap[index] = struct{}{}
+		// an EIP155 number can only be used by a single rollapp
+		if eip155, err := ParseChainID(elem.RollappId); err == nil && eip155 != nil {
+			if _, ok := rollappEIP155Map[eip155.String()]; ok {
+				return fmt.Errorf("duplicated EIP155 for rollapp")
+			}
+			rollappEIP155Map[eip155.String()] = struct{}{}
+		}
+		// a client can only be bound to a single rollapp
+		if elem.ClientId == "" {
+			continue
+		}
+		if _, ok := rollappClientIDMap[elem.ClientId]; ok {
+			return fmt.Errorf("duplicated client id for rollapp")
+		}
+		rollappClientIDMap[elem.ClientId] = struct{}{}
 	}
 	// Check for duplicated index in stateInfo
 	stateInfoIndexMap := make(map[string]struct{})
@@ -73,6 +91,16 @@ func (gs GenesisState) Validate() error {
 		}
 		blockHeightToFinalizationQueueIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in oldestStateInfoIndex
+	oldestStateInfoIndexIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.OldestStateInfoIndexList {
+		index := string(OldestStateInfoIndexKey(elem.RollappId))
+		if _, ok := oldestStateInfoIndexIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for oldestStateInfoIndex")
+		}
+		oldestStateInfoIndexIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
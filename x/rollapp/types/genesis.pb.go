@@ -31,6 +31,7 @@ type GenesisState struct {
 	LatestStateInfoIndexList           []StateInfoIndex                 `protobuf:"bytes,4,rep,name=latestStateInfoIndexList,proto3" json:"latestStateInfoIndexList"`
 	LatestFinalizedStateIndexList      []StateInfoIndex                 `protobuf:"bytes,5,rep,name=latestFinalizedStateIndexList,proto3" json:"latestFinalizedStateIndexList"`
 	BlockHeightToFinalizationQueueList []BlockHeightToFinalizationQueue `protobuf:"bytes,6,rep,name=blockHeightToFinalizationQueueList,proto3" json:"blockHeightToFinalizationQueueList"`
+	OldestStateInfoIndexList           []StateInfoIndex                 `protobuf:"bytes,7,rep,name=oldestStateInfoIndexList,proto3" json:"oldestStateInfoIndexList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +109,13 @@ func (m *GenesisState) GetBlockHeightToFinalizationQueueList() []BlockHeightToFi
 	return nil
 }
 
+func (m *GenesisState) GetOldestStateInfoIndexList() []StateInfoIndex {
+	if m != nil {
+		return m.OldestStateInfoIndexList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.rollapp.GenesisState")
 }
@@ -115,30 +123,31 @@ func init() {
 func init() { proto.RegisterFile("dymension/rollapp/genesis.proto", fileDescriptor_f4bf6d3c28914609) }
 
 var fileDescriptor_f4bf6d3c28914609 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x3f, 0x4f, 0x3a, 0x31,
-	0x18, 0xc7, 0xef, 0x7e, 0xfc, 0x19, 0xca, 0xcf, 0xa5, 0x71, 0x20, 0x24, 0x16, 0xc2, 0xa0, 0xb8,
-	0xf4, 0x22, 0xee, 0x0e, 0xc4, 0xa8, 0x44, 0x13, 0x15, 0x74, 0x71, 0x31, 0x05, 0xca, 0xd1, 0x78,
-	0xb4, 0x17, 0x5a, 0x12, 0x60, 0xf3, 0x1d, 0x38, 0xf8, 0xa2, 0x18, 0x19, 0x9d, 0x8c, 0x81, 0x37,
-	0x62, 0xe8, 0x3d, 0x9c, 0x18, 0x14, 0x48, 0x9c, 0xda, 0xe6, 0xf9, 0x3e, 0x9f, 0xcf, 0x77, 0x28,
-	0xca, 0xb7, 0x86, 0x5d, 0x2e, 0xb5, 0x50, 0xd2, 0xeb, 0xa9, 0x20, 0x60, 0x61, 0xe8, 0xf9, 0x5c,
-	0x72, 0x2d, 0x34, 0x0d, 0x7b, 0xca, 0x28, 0x4c, 0xe2, 0xc0, 0x60, 0x38, 0xa2, 0xf1, 0x83, 0x42,
-	0x3a, 0xb7, 0xeb, 0x2b, 0x5f, 0xd9, 0xa8, 0x37, 0xbf, 0x45, 0x5b, 0x39, 0xb2, 0x8a, 0x0d, 0x59,
-	0x8f, 0x75, 0x81, 0x9a, 0xfb, 0x41, 0x0b, 0x27, 0x04, 0x8a, 0xab, 0x01, 0x6d, 0x98, 0xe1, 0x8f,
-	0x42, 0xb6, 0x41, 0x52, 0x7c, 0x4e, 0xa1, 0xff, 0xe7, 0x51, 0xd9, 0xfa, 0x7c, 0x86, 0x4f, 0x51,
-	0x3a, 0xb2, 0x64, 0xdd, 0x82, 0x5b, 0xca, 0x94, 0xf7, 0xe9, 0xfa, 0xf2, 0xf4, 0xc6, 0xa6, 0x2b,
-	0xc9, 0xf1, 0x7b, 0xde, 0xa9, 0xc1, 0x2e, 0xbe, 0x46, 0x19, 0x98, 0x5f, 0x09, 0x6d, 0xb2, 0xff,
-	0x0a, 0x89, 0x52, 0xa6, 0x7c, 0xb0, 0x09, 0x55, 0x8b, 0x4e, 0x60, 0x2d, 0x13, 0xf0, 0x3d, 0xda,
-	0xb1, 0xdd, 0xab, 0xb2, 0xad, 0x2c, 0x32, 0x61, 0x91, 0x87, 0x9b, 0x90, 0xf5, 0xc5, 0x12, 0x40,
-	0xbf, 0x53, 0x70, 0x88, 0xb2, 0x01, 0x33, 0x5c, 0x9b, 0x38, 0x57, 0x95, 0x2d, 0x3e, 0xb0, 0x86,
-	0xa4, 0x35, 0xd0, 0xad, 0x0d, 0x76, 0x13, 0x34, 0xbf, 0x52, 0xf1, 0x08, 0xed, 0x45, 0xb3, 0x33,
-	0x21, 0x59, 0x20, 0x46, 0xbc, 0x05, 0xa1, 0x85, 0x36, 0xf5, 0x07, 0xed, 0x7a, 0x34, 0x7e, 0x75,
-	0x51, 0xb1, 0x11, 0xa8, 0xe6, 0xd3, 0x05, 0x17, 0x7e, 0xc7, 0xdc, 0x29, 0x08, 0x32, 0x23, 0x94,
-	0xbc, 0xed, 0xf3, 0x3e, 0xb7, 0x0d, 0xd2, 0xb6, 0xc1, 0xc9, 0xa6, 0x06, 0x95, 0xb5, 0x24, 0x68,
-	0xb4, 0x85, 0xaf, 0x72, 0x39, 0x9e, 0x12, 0x77, 0x32, 0x25, 0xee, 0xc7, 0x94, 0xb8, 0x2f, 0x33,
-	0xe2, 0x4c, 0x66, 0xc4, 0x79, 0x9b, 0x11, 0xe7, 0xe1, 0xc8, 0x17, 0xa6, 0xd3, 0x6f, 0xd0, 0xa6,
-	0xea, 0x7a, 0xcb, 0x6d, 0xbe, 0x1e, 0xde, 0x20, 0xfe, 0xdb, 0x66, 0x18, 0x72, 0xdd, 0x48, 0xdb,
-	0x7f, 0x7d, 0xfc, 0x19, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x3d, 0xbc, 0x58, 0x95, 0x03, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xcb, 0x4e, 0x32, 0x31,
+	0x18, 0x86, 0x67, 0x7e, 0x0e, 0x7f, 0x52, 0x74, 0xd3, 0xb8, 0x20, 0x24, 0x16, 0xc2, 0x42, 0x71,
+	0xd3, 0x89, 0xb8, 0x77, 0x41, 0x8c, 0x4a, 0x34, 0x51, 0x41, 0x37, 0x6e, 0x4c, 0x61, 0xca, 0xd0,
+	0x38, 0xb4, 0x13, 0x5a, 0x12, 0xe0, 0x2a, 0x5c, 0x78, 0x51, 0x2c, 0x59, 0xba, 0x32, 0x06, 0x2e,
+	0xc1, 0x1b, 0x30, 0x74, 0xca, 0x88, 0xe1, 0x30, 0x24, 0xac, 0x66, 0x9a, 0xbe, 0xdf, 0xf3, 0xbc,
+	0xc9, 0x97, 0x82, 0xbc, 0x3b, 0xe8, 0x50, 0x2e, 0x99, 0xe0, 0x4e, 0x57, 0xf8, 0x3e, 0x09, 0x02,
+	0xc7, 0xa3, 0x9c, 0x4a, 0x26, 0x71, 0xd0, 0x15, 0x4a, 0x40, 0x14, 0x05, 0xfa, 0x83, 0x21, 0x8e,
+	0x0e, 0xd8, 0xa4, 0x73, 0x07, 0x9e, 0xf0, 0x84, 0x8e, 0x3a, 0xb3, 0xbf, 0x70, 0x2a, 0x87, 0x96,
+	0xb1, 0x01, 0xe9, 0x92, 0x8e, 0xa1, 0xe6, 0x56, 0x68, 0xcd, 0xd7, 0x04, 0x8a, 0xcb, 0x01, 0xa9,
+	0x88, 0xa2, 0x2f, 0x8c, 0xb7, 0x8c, 0xa4, 0xf8, 0x9d, 0x02, 0x7b, 0x57, 0x61, 0xd9, 0xfa, 0xec,
+	0x0e, 0x5e, 0x80, 0x74, 0x68, 0xc9, 0xda, 0x05, 0xbb, 0x94, 0x29, 0x1f, 0xe1, 0xcd, 0xe5, 0xf1,
+	0xbd, 0x4e, 0x57, 0x92, 0xa3, 0xcf, 0xbc, 0x55, 0x33, 0xb3, 0xf0, 0x0e, 0x64, 0xcc, 0xfd, 0x2d,
+	0x93, 0x2a, 0xfb, 0xaf, 0x90, 0x28, 0x65, 0xca, 0xc7, 0x71, 0xa8, 0x5a, 0xf8, 0x35, 0xac, 0x45,
+	0x02, 0x7c, 0x02, 0xfb, 0xba, 0x7b, 0x95, 0xb7, 0x84, 0x46, 0x26, 0x34, 0xf2, 0x24, 0x0e, 0x59,
+	0x9f, 0x0f, 0x19, 0xe8, 0x5f, 0x0a, 0x0c, 0x40, 0xd6, 0x27, 0x8a, 0x4a, 0x15, 0xe5, 0xaa, 0xdc,
+	0xa5, 0x7d, 0x6d, 0x48, 0x6a, 0x03, 0xde, 0xda, 0xa0, 0x27, 0x8d, 0x66, 0x2d, 0x15, 0x0e, 0xc1,
+	0x61, 0x78, 0x77, 0xc9, 0x38, 0xf1, 0xd9, 0x90, 0xba, 0x26, 0x34, 0xd7, 0xa6, 0x76, 0xd0, 0x6e,
+	0x46, 0xc3, 0x77, 0x1b, 0x14, 0x1b, 0xbe, 0x68, 0xbe, 0x5e, 0x53, 0xe6, 0xb5, 0xd5, 0xa3, 0x30,
+	0x41, 0xa2, 0x98, 0xe0, 0x0f, 0x3d, 0xda, 0xa3, 0xba, 0x41, 0x5a, 0x37, 0x38, 0x8f, 0x6b, 0x50,
+	0xd9, 0x48, 0x32, 0x8d, 0xb6, 0xf0, 0xcd, 0x96, 0x20, 0x7c, 0x77, 0xf5, 0x12, 0xfe, 0xef, 0xb2,
+	0x84, 0x75, 0xd4, 0xca, 0xcd, 0x68, 0x82, 0xec, 0xf1, 0x04, 0xd9, 0x5f, 0x13, 0x64, 0xbf, 0x4d,
+	0x91, 0x35, 0x9e, 0x22, 0xeb, 0x63, 0x8a, 0xac, 0xe7, 0x53, 0x8f, 0xa9, 0x76, 0xaf, 0x81, 0x9b,
+	0xa2, 0xe3, 0x2c, 0x3a, 0x7f, 0x0f, 0x4e, 0x3f, 0x7a, 0x4d, 0x6a, 0x10, 0x50, 0xd9, 0x48, 0xeb,
+	0x97, 0x74, 0xf6, 0x33, 0x00, 0x92, 0x2f, 0xe6, 0x3b, 0x07, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -161,6 +170,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OldestStateInfoIndexList) > 0 {
+		for iNdEx := len(m.OldestStateInfoIndexList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OldestStateInfoIndexList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.BlockHeightToFinalizationQueueList) > 0 {
 		for iNdEx := len(m.BlockHeightToFinalizationQueueList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -293,6 +316,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OldestStateInfoIndexList) > 0 {
+		for _, e := range m.OldestStateInfoIndexList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -534,6 +563,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestStateInfoIndexList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldestStateInfoIndexList = append(m.OldestStateInfoIndexList, StateInfoIndex{})
+			if err := m.OldestStateInfoIndexList[len(m.OldestStateInfoIndexList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
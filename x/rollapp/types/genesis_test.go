@@ -28,8 +28,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state with empty DeployerWhitelist",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					DisputePeriodInBlocks: types.DefaultGenesis().Params.DisputePeriodInBlocks,
-					DeployerWhitelist:     []types.DeployerParams{},
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{},
 				},
 				RollappList: []types.Rollapp{
 					{
@@ -77,8 +80,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state with DeployerWhitelist",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					DisputePeriodInBlocks: types.DefaultGenesis().Params.DisputePeriodInBlocks,
-					DeployerWhitelist:     []types.DeployerParams{{deployerAddr1, 10}, {deployerAddr2, 0}},
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{{deployerAddr1, 10}, {deployerAddr2, 0}},
 				},
 				RollappList: []types.Rollapp{
 					{
@@ -126,8 +132,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "duplicated deployer in whitelist",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					DisputePeriodInBlocks: types.DefaultGenesis().Params.DisputePeriodInBlocks,
-					DeployerWhitelist:     []types.DeployerParams{{deployerAddr1, 10}, {deployerAddr1, 0}},
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{{deployerAddr1, 10}, {deployerAddr1, 0}},
 				},
 				RollappList:                        []types.Rollapp{},
 				StateInfoList:                      []types.StateInfo{},
@@ -140,8 +149,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "duplicated rollapp",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					DisputePeriodInBlocks: types.DefaultGenesis().Params.DisputePeriodInBlocks,
-					DeployerWhitelist:     []types.DeployerParams{},
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{},
 				},
 				RollappList:                        []types.Rollapp{{RollappId: "0"}, {RollappId: "0"}},
 				StateInfoList:                      []types.StateInfo{},
@@ -150,6 +162,40 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "client bound to two rollapps",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{},
+				},
+				RollappList:                        []types.Rollapp{{RollappId: "0", ClientId: "07-tendermint-0"}, {RollappId: "1", ClientId: "07-tendermint-0"}},
+				StateInfoList:                      []types.StateInfo{},
+				LatestStateInfoIndexList:           []types.StateInfoIndex{},
+				BlockHeightToFinalizationQueueList: []types.BlockHeightToFinalizationQueue{},
+			},
+			valid: false,
+		},
+		{
+			desc: "EIP155 used by two rollapps",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					DisputePeriodInBlocks:    types.DefaultGenesis().Params.DisputePeriodInBlocks,
+					MinDisputePeriodInBlocks: types.MinDisputePeriodInBlocks,
+					MaxDisputePeriodInBlocks: types.DefaultMaxDisputePeriodInBlocks,
+					RegistrationFee:          types.DefaultRegistrationFee,
+					DeployerWhitelist:        []types.DeployerParams{},
+				},
+				RollappList:                        []types.Rollapp{{RollappId: "evmos_9000-1"}, {RollappId: "other_9000-2"}},
+				StateInfoList:                      []types.StateInfo{},
+				LatestStateInfoIndexList:           []types.StateInfoIndex{},
+				BlockHeightToFinalizationQueueList: []types.BlockHeightToFinalizationQueue{},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid DisputePeriodInBlocks",
 			genState: &types.GenesisState{
@@ -211,6 +257,14 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated oldestStateInfoIndex",
+			genState: &types.GenesisState{
+				Params:                   types.Params{},
+				OldestStateInfoIndexList: []types.StateInfoIndex{{RollappId: "0", Index: 2}, {RollappId: "0", Index: 3}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
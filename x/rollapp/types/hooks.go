@@ -11,8 +11,13 @@ import (
 
 // RollappHooks event hooks for rollapp object (noalias)
 type RollappHooks interface {
-	BeforeUpdateState(ctx sdk.Context, seqAddr string, rollappId string) error         // Must be called when a rollapp's state changes
-	AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error // Must be called when a rollapp's state changes
+	BeforeUpdateState(ctx sdk.Context, seqAddr string, rollappId string) error               // Must be called when a rollapp's state changes
+	AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *StateInfo) error       // Must be called when a rollapp's state changes
+	AfterStatesReverted(ctx sdk.Context, rollappID string, stateInfos []StateInfo) error     // Must be called when rollapp's states are reverted
+	AfterSequencerPermissionRevoked(ctx sdk.Context, rollappID string, seqAddr string) error // Must be called when a sequencer is removed from the rollapp's permissioned addresses
+	AfterRollappRegistered(ctx sdk.Context, rollappID string) error                          // Must be called when a rollapp is registered
+	AfterRollappFrozen(ctx sdk.Context, rollappID string) error                              // Must be called when a rollapp is frozen
+	AfterRollappDeregistered(ctx sdk.Context, rollappID string) error                        // Must be called when a rollapp is deregistered
 }
 
 var _ RollappHooks = MultiRollappHooks{}
@@ -45,6 +50,56 @@ func (h MultiRollappHooks) AfterStateFinalized(ctx sdk.Context, rollappID string
 	return nil
 }
 
+func (h MultiRollappHooks) AfterStatesReverted(ctx sdk.Context, rollappID string, stateInfos []StateInfo) error {
+	for i := range h {
+		err := h[i].AfterStatesReverted(ctx, rollappID, stateInfos)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRollappHooks) AfterSequencerPermissionRevoked(ctx sdk.Context, rollappID string, seqAddr string) error {
+	for i := range h {
+		err := h[i].AfterSequencerPermissionRevoked(ctx, rollappID, seqAddr)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRollappHooks) AfterRollappRegistered(ctx sdk.Context, rollappID string) error {
+	for i := range h {
+		err := h[i].AfterRollappRegistered(ctx, rollappID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRollappHooks) AfterRollappFrozen(ctx sdk.Context, rollappID string) error {
+	for i := range h {
+		err := h[i].AfterRollappFrozen(ctx, rollappID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiRollappHooks) AfterRollappDeregistered(ctx sdk.Context, rollappID string) error {
+	for i := range h {
+		err := h[i].AfterRollappDeregistered(ctx, rollappID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BaseRollappHook struct {
 }
 
@@ -57,3 +112,23 @@ func (b BaseRollappHook) AfterStateFinalized(ctx sdk.Context, rollappID string,
 func (b BaseRollappHook) BeforeUpdateState(ctx sdk.Context, seqAddr string, rollappId string) error {
 	return nil
 }
+
+func (b BaseRollappHook) AfterStatesReverted(ctx sdk.Context, rollappID string, stateInfos []StateInfo) error {
+	return nil
+}
+
+func (b BaseRollappHook) AfterSequencerPermissionRevoked(ctx sdk.Context, rollappID string, seqAddr string) error {
+	return nil
+}
+
+func (b BaseRollappHook) AfterRollappRegistered(ctx sdk.Context, rollappID string) error {
+	return nil
+}
+
+func (b BaseRollappHook) AfterRollappFrozen(ctx sdk.Context, rollappID string) error {
+	return nil
+}
+
+func (b BaseRollappHook) AfterRollappDeregistered(ctx sdk.Context, rollappID string) error {
+	return nil
+}
@@ -0,0 +1,23 @@
+package types
+
+import "encoding/binary"
+
+var _ binary.ByteOrder
+
+const (
+	// OldestStateInfoIndexKeyPrefix is the prefix to retrieve all OldestStateInfoIndex
+	OldestStateInfoIndexKeyPrefix = "OldestStateInfoIndex/value/"
+)
+
+// OldestStateInfoIndexKey returns the store key to retrieve a OldestStateInfoIndex from the index fields
+func OldestStateInfoIndexKey(
+	rollappId string,
+) []byte {
+	var key []byte
+
+	rollappIdBytes := []byte(rollappId)
+	key = append(key, rollappIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
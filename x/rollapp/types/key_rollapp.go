@@ -8,6 +8,8 @@ const (
 	// RollappKeyPrefix is the prefix to retrieve all Rollapp
 	RollappKeyPrefix         = "Rollapp/value/"
 	RollappByEIP155KeyPrefix = "RollappByEIP155/value/"
+	// RollappByClientIDKeyPrefix is the prefix to retrieve the rollapp bound to an IBC client
+	RollappByClientIDKeyPrefix = "RollappByClientID/value/"
 )
 
 // RollappKey returns the store key to retrieve a Rollapp from the index fields
@@ -36,3 +38,16 @@ func RollappByEIP155Key(
 
 	return key
 }
+
+// RollappByClientIDKey returns the store key to retrieve a Rollapp from its canonical client
+func RollappByClientIDKey(
+	clientID string,
+) []byte {
+	var key []byte
+
+	clientIDBytes := []byte(clientID)
+	key = append(key, clientIDBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
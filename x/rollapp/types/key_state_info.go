@@ -2,7 +2,8 @@ package types
 
 import (
 	"encoding/binary"
-	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ binary.ByteOrder
@@ -12,18 +13,29 @@ const (
 	StateInfoKeyPrefix = "StateInfo/value/"
 )
 
-// StateInfoKey returns the store key to retrieve a StateInfo from the index fields
+// StateInfoKey returns the store key to retrieve a StateInfo from the index fields.
+// The index is big endian encoded, so the states of a rollapp are iterated by index
 func StateInfoKey(
 	stateInfoIndex StateInfoIndex,
 ) []byte {
 	var key []byte
 
-	rollappIdBytes := []byte(stateInfoIndex.RollappId)
+	key = append(key, StateInfoByRollappKey(stateInfoIndex.RollappId)...)
+	key = append(key, sdk.Uint64ToBigEndian(stateInfoIndex.Index)...)
+
+	return key
+}
+
+// StateInfoByRollappKey returns the store key prefix of the StateInfos of a rollapp.
+// The rollapp id is length prefixed, so the prefix of a rollapp is never the prefix of another one
+func StateInfoByRollappKey(
+	rollappId string,
+) []byte {
+	var key []byte
+
+	rollappIdBytes := []byte(rollappId)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(len(rollappIdBytes)))...)
 	key = append(key, rollappIdBytes...)
-	key = append(key, []byte("/")...)
-	stateInfoIndexBytes := []byte(fmt.Sprint(stateInfoIndex.Index))
-	key = append(key, stateInfoIndexBytes...)
-	key = append(key, []byte("/")...)
 
 	return key
 }
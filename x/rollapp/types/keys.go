@@ -20,3 +20,8 @@ const (
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+const (
+	// AppliedDisputePeriodKey stores the global dispute period the finalization queues were built with
+	AppliedDisputePeriodKey = "AppliedDisputePeriod/value/"
+)
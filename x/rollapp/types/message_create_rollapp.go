@@ -49,25 +49,29 @@ func (msg *MsgCreateRollapp) ValidateBasic() error {
 		return sdkerrors.Wrap(ErrInvalidMaxSequencers, "max-sequencers must be greater than 0")
 	}
 
-	// verifies that there's no duplicate address in PermissionedAddresses
-	// and addresses are in Bech32 format
-	permissionedAddresses := msg.GetPermissionedAddresses()
-	if len(permissionedAddresses) > 0 {
-		duplicateAddresses := make(map[string]bool)
-		for _, item := range permissionedAddresses {
-			// check if the item/element exist in the duplicateAddresses map
-			_, exist := duplicateAddresses[item]
-			if exist {
-				return sdkerrors.Wrapf(ErrPermissionedAddressesDuplicate, "address: %s", item)
-			}
-			// check Bech32 format
-			if _, err := sdk.AccAddressFromBech32(item); err != nil {
-				return sdkerrors.Wrapf(ErrInvalidPermissionedAddress, "invalid permissioned address: %s", err)
-			}
-			// mark as exist
-			duplicateAddresses[item] = true
-		}
+	if err := validatePermissionedAddresses(msg.GetPermissionedAddresses()); err != nil {
+		return err
 	}
 
 	return nil
 }
+
+// validatePermissionedAddresses verifies that there's no duplicate address in
+// permissionedAddresses and addresses are in Bech32 format
+func validatePermissionedAddresses(permissionedAddresses []string) error {
+	duplicateAddresses := make(map[string]bool)
+	for _, item := range permissionedAddresses {
+		// check if the item/element exist in the duplicateAddresses map
+		_, exist := duplicateAddresses[item]
+		if exist {
+			return sdkerrors.Wrapf(ErrPermissionedAddressesDuplicate, "address: %s", item)
+		}
+		// check Bech32 format
+		if _, err := sdk.AccAddressFromBech32(item); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidPermissionedAddress, "invalid permissioned address: %s", err)
+		}
+		// mark as exist
+		duplicateAddresses[item] = true
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgDeregisterRollapp = "deregister_rollapp"
+
+var _ sdk.Msg = &MsgDeregisterRollapp{}
+
+func NewMsgDeregisterRollapp(authority string, rollappId string) *MsgDeregisterRollapp {
+	return &MsgDeregisterRollapp{
+		Authority: authority,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgDeregisterRollapp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeregisterRollapp) Type() string {
+	return TypeMsgDeregisterRollapp
+}
+
+func (msg *MsgDeregisterRollapp) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgDeregisterRollapp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeregisterRollapp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	return nil
+}
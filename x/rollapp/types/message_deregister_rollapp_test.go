@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDeregisterRollapp_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeregisterRollapp
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeregisterRollapp{
+				Authority: "invalid_address",
+				RollappId: "rollapp1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgDeregisterRollapp{
+				Authority: sample.AccAddress(),
+				RollappId: "rollapp1",
+			},
+		}, {
+			name: "empty rollapp id",
+			msg: MsgDeregisterRollapp{
+				Authority: sample.AccAddress(),
+			},
+			err: ErrInvalidRollappID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgFreezeRollapp = "freeze_rollapp"
+
+var _ sdk.Msg = &MsgFreezeRollapp{}
+
+func NewMsgFreezeRollapp(authority string, rollappId string) *MsgFreezeRollapp {
+	return &MsgFreezeRollapp{
+		Authority: authority,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgFreezeRollapp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgFreezeRollapp) Type() string {
+	return TypeMsgFreezeRollapp
+}
+
+func (msg *MsgFreezeRollapp) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgFreezeRollapp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgFreezeRollapp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgScheduleRollappUpgrade = "schedule_rollapp_upgrade"
+
+var _ sdk.Msg = &MsgScheduleRollappUpgrade{}
+
+func NewMsgScheduleRollappUpgrade(creator string, rollappId string, version uint64, height uint64) *MsgScheduleRollappUpgrade {
+	return &MsgScheduleRollappUpgrade{
+		Creator:   creator,
+		RollappId: rollappId,
+		Version:   version,
+		Height:    height,
+	}
+}
+
+func (msg *MsgScheduleRollappUpgrade) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgScheduleRollappUpgrade) Type() string {
+	return TypeMsgScheduleRollappUpgrade
+}
+
+func (msg *MsgScheduleRollappUpgrade) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgScheduleRollappUpgrade) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgScheduleRollappUpgrade) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	if msg.Version == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "version must be greater than 0")
+	}
+
+	if msg.Height == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "height must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgScheduleRollappUpgrade_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgScheduleRollappUpgrade
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgScheduleRollappUpgrade{
+				Creator:   "invalid_address",
+				RollappId: "rollapp1",
+				Version:   1,
+				Height:    10,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgScheduleRollappUpgrade{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+				Version:   1,
+				Height:    10,
+			},
+		}, {
+			name: "empty rollapp id",
+			msg: MsgScheduleRollappUpgrade{
+				Creator: sample.AccAddress(),
+				Version: 1,
+				Height:  10,
+			},
+			err: ErrInvalidRollappID,
+		}, {
+			name: "zero version",
+			msg: MsgScheduleRollappUpgrade{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+				Height:    10,
+			},
+			err: ErrInvalidUpgrade,
+		}, {
+			name: "zero height",
+			msg: MsgScheduleRollappUpgrade{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+				Version:   1,
+			},
+			err: ErrInvalidUpgrade,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+const TypeMsgSetCanonicalClient = "set_canonical_client"
+
+var _ sdk.Msg = &MsgSetCanonicalClient{}
+
+func NewMsgSetCanonicalClient(creator string, rollappId string, clientId string, channelIds []string) *MsgSetCanonicalClient {
+	return &MsgSetCanonicalClient{
+		Creator:    creator,
+		RollappId:  rollappId,
+		ClientId:   clientId,
+		ChannelIds: channelIds,
+	}
+}
+
+func (msg *MsgSetCanonicalClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetCanonicalClient) Type() string {
+	return TypeMsgSetCanonicalClient
+}
+
+func (msg *MsgSetCanonicalClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSetCanonicalClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSetCanonicalClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClientID, err.Error())
+	}
+
+	channelIds := make(map[string]struct{})
+	for _, channelId := range msg.ChannelIds {
+		if err := host.ChannelIdentifierValidator(channelId); err != nil {
+			return sdkerrors.Wrap(ErrInvalidChannelID, err.Error())
+		}
+		if _, ok := channelIds[channelId]; ok {
+			return sdkerrors.Wrapf(ErrInvalidChannelID, "duplicated channel-id: %s", channelId)
+		}
+		channelIds[channelId] = struct{}{}
+	}
+
+	return nil
+}
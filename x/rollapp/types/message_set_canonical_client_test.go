@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetCanonicalClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetCanonicalClient
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetCanonicalClient{
+				Creator:   "invalid_address",
+				RollappId: "rollapp1",
+				ClientId:  "07-tendermint-0",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgSetCanonicalClient{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+				ClientId:  "07-tendermint-0",
+			},
+		}, {
+			name: "valid with channels",
+			msg: MsgSetCanonicalClient{
+				Creator:    sample.AccAddress(),
+				RollappId:  "rollapp1",
+				ClientId:   "07-tendermint-0",
+				ChannelIds: []string{"channel-0", "channel-1"},
+			},
+		}, {
+			name: "empty rollapp id",
+			msg: MsgSetCanonicalClient{
+				Creator:  sample.AccAddress(),
+				ClientId: "07-tendermint-0",
+			},
+			err: ErrInvalidRollappID,
+		}, {
+			name: "empty client id",
+			msg: MsgSetCanonicalClient{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+			},
+			err: ErrInvalidClientID,
+		}, {
+			name: "invalid channel id",
+			msg: MsgSetCanonicalClient{
+				Creator:    sample.AccAddress(),
+				RollappId:  "rollapp1",
+				ClientId:   "07-tendermint-0",
+				ChannelIds: []string{"ch"},
+			},
+			err: ErrInvalidChannelID,
+		}, {
+			name: "duplicated channel id",
+			msg: MsgSetCanonicalClient{
+				Creator:    sample.AccAddress(),
+				RollappId:  "rollapp1",
+				ClientId:   "07-tendermint-0",
+				ChannelIds: []string{"channel-0", "channel-0"},
+			},
+			err: ErrInvalidChannelID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
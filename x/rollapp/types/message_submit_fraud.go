@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitFraud = "submit_fraud"
+
+var _ sdk.Msg = &MsgSubmitFraud{}
+
+func NewMsgSubmitFraud(authority string, rollappId string, stateIndex uint64, fraudProof string) *MsgSubmitFraud {
+	return &MsgSubmitFraud{
+		Authority:  authority,
+		RollappId:  rollappId,
+		StateIndex: stateIndex,
+		FraudProof: fraudProof,
+	}
+}
+
+func (msg *MsgSubmitFraud) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitFraud) Type() string {
+	return TypeMsgSubmitFraud
+}
+
+func (msg *MsgSubmitFraud) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSubmitFraud) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitFraud) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	// state indexes start from 1
+	if msg.StateIndex == 0 {
+		return sdkerrors.Wrap(ErrStateNotExists, "state index must be greater than zero")
+	}
+
+	return nil
+}
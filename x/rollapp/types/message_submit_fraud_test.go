@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSubmitFraud_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSubmitFraud
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSubmitFraud{
+				Authority:  "invalid_address",
+				RollappId:  "rollapp1",
+				StateIndex: 1,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgSubmitFraud{
+				Authority:  sample.AccAddress(),
+				RollappId:  "rollapp1",
+				StateIndex: 1,
+			},
+		}, {
+			name: "empty rollapp id",
+			msg: MsgSubmitFraud{
+				Authority:  sample.AccAddress(),
+				StateIndex: 1,
+			},
+			err: ErrInvalidRollappID,
+		}, {
+			name: "zero state index",
+			msg: MsgSubmitFraud{
+				Authority: sample.AccAddress(),
+				RollappId: "rollapp1",
+			},
+			err: ErrStateNotExists,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
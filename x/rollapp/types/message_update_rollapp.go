@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateRollapp = "update_rollapp"
+
+var _ sdk.Msg = &MsgUpdateRollapp{}
+
+func NewMsgUpdateRollapp(creator string, rollappId string, addPermissionedAddresses []string, removePermissionedAddresses []string, maxSequencers uint64, addMetadatas []TokenMetadata, disputePeriodInBlocks uint64) *MsgUpdateRollapp {
+	return &MsgUpdateRollapp{
+		Creator:                     creator,
+		RollappId:                   rollappId,
+		AddPermissionedAddresses:    addPermissionedAddresses,
+		RemovePermissionedAddresses: removePermissionedAddresses,
+		MaxSequencers:               maxSequencers,
+		AddMetadatas:                addMetadatas,
+		DisputePeriodInBlocks:       disputePeriodInBlocks,
+	}
+}
+
+func (msg *MsgUpdateRollapp) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateRollapp) Type() string {
+	return TypeMsgUpdateRollapp
+}
+
+func (msg *MsgUpdateRollapp) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateRollapp) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateRollapp) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrInvalidRollappID, "rollappId can not be empty")
+	}
+
+	if len(msg.AddPermissionedAddresses) == 0 && len(msg.RemovePermissionedAddresses) == 0 &&
+		msg.MaxSequencers == 0 && len(msg.AddMetadatas) == 0 && msg.DisputePeriodInBlocks == 0 {
+		return ErrEmptyRollappUpdate
+	}
+
+	// an address can not be both added and removed
+	if err := validatePermissionedAddresses(append(append([]string{}, msg.AddPermissionedAddresses...), msg.RemovePermissionedAddresses...)); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]bool)
+	for _, metadata := range msg.AddMetadatas {
+		if err := sdk.ValidateDenom(metadata.Base); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTokenMetadata, "invalid base denom: %s", err)
+		}
+		if denoms[metadata.Base] {
+			return sdkerrors.Wrapf(ErrInvalidTokenMetadata, "duplicated base denom: %s", metadata.Base)
+		}
+		denoms[metadata.Base] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateRollapp_ValidateBasic(t *testing.T) {
+	seqAddr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgUpdateRollapp
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateRollapp{
+				Creator:       "invalid_address",
+				RollappId:     "rollapp1",
+				MaxSequencers: 2,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg: MsgUpdateRollapp{
+				Creator:                     sample.AccAddress(),
+				RollappId:                   "rollapp1",
+				AddPermissionedAddresses:    []string{sample.AccAddress()},
+				RemovePermissionedAddresses: []string{sample.AccAddress()},
+				MaxSequencers:               2,
+				AddMetadatas:                []TokenMetadata{{Base: "aRAX"}},
+			},
+		}, {
+			name: "empty rollapp id",
+			msg: MsgUpdateRollapp{
+				Creator:       sample.AccAddress(),
+				MaxSequencers: 2,
+			},
+			err: ErrInvalidRollappID,
+		}, {
+			name: "no changes",
+			msg: MsgUpdateRollapp{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+			},
+			err: ErrEmptyRollappUpdate,
+		}, {
+			name: "dispute period only",
+			msg: MsgUpdateRollapp{
+				Creator:               sample.AccAddress(),
+				RollappId:             "rollapp1",
+				DisputePeriodInBlocks: 100,
+			},
+		}, {
+			name: "invalid permissioned address",
+			msg: MsgUpdateRollapp{
+				Creator:                  sample.AccAddress(),
+				RollappId:                "rollapp1",
+				AddPermissionedAddresses: []string{"invalid_address"},
+			},
+			err: ErrInvalidPermissionedAddress,
+		}, {
+			name: "duplicated permissioned address",
+			msg: MsgUpdateRollapp{
+				Creator:                  sample.AccAddress(),
+				RollappId:                "rollapp1",
+				AddPermissionedAddresses: []string{seqAddr, seqAddr},
+			},
+			err: ErrPermissionedAddressesDuplicate,
+		}, {
+			name: "address both added and removed",
+			msg: MsgUpdateRollapp{
+				Creator:                     sample.AccAddress(),
+				RollappId:                   "rollapp1",
+				AddPermissionedAddresses:    []string{seqAddr},
+				RemovePermissionedAddresses: []string{seqAddr},
+			},
+			err: ErrPermissionedAddressesDuplicate,
+		}, {
+			name: "invalid metadata denom",
+			msg: MsgUpdateRollapp{
+				Creator:      sample.AccAddress(),
+				RollappId:    "rollapp1",
+				AddMetadatas: []TokenMetadata{{Base: ""}},
+			},
+			err: ErrInvalidTokenMetadata,
+		}, {
+			name: "duplicated metadata denom",
+			msg: MsgUpdateRollapp{
+				Creator:      sample.AccAddress(),
+				RollappId:    "rollapp1",
+				AddMetadatas: []TokenMetadata{{Base: "aRAX"}, {Base: "aRAX"}},
+			},
+			err: ErrInvalidTokenMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
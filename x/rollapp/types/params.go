@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	appparams "github.com/dymensionxyz/dymension/app/params"
 	"gopkg.in/yaml.v2"
 )
 
@@ -17,10 +18,26 @@ var (
 	KeyDeployerWhitelist = []byte("DeployerWhitelist")
 	// KeyDisputePeriodInBlocks is store's key for DisputePeriodInBlocks Params
 	KeyDisputePeriodInBlocks = []byte("DisputePeriodInBlocks")
+	// KeyStateInfoRetention is store's key for StateInfoRetention Params
+	KeyStateInfoRetention = []byte("StateInfoRetention")
+	// KeyMinDisputePeriodInBlocks is store's key for MinDisputePeriodInBlocks Params
+	KeyMinDisputePeriodInBlocks = []byte("MinDisputePeriodInBlocks")
+	// KeyMaxDisputePeriodInBlocks is store's key for MaxDisputePeriodInBlocks Params
+	KeyMaxDisputePeriodInBlocks = []byte("MaxDisputePeriodInBlocks")
+	// KeyRegistrationFee is store's key for RegistrationFee Params
+	KeyRegistrationFee = []byte("RegistrationFee")
+	// KeyBurnRegistrationFee is store's key for BurnRegistrationFee Params
+	KeyBurnRegistrationFee = []byte("BurnRegistrationFee")
 	// default value
 	DefaultDisputePeriodInBlocks uint64 = 3
+	// DefaultMaxDisputePeriodInBlocks is the default highest dispute period a rollapp can choose
+	DefaultMaxDisputePeriodInBlocks uint64 = 120960
+	// DefaultStateInfoRetention keeps all the finalized states
+	DefaultStateInfoRetention uint64 = 0
 	// MinDisputePeriodInBlocks is the minimum numner of blocks for dispute period
 	MinDisputePeriodInBlocks uint64 = 1
+	// DefaultRegistrationFee is the default value of RegistrationFee. A zero amount means the registration is free.
+	DefaultRegistrationFee = sdk.NewCoin(appparams.BaseDenom, sdk.ZeroInt())
 )
 
 // ParamKeyTable the param key table for launch module
@@ -33,18 +50,30 @@ func NewParams(
 	enabled bool,
 	disputePeriodInBlocks uint64,
 	deployerWhitelist []DeployerParams,
+	stateInfoRetention uint64,
+	minDisputePeriodInBlocks uint64,
+	maxDisputePeriodInBlocks uint64,
+	registrationFee sdk.Coin,
+	burnRegistrationFee bool,
 ) Params {
 	return Params{
-		DisputePeriodInBlocks: disputePeriodInBlocks,
-		DeployerWhitelist:     deployerWhitelist,
-		RollappsEnabled:       enabled,
+		DisputePeriodInBlocks:    disputePeriodInBlocks,
+		DeployerWhitelist:        deployerWhitelist,
+		RollappsEnabled:          enabled,
+		StateInfoRetention:       stateInfoRetention,
+		MinDisputePeriodInBlocks: minDisputePeriodInBlocks,
+		MaxDisputePeriodInBlocks: maxDisputePeriodInBlocks,
+		RegistrationFee:          registrationFee,
+		BurnRegistrationFee:      burnRegistrationFee,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return NewParams(
-		true, DefaultDisputePeriodInBlocks, []DeployerParams{},
+		true, DefaultDisputePeriodInBlocks, []DeployerParams{}, DefaultStateInfoRetention,
+		MinDisputePeriodInBlocks, DefaultMaxDisputePeriodInBlocks,
+		DefaultRegistrationFee, false,
 	)
 }
 
@@ -54,6 +83,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDisputePeriodInBlocks, &p.DisputePeriodInBlocks, validateDisputePeriodInBlocks),
 		paramtypes.NewParamSetPair(KeyDeployerWhitelist, &p.DeployerWhitelist, validateDeployerWhitelist),
 		paramtypes.NewParamSetPair(KeyRollappsEnabled, &p.RollappsEnabled, func(_ interface{}) error { return nil }),
+		paramtypes.NewParamSetPair(KeyStateInfoRetention, &p.StateInfoRetention, validateStateInfoRetention),
+		paramtypes.NewParamSetPair(KeyMinDisputePeriodInBlocks, &p.MinDisputePeriodInBlocks, validateDisputePeriodInBlocks),
+		paramtypes.NewParamSetPair(KeyMaxDisputePeriodInBlocks, &p.MaxDisputePeriodInBlocks, validateDisputePeriodInBlocks),
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		paramtypes.NewParamSetPair(KeyBurnRegistrationFee, &p.BurnRegistrationFee, func(_ interface{}) error { return nil }),
 	}
 }
 
@@ -63,6 +97,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDisputePeriodInBlocks(p.MinDisputePeriodInBlocks); err != nil {
+		return err
+	}
+
+	if err := validateDisputePeriodInBlocks(p.MaxDisputePeriodInBlocks); err != nil {
+		return err
+	}
+
+	if p.MinDisputePeriodInBlocks > p.MaxDisputePeriodInBlocks {
+		return fmt.Errorf("min dispute period cannot be greater than max dispute period")
+	}
+
+	if err := validateStateInfoRetention(p.StateInfoRetention); err != nil {
+		return err
+	}
+
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return err
+	}
+
 	return validateDeployerWhitelist(p.DeployerWhitelist)
 }
 
@@ -86,6 +140,30 @@ func validateDisputePeriodInBlocks(v interface{}) error {
 	return nil
 }
 
+// validateStateInfoRetention validates the StateInfoRetention param
+func validateStateInfoRetention(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateRegistrationFee validates the RegistrationFee param
+func validateRegistrationFee(v interface{}) error {
+	registrationFee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if registrationFee.IsNil() {
+		return fmt.Errorf("registration fee cannot be nil")
+	}
+
+	return registrationFee.Validate()
+}
+
 // validateDeployerWhitelist validates the DeployerWhitelist param
 func validateDeployerWhitelist(v interface{}) error {
 	deployerWhitelist, ok := v.([]DeployerParams)
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -89,6 +90,19 @@ type Params struct {
 	// In the case of an empty list, there are no restrictions
 	DeployerWhitelist []DeployerParams `protobuf:"bytes,2,rep,name=deployer_whitelist,json=deployerWhitelist,proto3" json:"deployer_whitelist" yaml:"deployer_whitelist"`
 	RollappsEnabled   bool             `protobuf:"varint,3,opt,name=rollapps_enabled,json=rollappsEnabled,proto3" json:"rollapps_enabled,omitempty" yaml:"rollapps_enabled"`
+	// state_info_retention is the number of finalized states kept per rollapp.
+	// Older finalized states are pruned. Zero keeps all the states
+	StateInfoRetention uint64 `protobuf:"varint,4,opt,name=state_info_retention,json=stateInfoRetention,proto3" json:"state_info_retention,omitempty" yaml:"state_info_retention"`
+	// min_dispute_period_in_blocks is the lowest dispute period a rollapp can choose
+	MinDisputePeriodInBlocks uint64 `protobuf:"varint,5,opt,name=min_dispute_period_in_blocks,json=minDisputePeriodInBlocks,proto3" json:"min_dispute_period_in_blocks,omitempty" yaml:"min_dispute_period_in_blocks"`
+	// max_dispute_period_in_blocks is the highest dispute period a rollapp can choose
+	MaxDisputePeriodInBlocks uint64 `protobuf:"varint,6,opt,name=max_dispute_period_in_blocks,json=maxDisputePeriodInBlocks,proto3" json:"max_dispute_period_in_blocks,omitempty" yaml:"max_dispute_period_in_blocks"`
+	// registration_fee is the fee paid by the creator of a rollapp.
+	// A zero amount means the registration is free
+	RegistrationFee types.Coin `protobuf:"bytes,7,opt,name=registration_fee,json=registrationFee,proto3" json:"registration_fee" yaml:"registration_fee"`
+	// burn_registration_fee burns the registration fee instead of
+	// sending it to the community pool
+	BurnRegistrationFee bool `protobuf:"varint,8,opt,name=burn_registration_fee,json=burnRegistrationFee,proto3" json:"burn_registration_fee,omitempty" yaml:"burn_registration_fee"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -144,6 +158,41 @@ func (m *Params) GetRollappsEnabled() bool {
 	return false
 }
 
+func (m *Params) GetStateInfoRetention() uint64 {
+	if m != nil {
+		return m.StateInfoRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMinDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MinDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MaxDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRegistrationFee() types.Coin {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetBurnRegistrationFee() bool {
+	if m != nil {
+		return m.BurnRegistrationFee
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*DeployerParams)(nil), "dymensionxyz.dymension.rollapp.DeployerParams")
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
@@ -152,32 +201,43 @@ func init() {
 func init() { proto.RegisterFile("dymension/rollapp/params.proto", fileDescriptor_8a5e294b0dff70d2) }
 
 var fileDescriptor_8a5e294b0dff70d2 = []byte{
-	// 386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0xca, 0xcf, 0xc9, 0x49, 0x2c, 0x28, 0xd0, 0x2f, 0x48,
-	0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x42, 0xc8, 0x57, 0x54, 0x56,
-	0xe9, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa5, 0xfa, 0x20,
-	0x16, 0x44, 0x97, 0x52, 0x15, 0x17, 0x9f, 0x4b, 0x6a, 0x41, 0x4e, 0x7e, 0x65, 0x6a, 0x51, 0x00,
-	0xd8, 0x34, 0x21, 0x1d, 0x2e, 0xf6, 0xc4, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0x62, 0x09, 0x46, 0x05,
-	0x46, 0x0d, 0x4e, 0x27, 0xa1, 0x4f, 0xf7, 0xe4, 0xf9, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0xa0,
-	0x12, 0x4a, 0x41, 0x30, 0x25, 0x42, 0x56, 0x5c, 0x3c, 0xb9, 0x89, 0x15, 0xf1, 0x50, 0x4b, 0x8a,
-	0x25, 0x98, 0x14, 0x18, 0x35, 0x58, 0x9c, 0xc4, 0x3f, 0xdd, 0x93, 0x17, 0x86, 0x68, 0x41, 0x96,
-	0x55, 0x0a, 0xe2, 0xce, 0x4d, 0xac, 0x08, 0x82, 0xf1, 0xb6, 0x33, 0x71, 0xb1, 0x41, 0x2d, 0x8d,
-	0xe1, 0x92, 0x48, 0xc9, 0x2c, 0x2e, 0x28, 0x2d, 0x49, 0x8d, 0x2f, 0x48, 0x2d, 0xca, 0xcc, 0x4f,
-	0x89, 0xcf, 0xcc, 0x8b, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x86, 0xb8, 0x82, 0xc5, 0x49, 0xf9, 0xd3,
-	0x3d, 0x79, 0x79, 0x88, 0x91, 0xb8, 0x54, 0x2a, 0x05, 0x89, 0x42, 0xa5, 0x02, 0xc0, 0x32, 0x9e,
-	0x79, 0x4e, 0x60, 0x71, 0xa1, 0x06, 0x46, 0x2e, 0xa1, 0x14, 0xa8, 0x2f, 0xe3, 0xcb, 0x33, 0x32,
-	0x4b, 0x52, 0x73, 0x32, 0x8b, 0x4b, 0x24, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0xf4, 0xf4, 0xf0,
-	0x07, 0x9c, 0x1e, 0x6a, 0xf8, 0x38, 0x29, 0x9e, 0xb8, 0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0xbc, 0x24,
-	0xd4, 0x31, 0x18, 0xe6, 0x2a, 0x05, 0x09, 0xc2, 0x04, 0xc3, 0x61, 0x62, 0x42, 0x6e, 0x5c, 0x02,
-	0xb0, 0x50, 0x88, 0x4f, 0xcd, 0x4b, 0x4c, 0xca, 0x49, 0x4d, 0x91, 0x60, 0x56, 0x60, 0xd4, 0xe0,
-	0x70, 0x92, 0xfe, 0x74, 0x4f, 0x5e, 0x1c, 0x62, 0x16, 0xba, 0x0a, 0xa5, 0x20, 0x7e, 0x98, 0x90,
-	0x2b, 0x44, 0xc4, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xef, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x47, 0xf6, 0x17, 0x82, 0xa3, 0x5f, 0x01, 0x4f, 0x3f, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49,
-	0x6c, 0xe0, 0x94, 0x60, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x74, 0xa5, 0x32, 0x61, 0x02,
-	0x00, 0x00,
+	// 563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x8d, 0xdb, 0x90, 0x96, 0x09, 0x2a, 0xc5, 0x6d, 0x55, 0xf7, 0x21, 0x8f, 0x71, 0x17, 0x64,
+	0x81, 0x6c, 0xa5, 0xec, 0xb2, 0x34, 0xa5, 0x52, 0xc5, 0xa6, 0x58, 0x48, 0x48, 0x08, 0x69, 0x34,
+	0x8e, 0x27, 0xe9, 0x08, 0x7b, 0xc6, 0xf2, 0x4c, 0xc0, 0xe9, 0x8a, 0x4f, 0x60, 0x09, 0x3b, 0x3e,
+	0xa7, 0xcb, 0x2e, 0x59, 0x59, 0x28, 0xf9, 0x03, 0x7f, 0x01, 0xf2, 0xab, 0x4d, 0x9b, 0xc7, 0x2e,
+	0x73, 0xee, 0x99, 0x73, 0xee, 0xdc, 0x93, 0x6b, 0xa0, 0xfb, 0xe3, 0x90, 0x30, 0x41, 0x39, 0xb3,
+	0x63, 0x1e, 0x04, 0x38, 0x8a, 0xec, 0x08, 0xc7, 0x38, 0x14, 0x56, 0x14, 0x73, 0xc9, 0xd5, 0xfb,
+	0x7a, 0x32, 0xbe, 0xb6, 0xee, 0x0e, 0x56, 0x45, 0x3e, 0xdc, 0x1d, 0xf2, 0x21, 0x2f, 0xa8, 0x76,
+	0xfe, 0xab, 0xbc, 0x75, 0xa8, 0xf7, 0xb9, 0x08, 0xb9, 0xb0, 0x3d, 0x2c, 0x88, 0xfd, 0xad, 0xeb,
+	0x11, 0x89, 0xbb, 0x76, 0x9f, 0x53, 0x56, 0xd6, 0xcd, 0x6b, 0xb0, 0x75, 0x46, 0xa2, 0x80, 0x8f,
+	0x49, 0x7c, 0x59, 0xb8, 0xa9, 0xaf, 0xc1, 0x06, 0xf6, 0xfd, 0x98, 0x08, 0xa1, 0x29, 0x86, 0xd2,
+	0x79, 0xea, 0xa8, 0x59, 0x0a, 0xb7, 0xc6, 0x38, 0x0c, 0x7a, 0x66, 0x55, 0x30, 0xdd, 0x9a, 0xa2,
+	0xf6, 0xc0, 0xb3, 0x10, 0x27, 0xa8, 0x6a, 0x42, 0x68, 0x6b, 0x86, 0xd2, 0x69, 0x3a, 0xfb, 0x59,
+	0x0a, 0x77, 0xca, 0x2b, 0xb3, 0x55, 0xd3, 0x6d, 0x87, 0x38, 0x71, 0xeb, 0xd3, 0xef, 0x16, 0x68,
+	0x55, 0xa6, 0x5f, 0x80, 0xe6, 0x53, 0x11, 0x8d, 0x24, 0x41, 0x11, 0x89, 0x29, 0xf7, 0x11, 0x65,
+	0xc8, 0x0b, 0x78, 0xff, 0x6b, 0xd9, 0x45, 0xd3, 0x39, 0xc9, 0x52, 0x08, 0x4b, 0xc9, 0x65, 0x4c,
+	0xd3, 0xdd, 0xab, 0x4a, 0x97, 0x45, 0xe5, 0x82, 0x39, 0x05, 0xae, 0xfe, 0x50, 0x80, 0xea, 0x57,
+	0xaf, 0x44, 0xdf, 0xaf, 0xa8, 0x24, 0x01, 0x15, 0x52, 0x5b, 0x33, 0xd6, 0x3b, 0xed, 0x53, 0xcb,
+	0x5a, 0x3d, 0x58, 0xeb, 0xe1, 0x7c, 0x9c, 0x97, 0x37, 0x29, 0x6c, 0x64, 0x29, 0x3c, 0xa8, 0x9a,
+	0x99, 0xd3, 0x35, 0xdd, 0x17, 0x35, 0xf8, 0xa9, 0xc6, 0xd4, 0x73, 0xb0, 0x5d, 0x4f, 0x01, 0x11,
+	0x86, 0xbd, 0x80, 0xf8, 0xda, 0xba, 0xa1, 0x74, 0x36, 0x9d, 0xa3, 0x2c, 0x85, 0xfb, 0xa5, 0xd6,
+	0x63, 0x86, 0xe9, 0x3e, 0xaf, 0xa1, 0x77, 0x25, 0xa2, 0x7e, 0x00, 0xbb, 0x42, 0x62, 0x49, 0x10,
+	0x65, 0x03, 0x8e, 0x62, 0x22, 0x09, 0x93, 0x94, 0x33, 0xad, 0x59, 0x0c, 0x09, 0x66, 0x29, 0x3c,
+	0x2a, 0xb5, 0x16, 0xb1, 0x4c, 0x57, 0x2d, 0xe0, 0x0b, 0x36, 0xe0, 0x6e, 0x0d, 0xaa, 0x43, 0x70,
+	0x1c, 0x52, 0x86, 0x96, 0xce, 0xff, 0x49, 0x21, 0xfd, 0x2a, 0x4b, 0xe1, 0x49, 0x15, 0xe9, 0x0a,
+	0xb6, 0xe9, 0x6a, 0x21, 0x65, 0x67, 0x0b, 0x63, 0xc8, 0x8d, 0x70, 0xb2, 0xdc, 0xa8, 0x35, 0x67,
+	0x84, 0x93, 0x95, 0x46, 0x38, 0x59, 0x6c, 0x44, 0xc0, 0x76, 0x4c, 0x86, 0x54, 0xc8, 0x18, 0xe7,
+	0x2f, 0x44, 0x03, 0x42, 0xb4, 0x0d, 0x43, 0xe9, 0xb4, 0x4f, 0x0f, 0xac, 0x72, 0x1f, 0xac, 0x7c,
+	0x1f, 0xac, 0x6a, 0x1f, 0xac, 0xb7, 0x9c, 0x32, 0x07, 0x56, 0xb9, 0xd6, 0x59, 0x3c, 0x12, 0xc8,
+	0xb3, 0x98, 0x81, 0xce, 0x09, 0x51, 0x3f, 0x82, 0x3d, 0x6f, 0x14, 0x33, 0x34, 0xe7, 0xb5, 0x59,
+	0x04, 0x6b, 0x64, 0x29, 0x3c, 0x2e, 0xc5, 0x16, 0xd2, 0x4c, 0x77, 0x27, 0xc7, 0xdd, 0x87, 0xaa,
+	0xbd, 0xe6, 0xaf, 0x3f, 0xb0, 0xe1, 0xbc, 0xbf, 0x99, 0xe8, 0xca, 0xed, 0x44, 0x57, 0xfe, 0x4d,
+	0x74, 0xe5, 0xe7, 0x54, 0x6f, 0xdc, 0x4e, 0xf5, 0xc6, 0xdf, 0xa9, 0xde, 0xf8, 0xdc, 0x1d, 0x52,
+	0x79, 0x35, 0xf2, 0xac, 0x3e, 0x0f, 0xed, 0xd9, 0x7f, 0xee, 0xfd, 0xc1, 0x4e, 0xee, 0xbe, 0x20,
+	0x72, 0x1c, 0x11, 0xe1, 0xb5, 0x8a, 0x5d, 0x7f, 0xf3, 0x7f, 0x00, 0xcc, 0x8a, 0x27, 0x5b, 0x63,
+	0x04, 0x00, 0x00,
 }
 
 func (m *DeployerParams) Marshal() (dAtA []byte, err error) {
@@ -235,6 +295,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BurnRegistrationFee {
+		i--
+		if m.BurnRegistrationFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.RegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StateInfoRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StateInfoRetention))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.RollappsEnabled {
 		i--
 		if m.RollappsEnabled {
@@ -312,6 +407,20 @@ func (m *Params) Size() (n int) {
 	if m.RollappsEnabled {
 		n += 2
 	}
+	if m.StateInfoRetention != 0 {
+		n += 1 + sovParams(uint64(m.StateInfoRetention))
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinDisputePeriodInBlocks))
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisputePeriodInBlocks))
+	}
+	l = m.RegistrationFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BurnRegistrationFee {
+		n += 2
+	}
 	return n
 }
 
@@ -524,6 +633,116 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RollappsEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoRetention", wireType)
+			}
+			m.StateInfoRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateInfoRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDisputePeriodInBlocks", wireType)
+			}
+			m.MinDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisputePeriodInBlocks", wireType)
+			}
+			m.MaxDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRegistrationFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnRegistrationFee = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
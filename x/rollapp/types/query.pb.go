@@ -568,8 +568,15 @@ func (m *QueryGetStateInfoResponse) GetStateInfo() StateInfo {
 }
 
 type QueryAllStateInfoRequest struct {
-	RollappId  string             `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// pagination iterates the states of the rollapp by index, pagination.reverse starts from the latest
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status filters the states by status. STATE_STATUS_UNSPECIFIED returns all the states
+	Status StateStatus `protobuf:"varint,3,opt,name=status,proto3,enum=dymensionxyz.dymension.rollapp.StateStatus" json:"status,omitempty"`
+	// minCreationHeight filters the states created from this hub height, if set
+	MinCreationHeight uint64 `protobuf:"varint,4,opt,name=minCreationHeight,proto3" json:"minCreationHeight,omitempty"`
+	// maxCreationHeight filters the states created until this hub height, if set
+	MaxCreationHeight uint64 `protobuf:"varint,5,opt,name=maxCreationHeight,proto3" json:"maxCreationHeight,omitempty"`
 }
 
 func (m *QueryAllStateInfoRequest) Reset()         { *m = QueryAllStateInfoRequest{} }
@@ -619,6 +626,27 @@ func (m *QueryAllStateInfoRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllStateInfoRequest) GetStatus() StateStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STATE_STATUS_UNSPECIFIED
+}
+
+func (m *QueryAllStateInfoRequest) GetMinCreationHeight() uint64 {
+	if m != nil {
+		return m.MinCreationHeight
+	}
+	return 0
+}
+
+func (m *QueryAllStateInfoRequest) GetMaxCreationHeight() uint64 {
+	if m != nil {
+		return m.MaxCreationHeight
+	}
+	return 0
+}
+
 type QueryAllStateInfoResponse struct {
 	StateInfo  []StateInfoSummary  `protobuf:"bytes,1,rep,name=stateInfo,proto3" json:"stateInfo"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -671,6 +699,227 @@ func (m *QueryAllStateInfoResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type QueryGetPendingUpgradeRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *QueryGetPendingUpgradeRequest) Reset()         { *m = QueryGetPendingUpgradeRequest{} }
+func (m *QueryGetPendingUpgradeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingUpgradeRequest) ProtoMessage()    {}
+func (*QueryGetPendingUpgradeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6816c5236b322a4f, []int{13}
+}
+func (m *QueryGetPendingUpgradeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingUpgradeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingUpgradeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingUpgradeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingUpgradeRequest.Merge(m, src)
+}
+func (m *QueryGetPendingUpgradeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingUpgradeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingUpgradeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingUpgradeRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingUpgradeRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryGetPendingUpgradeResponse struct {
+	// version is the current version of the rollapp
+	Version uint64         `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Upgrade RollappUpgrade `protobuf:"bytes,2,opt,name=upgrade,proto3" json:"upgrade"`
+}
+
+func (m *QueryGetPendingUpgradeResponse) Reset()         { *m = QueryGetPendingUpgradeResponse{} }
+func (m *QueryGetPendingUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingUpgradeResponse) ProtoMessage()    {}
+func (*QueryGetPendingUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6816c5236b322a4f, []int{14}
+}
+func (m *QueryGetPendingUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPendingUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingUpgradeResponse.Merge(m, src)
+}
+func (m *QueryGetPendingUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingUpgradeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingUpgradeResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingUpgradeResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *QueryGetPendingUpgradeResponse) GetUpgrade() RollappUpgrade {
+	if m != nil {
+		return m.Upgrade
+	}
+	return RollappUpgrade{}
+}
+
+type QueryGetBlockDescriptorRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// height is the rollapp height
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryGetBlockDescriptorRequest) Reset()         { *m = QueryGetBlockDescriptorRequest{} }
+func (m *QueryGetBlockDescriptorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockDescriptorRequest) ProtoMessage()    {}
+func (*QueryGetBlockDescriptorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6816c5236b322a4f, []int{15}
+}
+func (m *QueryGetBlockDescriptorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockDescriptorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockDescriptorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlockDescriptorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockDescriptorRequest.Merge(m, src)
+}
+func (m *QueryGetBlockDescriptorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockDescriptorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockDescriptorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlockDescriptorRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockDescriptorRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *QueryGetBlockDescriptorRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryGetBlockDescriptorResponse struct {
+	BlockDescriptor BlockDescriptor `protobuf:"bytes,1,opt,name=blockDescriptor,proto3" json:"blockDescriptor"`
+	// stateInfoIndex is the index of the state that posted the height
+	StateInfoIndex StateInfoIndex `protobuf:"bytes,2,opt,name=stateInfoIndex,proto3" json:"stateInfoIndex"`
+	// sequencer is the sequencer that posted the state
+	Sequencer string `protobuf:"bytes,3,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// status is the finalization status of the state
+	Status StateStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dymensionxyz.dymension.rollapp.StateStatus" json:"status,omitempty"`
+}
+
+func (m *QueryGetBlockDescriptorResponse) Reset()         { *m = QueryGetBlockDescriptorResponse{} }
+func (m *QueryGetBlockDescriptorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockDescriptorResponse) ProtoMessage()    {}
+func (*QueryGetBlockDescriptorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6816c5236b322a4f, []int{16}
+}
+func (m *QueryGetBlockDescriptorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockDescriptorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockDescriptorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetBlockDescriptorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockDescriptorResponse.Merge(m, src)
+}
+func (m *QueryGetBlockDescriptorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockDescriptorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockDescriptorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetBlockDescriptorResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockDescriptorResponse) GetBlockDescriptor() BlockDescriptor {
+	if m != nil {
+		return m.BlockDescriptor
+	}
+	return BlockDescriptor{}
+}
+
+func (m *QueryGetBlockDescriptorResponse) GetStateInfoIndex() StateInfoIndex {
+	if m != nil {
+		return m.StateInfoIndex
+	}
+	return StateInfoIndex{}
+}
+
+func (m *QueryGetBlockDescriptorResponse) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *QueryGetBlockDescriptorResponse) GetStatus() StateStatus {
+	if m != nil {
+		return m.Status
+	}
+	return STATE_STATUS_UNSPECIFIED
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.rollapp.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.rollapp.QueryParamsResponse")
@@ -685,68 +934,89 @@ func init() {
 	proto.RegisterType((*QueryGetStateInfoResponse)(nil), "dymensionxyz.dymension.rollapp.QueryGetStateInfoResponse")
 	proto.RegisterType((*QueryAllStateInfoRequest)(nil), "dymensionxyz.dymension.rollapp.QueryAllStateInfoRequest")
 	proto.RegisterType((*QueryAllStateInfoResponse)(nil), "dymensionxyz.dymension.rollapp.QueryAllStateInfoResponse")
+	proto.RegisterType((*QueryGetPendingUpgradeRequest)(nil), "dymensionxyz.dymension.rollapp.QueryGetPendingUpgradeRequest")
+	proto.RegisterType((*QueryGetPendingUpgradeResponse)(nil), "dymensionxyz.dymension.rollapp.QueryGetPendingUpgradeResponse")
+	proto.RegisterType((*QueryGetBlockDescriptorRequest)(nil), "dymensionxyz.dymension.rollapp.QueryGetBlockDescriptorRequest")
+	proto.RegisterType((*QueryGetBlockDescriptorResponse)(nil), "dymensionxyz.dymension.rollapp.QueryGetBlockDescriptorResponse")
 }
 
 func init() { proto.RegisterFile("dymension/rollapp/query.proto", fileDescriptor_6816c5236b322a4f) }
 
 var fileDescriptor_6816c5236b322a4f = []byte{
-	// 885 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
-	0x18, 0x8e, 0x43, 0x08, 0xcd, 0xdb, 0x4a, 0x45, 0x53, 0x44, 0x21, 0xa2, 0x06, 0xf9, 0xc0, 0x97,
-	0x2a, 0xbb, 0x09, 0x0a, 0x50, 0x55, 0xa2, 0x80, 0xda, 0x20, 0xd4, 0x0f, 0x51, 0xd3, 0x13, 0x55,
-	0x45, 0x1d, 0x32, 0x18, 0x57, 0x8e, 0x6d, 0x62, 0xa7, 0x4a, 0x40, 0x48, 0x55, 0xd5, 0x1f, 0x50,
-	0xa9, 0xbf, 0x60, 0xef, 0xab, 0x3d, 0xec, 0x65, 0xa5, 0xbd, 0xad, 0xf6, 0xc2, 0x11, 0x69, 0x2f,
-	0x7b, 0xd9, 0xd5, 0x0a, 0xf6, 0x87, 0xac, 0x3c, 0xf3, 0xda, 0x89, 0x6d, 0xb2, 0x71, 0xa2, 0x3d,
-	0x25, 0x1e, 0xcf, 0xf3, 0xbc, 0xcf, 0xf3, 0x7e, 0xcc, 0x18, 0xbe, 0xa8, 0x77, 0x1a, 0xd4, 0x72,
-	0x0d, 0xdb, 0x52, 0x9a, 0xb6, 0x69, 0x6a, 0x8e, 0xa3, 0x9c, 0xb7, 0x68, 0xb3, 0x23, 0x3b, 0x4d,
-	0xdb, 0xb3, 0x89, 0x18, 0xbe, 0x6e, 0x77, 0x2e, 0xe4, 0xf0, 0x41, 0xc6, 0xbd, 0xc5, 0x29, 0xdd,
-	0xd6, 0x6d, 0xb6, 0x55, 0xf1, 0xff, 0x71, 0x54, 0x71, 0x4e, 0xb7, 0x6d, 0xdd, 0xa4, 0x8a, 0xe6,
-	0x18, 0x8a, 0x66, 0x59, 0xb6, 0xa7, 0x79, 0x86, 0x6d, 0xb9, 0xf8, 0x76, 0xf5, 0xc4, 0x76, 0x1b,
-	0xb6, 0xab, 0xd4, 0x34, 0x97, 0xf2, 0x60, 0xca, 0x5f, 0xa5, 0x1a, 0xf5, 0xb4, 0x92, 0xe2, 0x68,
-	0xba, 0x61, 0xb1, 0xcd, 0xb8, 0x57, 0x4c, 0xca, 0x73, 0xb4, 0xa6, 0xd6, 0x08, 0xb8, 0xe6, 0x93,
-	0xef, 0xf1, 0x17, 0x37, 0x48, 0xc9, 0x0d, 0xae, 0xa7, 0x79, 0xf4, 0xd8, 0xb0, 0x4e, 0x51, 0xae,
-	0x34, 0x05, 0xe4, 0x17, 0x5f, 0xc6, 0x01, 0x63, 0x56, 0xe9, 0x79, 0x8b, 0xba, 0x9e, 0xf4, 0x1b,
-	0x7c, 0x16, 0x59, 0x75, 0x1d, 0xdb, 0x72, 0x29, 0xf9, 0x0e, 0xf2, 0x5c, 0xc1, 0x8c, 0xb0, 0x20,
-	0x2c, 0x7f, 0x5c, 0x5e, 0x94, 0xdf, 0x9f, 0x22, 0x99, 0xe3, 0x77, 0x73, 0xd7, 0xaf, 0xe7, 0x33,
-	0x2a, 0x62, 0xa5, 0x75, 0x98, 0x66, 0xe4, 0x7b, 0xd4, 0x53, 0xf9, 0x3e, 0x0c, 0x4b, 0xe6, 0xa0,
-	0x80, 0xc8, 0xfd, 0x3a, 0x0b, 0x51, 0x50, 0xbb, 0x0b, 0xd2, 0x26, 0x88, 0x31, 0xdc, 0x6e, 0xe7,
-	0xfb, 0xfd, 0x83, 0x52, 0xa5, 0x12, 0xe0, 0xa7, 0x21, 0x4f, 0x0d, 0xa7, 0x54, 0xa9, 0x30, 0x70,
-	0x4e, 0xc5, 0x27, 0xe9, 0x77, 0x98, 0x0f, 0x90, 0x3f, 0x6a, 0x1e, 0x75, 0xbd, 0x43, 0x3f, 0x0d,
-	0xfb, 0x56, 0x9d, 0xb6, 0x53, 0x85, 0xf6, 0xdf, 0x9e, 0x1a, 0x96, 0x66, 0x1a, 0x17, 0xb4, 0x3e,
-	0x93, 0x5d, 0x10, 0x96, 0x3f, 0x52, 0xbb, 0x0b, 0x52, 0x1b, 0x16, 0xfa, 0xd3, 0x63, 0xea, 0x7e,
-	0x05, 0x70, 0xc3, 0x55, 0x4c, 0x9f, 0x3c, 0x28, 0x7d, 0xc8, 0x73, 0x6a, 0x33, 0x14, 0xa6, 0xb1,
-	0x87, 0x47, 0x7a, 0x98, 0x85, 0xcf, 0x13, 0xb9, 0xc4, 0x88, 0x7b, 0x30, 0x81, 0x3c, 0x18, 0x6e,
-	0x69, 0x50, 0xb8, 0x20, 0xab, 0x3c, 0x4e, 0x80, 0x26, 0x47, 0x30, 0x69, 0xc6, 0x6c, 0xb1, 0x1c,
-	0x0c, 0x6d, 0x40, 0x4d, 0xf0, 0x10, 0x13, 0x66, 0xf9, 0x5a, 0x35, 0xc8, 0x66, 0x4f, 0x90, 0xb1,
-	0x91, 0x82, 0xf4, 0x27, 0x94, 0xfe, 0xc0, 0xce, 0xdb, 0x31, 0xcd, 0x58, 0xe7, 0x55, 0x01, 0xba,
-	0xf3, 0x17, 0x76, 0x37, 0x1f, 0x56, 0xd9, 0x1f, 0x56, 0x99, 0x9f, 0x0c, 0x38, 0xac, 0xf2, 0x81,
-	0xa6, 0x53, 0xc4, 0xaa, 0x3d, 0x48, 0xe9, 0xb1, 0x80, 0x05, 0xe9, 0x0d, 0x81, 0x05, 0xf9, 0xb9,
-	0xb7, 0x20, 0x63, 0x69, 0x9c, 0x21, 0xc3, 0x61, 0xab, 0xd1, 0xd0, 0x9a, 0x9d, 0x78, 0x5d, 0xf6,
-	0x22, 0x9a, 0xb3, 0x58, 0xe3, 0x41, 0x9a, 0xb9, 0x98, 0x88, 0xe8, 0x7f, 0x05, 0x98, 0x09, 0xba,
-	0x28, 0x4c, 0x66, 0xba, 0xc1, 0x98, 0x82, 0x71, 0x23, 0x6c, 0x88, 0x9c, 0xca, 0x1f, 0xfc, 0x39,
-	0x3c, 0xa3, 0x86, 0x7e, 0xe6, 0xb1, 0x12, 0xe6, 0x54, 0x7c, 0x8a, 0x8e, 0x51, 0x2e, 0x3e, 0x46,
-	0x7f, 0xc2, 0xec, 0x3d, 0x2a, 0x30, 0x79, 0x3f, 0x41, 0xc1, 0x0d, 0x16, 0xb1, 0x3e, 0x2b, 0xa9,
-	0x1b, 0x03, 0x33, 0xd7, 0x65, 0x90, 0xfe, 0x0e, 0x2c, 0xef, 0x98, 0xe6, 0x90, 0x96, 0xab, 0xf7,
-	0xa4, 0x7d, 0x94, 0x56, 0x79, 0x2a, 0xa0, 0xdf, 0xa8, 0x84, 0xf0, 0xbc, 0x88, 0xf8, 0xf5, 0xdb,
-	0xe5, 0xab, 0xd4, 0x7e, 0xa3, 0x0d, 0xd3, 0x25, 0xfa, 0x60, 0x2d, 0x53, 0x7e, 0x06, 0x30, 0xce,
-	0xc4, 0x93, 0x07, 0x02, 0xe4, 0xf9, 0x31, 0x4f, 0xca, 0x83, 0x04, 0x26, 0x6f, 0x9a, 0xe2, 0xda,
-	0x50, 0x18, 0xae, 0x44, 0x92, 0xff, 0x79, 0xf1, 0xf6, 0xff, 0xec, 0x32, 0x59, 0x54, 0x7a, 0xc1,
-	0x4a, 0xbf, 0xfb, 0x92, 0x3c, 0x11, 0x60, 0x02, 0x67, 0x89, 0xac, 0xa7, 0x0a, 0x98, 0xb8, 0x9b,
-	0x8a, 0x1b, 0x43, 0xe3, 0x50, 0xec, 0x37, 0x4c, 0x6c, 0x85, 0xac, 0x0d, 0x12, 0x1b, 0xfc, 0x5e,
-	0x86, 0xbd, 0x76, 0x45, 0x9e, 0x0b, 0xf0, 0x69, 0xec, 0xb2, 0x23, 0x5b, 0x43, 0x2a, 0x89, 0xdd,
-	0x92, 0xa3, 0x3b, 0xd9, 0x60, 0x4e, 0x4a, 0x44, 0x19, 0xe4, 0x84, 0x5f, 0xbb, 0xca, 0x25, 0xff,
-	0xbd, 0x22, 0x8f, 0x04, 0x00, 0x24, 0xdb, 0x31, 0xcd, 0x94, 0x25, 0x48, 0x1c, 0xd2, 0x29, 0x85,
-	0x27, 0x4f, 0x5e, 0x49, 0x61, 0xc2, 0x57, 0xc8, 0x52, 0xca, 0x12, 0x90, 0x57, 0x02, 0x4c, 0xc6,
-	0xaf, 0x72, 0xf2, 0x6d, 0xda, 0xbc, 0xf5, 0xf9, 0xc6, 0x28, 0x6e, 0x8f, 0x4e, 0x80, 0x46, 0xaa,
-	0xcc, 0xc8, 0x36, 0xd9, 0x1a, 0x64, 0x84, 0xdf, 0x81, 0xc7, 0xc1, 0xe7, 0x5e, 0x9d, 0xb6, 0x23,
-	0x6d, 0x75, 0x2d, 0x40, 0x21, 0x3c, 0x2d, 0xc8, 0x66, 0x5a, 0x5d, 0xf1, 0x93, 0xb2, 0xf8, 0xf5,
-	0x08, 0xc8, 0x61, 0xad, 0x74, 0x3f, 0x59, 0x7b, 0x2d, 0x28, 0x97, 0xcc, 0xd5, 0x95, 0x3f, 0xdb,
-	0x9f, 0x84, 0xec, 0x7e, 0x77, 0x6d, 0xa6, 0xed, 0x92, 0x11, 0xdd, 0xdc, 0x77, 0x5c, 0x4b, 0x65,
-	0xe6, 0xe6, 0x4b, 0xb2, 0x9a, 0xde, 0xcd, 0xee, 0x0f, 0xd7, 0xb7, 0xa2, 0x70, 0x73, 0x2b, 0x0a,
-	0x6f, 0x6e, 0x45, 0xe1, 0xbf, 0x3b, 0x31, 0x73, 0x73, 0x27, 0x66, 0x5e, 0xde, 0x89, 0x99, 0xa3,
-	0x92, 0x6e, 0x78, 0x67, 0xad, 0x9a, 0x7c, 0x62, 0x37, 0xfa, 0xf1, 0xb5, 0x43, 0x46, 0xaf, 0xe3,
-	0x50, 0xb7, 0x96, 0x67, 0x9f, 0xf3, 0x6b, 0xef, 0x02, 0x00, 0x00, 0xff, 0xff, 0x28, 0x61, 0x92,
-	0x99, 0xd4, 0x0c, 0x00, 0x00,
+	// 1163 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x41, 0x6f, 0xdc, 0x54,
+	0x10, 0x8e, 0xb7, 0x9b, 0x84, 0x0c, 0x90, 0x94, 0x47, 0xd4, 0x26, 0xab, 0xd6, 0x89, 0x2c, 0xd4,
+	0xa6, 0xa5, 0xb2, 0xd9, 0x44, 0x69, 0x82, 0xaa, 0xa4, 0x4d, 0xd2, 0x26, 0x44, 0x50, 0x14, 0x1c,
+	0xe0, 0x50, 0x40, 0xc1, 0xbb, 0xfb, 0xe2, 0x18, 0xbc, 0xb6, 0x6b, 0x7b, 0xab, 0xdd, 0x46, 0xb9,
+	0x20, 0x4e, 0x9c, 0x90, 0xf8, 0x05, 0xbd, 0x23, 0x0e, 0x5c, 0x90, 0xb8, 0xf6, 0x92, 0x63, 0x25,
+	0x38, 0x70, 0x01, 0x41, 0xc2, 0xcf, 0xe0, 0x80, 0xfc, 0xde, 0xd8, 0xbb, 0xb6, 0x77, 0xb1, 0xd7,
+	0xe2, 0x92, 0x8d, 0xfd, 0xe6, 0x9b, 0x99, 0xef, 0x7b, 0x33, 0xef, 0xcd, 0x2e, 0x5c, 0x6d, 0x74,
+	0x9a, 0xd4, 0xf2, 0x0c, 0xdb, 0x52, 0x5c, 0xdb, 0x34, 0x35, 0xc7, 0x51, 0x1e, 0xb7, 0xa8, 0xdb,
+	0x91, 0x1d, 0xd7, 0xf6, 0x6d, 0x22, 0x46, 0xcb, 0xed, 0xce, 0x53, 0x39, 0x7a, 0x90, 0xd1, 0xb6,
+	0x32, 0xad, 0xdb, 0xba, 0xcd, 0x4c, 0x95, 0xe0, 0x3f, 0x8e, 0xaa, 0x5c, 0xd1, 0x6d, 0x5b, 0x37,
+	0xa9, 0xa2, 0x39, 0x86, 0xa2, 0x59, 0x96, 0xed, 0x6b, 0xbe, 0x61, 0x5b, 0x1e, 0xae, 0xde, 0xac,
+	0xdb, 0x5e, 0xd3, 0xf6, 0x94, 0x9a, 0xe6, 0x51, 0x1e, 0x4c, 0x79, 0x52, 0xad, 0x51, 0x5f, 0xab,
+	0x2a, 0x8e, 0xa6, 0x1b, 0x16, 0x33, 0x46, 0x5b, 0x31, 0x9d, 0x9e, 0xa3, 0xb9, 0x5a, 0x33, 0xf4,
+	0x35, 0x97, 0x5e, 0xc7, 0x4f, 0x34, 0x90, 0xd2, 0x06, 0x9e, 0xaf, 0xf9, 0xf4, 0xc0, 0xb0, 0x0e,
+	0xc3, 0x74, 0xdf, 0x18, 0x64, 0x13, 0xfc, 0x6d, 0x85, 0xa1, 0x16, 0xd2, 0x56, 0x35, 0xd3, 0xae,
+	0x7f, 0x79, 0xd0, 0xa0, 0x5e, 0xdd, 0x35, 0x1c, 0xdf, 0x76, 0xb9, 0xa5, 0x34, 0x0d, 0xe4, 0x83,
+	0x80, 0xd6, 0x1e, 0xcb, 0x54, 0xa5, 0x8f, 0x5b, 0xd4, 0xf3, 0xa5, 0x4f, 0xe0, 0xf5, 0xd8, 0x5b,
+	0xcf, 0xb1, 0x2d, 0x8f, 0x92, 0xfb, 0x30, 0xc6, 0x19, 0xcd, 0x08, 0xf3, 0xc2, 0xc2, 0xcb, 0x8b,
+	0xd7, 0xe4, 0xff, 0x96, 0x5c, 0xe6, 0xf8, 0xcd, 0xf2, 0xe9, 0x1f, 0x73, 0x23, 0x2a, 0x62, 0xa5,
+	0xdb, 0x70, 0x89, 0x39, 0xdf, 0xa1, 0xbe, 0xca, 0xed, 0x30, 0x2c, 0xb9, 0x02, 0x13, 0x88, 0xdc,
+	0x6d, 0xb0, 0x10, 0x13, 0x6a, 0xf7, 0x85, 0xb4, 0x0a, 0x62, 0x02, 0xb7, 0xd9, 0x79, 0xb0, 0xbb,
+	0x57, 0x5d, 0x5e, 0x0e, 0xf1, 0x97, 0x60, 0x8c, 0x1a, 0x4e, 0x75, 0x79, 0x99, 0x81, 0xcb, 0x2a,
+	0x3e, 0x49, 0x9f, 0xc1, 0x5c, 0x88, 0x7c, 0x4f, 0xf3, 0xa9, 0xe7, 0xef, 0x07, 0x92, 0xed, 0x5a,
+	0x0d, 0xda, 0xce, 0x15, 0x3a, 0x58, 0x3d, 0x34, 0x2c, 0xcd, 0x34, 0x9e, 0xd2, 0xc6, 0x4c, 0x69,
+	0x5e, 0x58, 0x78, 0x49, 0xed, 0xbe, 0x90, 0xda, 0x30, 0x3f, 0xd8, 0x3d, 0x4a, 0xf7, 0x21, 0x80,
+	0x17, 0xbd, 0x45, 0xf9, 0xe4, 0x2c, 0xf9, 0xd0, 0xcf, 0xa1, 0xcd, 0x50, 0x28, 0x63, 0x8f, 0x1f,
+	0xe9, 0xfb, 0x12, 0x5c, 0x4e, 0x69, 0x89, 0x11, 0x77, 0x60, 0x1c, 0xfd, 0x60, 0xb8, 0xeb, 0x59,
+	0xe1, 0x42, 0x55, 0x79, 0x9c, 0x10, 0x4d, 0x1e, 0xc1, 0x45, 0x33, 0x41, 0x6b, 0xa6, 0x54, 0x84,
+	0x80, 0x9a, 0xf2, 0x43, 0x4c, 0x98, 0xe5, 0xef, 0xb6, 0x43, 0x35, 0x7b, 0x82, 0x5c, 0x28, 0x14,
+	0x64, 0xb0, 0x43, 0xe9, 0x73, 0xac, 0xbc, 0x0d, 0xd3, 0x4c, 0x54, 0xde, 0x36, 0x40, 0xb7, 0x9f,
+	0xa3, 0xea, 0xe6, 0xcd, 0x2f, 0x07, 0xcd, 0x2f, 0xf3, 0x93, 0x06, 0x9b, 0x5f, 0xde, 0xd3, 0x74,
+	0x8a, 0x58, 0xb5, 0x07, 0x29, 0xfd, 0x28, 0xc0, 0xe5, 0x54, 0x08, 0xdc, 0x90, 0xf7, 0x7b, 0x37,
+	0xe4, 0x42, 0x1e, 0x66, 0xe8, 0x61, 0xbf, 0xd5, 0x6c, 0x6a, 0x6e, 0x27, 0xb9, 0x2f, 0x3b, 0xb1,
+	0x9c, 0x4b, 0xb8, 0xc7, 0x59, 0x39, 0xf3, 0x64, 0x62, 0x49, 0x7f, 0x2d, 0xc0, 0x4c, 0x58, 0x45,
+	0x91, 0x98, 0xf9, 0x1a, 0x63, 0x1a, 0x46, 0x8d, 0xa8, 0x20, 0xca, 0x2a, 0x7f, 0x08, 0xfa, 0xf0,
+	0x88, 0x1a, 0xfa, 0x91, 0xcf, 0xb6, 0xb0, 0xac, 0xe2, 0x53, 0xbc, 0x8d, 0xca, 0xc9, 0x36, 0xfa,
+	0x02, 0x66, 0xfb, 0x64, 0x81, 0xe2, 0x3d, 0x84, 0x09, 0x2f, 0x7c, 0x89, 0xfb, 0x73, 0x23, 0x77,
+	0x61, 0xa0, 0x72, 0x5d, 0x0f, 0xd2, 0xb3, 0x12, 0x52, 0xde, 0x30, 0xcd, 0x21, 0x29, 0x6f, 0xf7,
+	0x91, 0xbd, 0x40, 0xa9, 0x90, 0x2d, 0x18, 0xe3, 0x67, 0x36, 0x13, 0x69, 0x72, 0xf1, 0xcd, 0x5c,
+	0x74, 0xf6, 0x19, 0x44, 0x45, 0x28, 0xb9, 0x05, 0xaf, 0x35, 0x0d, 0x6b, 0xcb, 0xa5, 0xcc, 0xe7,
+	0x3b, 0x5c, 0xf4, 0x32, 0x13, 0x3d, 0xbd, 0xc0, 0xac, 0xb5, 0x76, 0xc2, 0x7a, 0x14, 0xad, 0x93,
+	0x0b, 0xd2, 0xcf, 0x02, 0xcc, 0xf6, 0xd1, 0x28, 0x3a, 0xd0, 0x62, 0x1b, 0x12, 0xd4, 0xf3, 0x5b,
+	0xb9, 0x37, 0x24, 0x5e, 0xd1, 0x5d, 0x47, 0xff, 0x5f, 0x4d, 0xaf, 0xc1, 0xd5, 0xb0, 0x98, 0xf6,
+	0xa8, 0xd5, 0x30, 0x2c, 0xfd, 0x23, 0x47, 0x77, 0xb5, 0x06, 0xcd, 0x77, 0xd7, 0x7c, 0x23, 0x80,
+	0x38, 0x08, 0x8f, 0x02, 0xcc, 0xc0, 0xf8, 0x13, 0xea, 0x7a, 0xe1, 0x79, 0x51, 0x56, 0xc3, 0xc7,
+	0xa0, 0xd1, 0x5b, 0xdc, 0x38, 0xef, 0x39, 0x89, 0x8d, 0x8e, 0x21, 0xc2, 0x46, 0x47, 0x27, 0xd2,
+	0xc7, 0xdd, 0x5c, 0x36, 0x83, 0x5b, 0xfc, 0x7e, 0x74, 0x89, 0xe7, 0xab, 0xd8, 0x6e, 0x3b, 0x96,
+	0x7a, 0xdb, 0x51, 0x7a, 0x5e, 0x82, 0xb9, 0x81, 0x8e, 0x91, 0xe5, 0x01, 0x4c, 0xd5, 0xe2, 0x4b,
+	0xd8, 0x7d, 0x4a, 0x16, 0xa7, 0x84, 0x47, 0x24, 0x95, 0xf4, 0x46, 0x3e, 0x85, 0x49, 0x2f, 0x76,
+	0x80, 0x17, 0xbb, 0x5b, 0xd0, 0x7d, 0xc2, 0x57, 0x20, 0x8c, 0x17, 0x68, 0x64, 0xd5, 0xa9, 0xcb,
+	0xfa, 0x6c, 0x42, 0xed, 0xbe, 0xe8, 0x69, 0xc1, 0x72, 0xe1, 0x16, 0x5c, 0xfc, 0xe7, 0x55, 0x18,
+	0x65, 0x2a, 0x92, 0x67, 0x02, 0x8c, 0xf1, 0x89, 0x87, 0x2c, 0x66, 0x79, 0x4a, 0x0f, 0x5d, 0x95,
+	0xa5, 0xa1, 0x30, 0x7c, 0x7f, 0x24, 0xf9, 0xab, 0x5f, 0xfe, 0xfe, 0xae, 0xb4, 0x40, 0xae, 0x29,
+	0xbd, 0x60, 0x65, 0xd0, 0x28, 0x4a, 0x7e, 0x12, 0x60, 0x1c, 0xab, 0x8d, 0xdc, 0xce, 0x15, 0x30,
+	0x35, 0xa6, 0x55, 0x56, 0x86, 0xc6, 0x61, 0xb2, 0x77, 0x58, 0xb2, 0xcb, 0x64, 0x29, 0x2b, 0xd9,
+	0xf0, 0xf3, 0x38, 0x2a, 0xe2, 0x13, 0xf2, 0x5c, 0x80, 0xa9, 0xc4, 0xdc, 0x47, 0xd6, 0x87, 0xcc,
+	0x24, 0x31, 0x30, 0x16, 0x67, 0xb2, 0xc2, 0x98, 0x54, 0x89, 0x92, 0xc5, 0x84, 0x4f, 0xa0, 0xca,
+	0x31, 0xff, 0x3c, 0x21, 0x3f, 0x08, 0x00, 0xe8, 0x6c, 0xc3, 0x34, 0x73, 0x6e, 0x41, 0x6a, 0x5e,
+	0xa9, 0xac, 0x0c, 0x8d, 0xc3, 0xc4, 0x15, 0x96, 0xf8, 0x0d, 0x72, 0x3d, 0xe7, 0x16, 0x90, 0xdf,
+	0x05, 0xb8, 0x98, 0x9c, 0x6a, 0xc9, 0xdd, 0xbc, 0xba, 0x0d, 0x18, 0xb7, 0x2b, 0xf7, 0x8a, 0x3b,
+	0x40, 0x22, 0xdb, 0x8c, 0xc8, 0x3d, 0xb2, 0x9e, 0x45, 0x84, 0x8f, 0x83, 0x07, 0xe1, 0x37, 0xa9,
+	0x06, 0x6d, 0xc7, 0xca, 0xea, 0x54, 0x80, 0x89, 0xe8, 0x28, 0x21, 0xab, 0x79, 0xf3, 0x4a, 0x0e,
+	0x0d, 0x95, 0xb7, 0x0b, 0x20, 0x87, 0xa5, 0xd2, 0xfd, 0x36, 0xd8, 0x4b, 0x41, 0x39, 0x66, 0xac,
+	0x4e, 0x82, 0xde, 0x7e, 0x25, 0xf2, 0x1e, 0x54, 0xd7, 0x6a, 0xde, 0x2a, 0x29, 0xc8, 0xa6, 0xdf,
+	0x60, 0x20, 0x2d, 0x32, 0x36, 0xb7, 0xc8, 0xcd, 0xfc, 0x6c, 0xc8, 0xaf, 0x02, 0x4c, 0xc6, 0xaf,
+	0x59, 0xb2, 0x96, 0x57, 0xcf, 0xbe, 0xd7, 0x7b, 0x65, 0xbd, 0x28, 0x1c, 0x59, 0x6c, 0x31, 0x16,
+	0x6b, 0xe4, 0x4e, 0xe6, 0xb9, 0xca, 0xf1, 0x07, 0x78, 0x59, 0xc7, 0x6a, 0xeb, 0x2f, 0x01, 0xa6,
+	0x12, 0xd7, 0x60, 0xfe, 0x23, 0xab, 0xff, 0x55, 0x5f, 0xb9, 0x5b, 0x18, 0x8f, 0xcc, 0x1e, 0x32,
+	0x66, 0x3b, 0xe4, 0x41, 0x16, 0xb3, 0xe4, 0x2f, 0x06, 0xf1, 0x9a, 0xe3, 0x33, 0xc4, 0xc9, 0xe6,
+	0xbb, 0xa7, 0x67, 0xa2, 0xf0, 0xe2, 0x4c, 0x14, 0xfe, 0x3c, 0x13, 0x85, 0x6f, 0xcf, 0xc5, 0x91,
+	0x17, 0xe7, 0xe2, 0xc8, 0x6f, 0xe7, 0xe2, 0xc8, 0xa3, 0xaa, 0x6e, 0xf8, 0x47, 0xad, 0x9a, 0x5c,
+	0xb7, 0x9b, 0x83, 0x42, 0xb5, 0xa3, 0x60, 0x7e, 0xc7, 0xa1, 0x5e, 0x6d, 0x8c, 0xfd, 0x28, 0xb1,
+	0xf4, 0xef, 0x00, 0x6a, 0x3e, 0xe8, 0x3f, 0xea, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -775,6 +1045,10 @@ type QueryClient interface {
 	StateInfo(ctx context.Context, in *QueryGetStateInfoRequest, opts ...grpc.CallOption) (*QueryGetStateInfoResponse, error)
 	// Queries a list of StateInfo items.
 	StateInfoAll(ctx context.Context, in *QueryAllStateInfoRequest, opts ...grpc.CallOption) (*QueryAllStateInfoResponse, error)
+	// Queries the pending upgrade of a rollapp.
+	PendingUpgrade(ctx context.Context, in *QueryGetPendingUpgradeRequest, opts ...grpc.CallOption) (*QueryGetPendingUpgradeResponse, error)
+	// Queries the BlockDescriptor of a rollapp height, with the state that posted it.
+	BlockDescriptor(ctx context.Context, in *QueryGetBlockDescriptorRequest, opts ...grpc.CallOption) (*QueryGetBlockDescriptorResponse, error)
 }
 
 type queryClient struct {
@@ -848,6 +1122,24 @@ func (c *queryClient) StateInfoAll(ctx context.Context, in *QueryAllStateInfoReq
 	return out, nil
 }
 
+func (c *queryClient) PendingUpgrade(ctx context.Context, in *QueryGetPendingUpgradeRequest, opts ...grpc.CallOption) (*QueryGetPendingUpgradeResponse, error) {
+	out := new(QueryGetPendingUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/PendingUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockDescriptor(ctx context.Context, in *QueryGetBlockDescriptorRequest, opts ...grpc.CallOption) (*QueryGetBlockDescriptorResponse, error) {
+	out := new(QueryGetBlockDescriptorResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Query/BlockDescriptor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -864,6 +1156,10 @@ type QueryServer interface {
 	StateInfo(context.Context, *QueryGetStateInfoRequest) (*QueryGetStateInfoResponse, error)
 	// Queries a list of StateInfo items.
 	StateInfoAll(context.Context, *QueryAllStateInfoRequest) (*QueryAllStateInfoResponse, error)
+	// Queries the pending upgrade of a rollapp.
+	PendingUpgrade(context.Context, *QueryGetPendingUpgradeRequest) (*QueryGetPendingUpgradeResponse, error)
+	// Queries the BlockDescriptor of a rollapp height, with the state that posted it.
+	BlockDescriptor(context.Context, *QueryGetBlockDescriptorRequest) (*QueryGetBlockDescriptorResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -891,6 +1187,12 @@ func (*UnimplementedQueryServer) StateInfo(ctx context.Context, req *QueryGetSta
 func (*UnimplementedQueryServer) StateInfoAll(ctx context.Context, req *QueryAllStateInfoRequest) (*QueryAllStateInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StateInfoAll not implemented")
 }
+func (*UnimplementedQueryServer) PendingUpgrade(ctx context.Context, req *QueryGetPendingUpgradeRequest) (*QueryGetPendingUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingUpgrade not implemented")
+}
+func (*UnimplementedQueryServer) BlockDescriptor(ctx context.Context, req *QueryGetBlockDescriptorRequest) (*QueryGetBlockDescriptorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDescriptor not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1022,6 +1324,42 @@ func _Query_StateInfoAll_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/PendingUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingUpgrade(ctx, req.(*QueryGetPendingUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockDescriptor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockDescriptorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockDescriptor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Query/BlockDescriptor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockDescriptor(ctx, req.(*QueryGetBlockDescriptorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1054,6 +1392,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StateInfoAll",
 			Handler:    _Query_StateInfoAll_Handler,
 		},
+		{
+			MethodName: "PendingUpgrade",
+			Handler:    _Query_PendingUpgrade_Handler,
+		},
+		{
+			MethodName: "BlockDescriptor",
+			Handler:    _Query_BlockDescriptor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymension/rollapp/query.proto",
@@ -1490,6 +1836,21 @@ func (m *QueryAllStateInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.MaxCreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCreationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinCreationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinCreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1561,48 +1922,206 @@ func (m *QueryAllStateInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetPendingUpgradeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetPendingUpgradeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPendingUpgradeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetRollappRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetPendingUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Upgrade.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockDescriptorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockDescriptorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockDescriptorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockDescriptorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockDescriptorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockDescriptorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.StateInfoIndex.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BlockDescriptor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGetRollappByEIP155Request) Size() (n int) {
@@ -1742,6 +2261,15 @@ func (m *QueryAllStateInfoRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.MinCreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinCreationHeight))
+	}
+	if m.MaxCreationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCreationHeight))
+	}
 	return n
 }
 
@@ -1764,6 +2292,69 @@ func (m *QueryAllStateInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGetPendingUpgradeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = m.Upgrade.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetBlockDescriptorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryGetBlockDescriptorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlockDescriptor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StateInfoIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2920,6 +3511,63 @@ func (m *QueryAllStateInfoRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StateStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCreationHeight", wireType)
+			}
+			m.MinCreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCreationHeight", wireType)
+			}
+			m.MaxCreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3061,6 +3709,458 @@ func (m *QueryAllStateInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGetPendingUpgradeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingUpgradeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingUpgradeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingUpgradeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlockDescriptorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlockDescriptorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlockDescriptorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBlockDescriptorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBlockDescriptorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBlockDescriptorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDescriptor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockDescriptor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StateInfoIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StateStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
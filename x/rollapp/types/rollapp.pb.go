@@ -32,7 +32,7 @@ type Rollapp struct {
 	// creator is the bech32-encoded address of the rollapp creator.
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// version is the software and configuration version.
-	// starts from 1 and increases by one on every MsgUpdateState
+	// It is set by the rollapp upgrades, see MsgScheduleRollappUpgrade
 	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	// codeStamp is a generated hash for unique identification of the rollapp code.
 	CodeStamp string `protobuf:"bytes,4,opt,name=codeStamp,proto3" json:"codeStamp,omitempty"` // Deprecated: Do not use.
@@ -48,6 +48,21 @@ type Rollapp struct {
 	PermissionedAddresses []string `protobuf:"bytes,8,rep,name=permissionedAddresses,proto3" json:"permissionedAddresses,omitempty"`
 	// tokenMetadata is a list of TokenMetadata that are registered on this rollapp
 	TokenMetadata []*TokenMetadata `protobuf:"bytes,9,rep,name=tokenMetadata,proto3" json:"tokenMetadata,omitempty"`
+	// clientId is the canonical IBC client of the rollapp on the hub.
+	// Only packets over channels of this client are considered as coming from the rollapp.
+	ClientId string `protobuf:"bytes,10,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	// channelIds optionally restricts the channels of the canonical client considered as the rollapp's.
+	// In the case of an empty list, all the channels of the client are considered.
+	ChannelIds []string `protobuf:"bytes,11,rep,name=channelIds,proto3" json:"channelIds,omitempty"`
+	// pendingUpgrade is the scheduled upgrade of the rollapp software version, if any.
+	// It is applied to the version once the first state running the new version is finalized
+	PendingUpgrade *RollappUpgrade `protobuf:"bytes,12,opt,name=pendingUpgrade,proto3" json:"pendingUpgrade,omitempty"`
+	// disputePeriodInBlocks is the dispute period chosen by the rollapp, within the bounds of the module params.
+	// Zero follows the dispute_period_in_blocks param
+	DisputePeriodInBlocks uint64 `protobuf:"varint,13,opt,name=disputePeriodInBlocks,proto3" json:"disputePeriodInBlocks,omitempty"`
+	// frozen is set by governance to halt the rollapp. A frozen rollapp can not update its state
+	// and its pending states are not finalized
+	Frozen bool `protobuf:"varint,14,opt,name=frozen,proto3" json:"frozen,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -149,6 +164,97 @@ func (m *Rollapp) GetTokenMetadata() []*TokenMetadata {
 	return nil
 }
 
+func (m *Rollapp) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *Rollapp) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
+	}
+	return nil
+}
+
+func (m *Rollapp) GetPendingUpgrade() *RollappUpgrade {
+	if m != nil {
+		return m.PendingUpgrade
+	}
+	return nil
+}
+
+func (m *Rollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Rollapp) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// RollappUpgrade defines a scheduled upgrade of the rollapp software version
+type RollappUpgrade struct {
+	// version is the rollapp version after the upgrade
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the first rollapp height running the new version.
+	// State updates starting from this height must carry the new version
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RollappUpgrade) Reset()         { *m = RollappUpgrade{} }
+func (m *RollappUpgrade) String() string { return proto.CompactTextString(m) }
+func (*RollappUpgrade) ProtoMessage()    {}
+func (*RollappUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c072320fdc0abd9, []int{1}
+}
+func (m *RollappUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappUpgrade.Merge(m, src)
+}
+func (m *RollappUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappUpgrade proto.InternalMessageInfo
+
+func (m *RollappUpgrade) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollappUpgrade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // Rollapp summary is a compact representation of Rollapp
 type RollappSummary struct {
 	// The unique identifier of the rollapp chain.
@@ -164,7 +270,7 @@ func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2c072320fdc0abd9, []int{1}
+	return fileDescriptor_2c072320fdc0abd9, []int{2}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,41 +322,49 @@ func (m *RollappSummary) GetLatestFinalizedStateIndex() *StateInfoIndex {
 
 func init() {
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*RollappUpgrade)(nil), "dymensionxyz.dymension.rollapp.RollappUpgrade")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
 }
 
 func init() { proto.RegisterFile("dymension/rollapp/rollapp.proto", fileDescriptor_2c072320fdc0abd9) }
 
 var fileDescriptor_2c072320fdc0abd9 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0xef, 0x6c, 0xd6, 0xad, 0x99, 0xb2, 0x22, 0xc3, 0x0a, 0xe3, 0xb2, 0xc4, 0x50, 0xf6, 0xd0,
-	0x8b, 0x29, 0xae, 0xe2, 0xdd, 0x1e, 0x84, 0x45, 0x04, 0x49, 0x04, 0x61, 0x2f, 0x32, 0xcd, 0xbc,
-	0x4d, 0x87, 0x66, 0x66, 0xe2, 0xcc, 0x54, 0xd2, 0xfd, 0x0c, 0x1e, 0xfc, 0x58, 0x1e, 0xf7, 0xe8,
-	0x51, 0xda, 0x2f, 0xe1, 0x51, 0x92, 0xb6, 0x69, 0x96, 0xae, 0x16, 0x3c, 0x25, 0xef, 0xf7, 0xe7,
-	0xfd, 0x1e, 0xfc, 0x06, 0x3f, 0xe3, 0x73, 0x09, 0xca, 0x0a, 0xad, 0x86, 0x46, 0xe7, 0x39, 0x2b,
-	0x8a, 0xcd, 0x37, 0x2a, 0x8c, 0x76, 0x9a, 0x04, 0x8d, 0xa0, 0x9c, 0xdf, 0x44, 0xcd, 0x10, 0xad,
-	0x55, 0xa7, 0x27, 0x99, 0xce, 0x74, 0x2d, 0x1d, 0x56, 0x7f, 0x2b, 0xd7, 0x69, 0x7f, 0x77, 0xad,
-	0x75, 0xcc, 0xc1, 0x67, 0xa1, 0xae, 0x37, 0x9a, 0xb3, 0x5d, 0xcd, 0x98, 0xa9, 0xe9, 0x8a, 0xed,
-	0x7f, 0xf3, 0x70, 0x37, 0x5e, 0xc1, 0xe4, 0x0c, 0xfb, 0x6b, 0xc5, 0x25, 0xa7, 0x28, 0x44, 0x03,
-	0x3f, 0xde, 0x02, 0x84, 0xe2, 0x6e, 0x6a, 0x80, 0x39, 0x6d, 0xe8, 0x41, 0xcd, 0x6d, 0xc6, 0x8a,
-	0xf9, 0x0a, 0xa6, 0x4a, 0xa0, 0x5e, 0x88, 0x06, 0x87, 0xf1, 0x66, 0x24, 0x21, 0xf6, 0x53, 0xcd,
-	0x21, 0x71, 0x4c, 0x16, 0xf4, 0xb0, 0x72, 0x8d, 0x0e, 0x28, 0x8a, 0xb7, 0x20, 0x39, 0xc7, 0xbd,
-	0x0c, 0x14, 0x58, 0x61, 0x3f, 0x30, 0x37, 0xa1, 0x0f, 0x1a, 0x4d, 0x1b, 0x26, 0xaf, 0xf1, 0x89,
-	0x64, 0xe5, 0x27, 0xe1, 0x26, 0x13, 0x9d, 0x73, 0xa1, 0xb2, 0x51, 0xae, 0xd3, 0xa9, 0xa5, 0x47,
-	0x55, 0x5c, 0x2d, 0xbf, 0x97, 0x27, 0xe7, 0xf8, 0x58, 0xb2, 0x32, 0x81, 0x2f, 0x33, 0x50, 0x29,
-	0x18, 0x4b, 0xbb, 0xf5, 0x7d, 0x77, 0x41, 0xf2, 0x0a, 0x3f, 0x29, 0xc0, 0x48, 0x61, 0xab, 0x9b,
-	0x81, 0xbf, 0xe1, 0xdc, 0x80, 0xb5, 0x60, 0xe9, 0xc3, 0xd0, 0x1b, 0xf8, 0xf1, 0xfd, 0x24, 0x49,
-	0xf0, 0xb1, 0xd3, 0x53, 0x50, 0xef, 0xc1, 0x31, 0xce, 0x1c, 0xa3, 0x7e, 0xe8, 0x0d, 0x7a, 0x17,
-	0xcf, 0xa3, 0x7f, 0x37, 0x19, 0x7d, 0x6c, 0x9b, 0xe2, 0xbb, 0x3b, 0xfa, 0xbf, 0x11, 0x7e, 0xb4,
-	0xae, 0x23, 0x99, 0x49, 0xc9, 0xcc, 0x7c, 0x4f, 0x2b, 0x57, 0xf8, 0x71, 0xce, 0x1c, 0x58, 0x97,
-	0x54, 0xbd, 0x5f, 0x2a, 0x0e, 0x65, 0x5d, 0x4f, 0xef, 0x22, 0xda, 0x77, 0xc8, 0xda, 0x71, 0xad,
-	0x6b, 0x57, 0xbc, 0xb3, 0x87, 0xe4, 0xf8, 0xe9, 0x0a, 0x7b, 0x2b, 0x14, 0xcb, 0xc5, 0x0d, 0xf0,
-	0x56, 0x88, 0xf7, 0x5f, 0x21, 0x7f, 0x5f, 0x38, 0x7a, 0xf7, 0x63, 0x11, 0xa0, 0xdb, 0x45, 0x80,
-	0x7e, 0x2d, 0x02, 0xf4, 0x7d, 0x19, 0x74, 0x6e, 0x97, 0x41, 0xe7, 0xe7, 0x32, 0xe8, 0x5c, 0xbd,
-	0xc8, 0x84, 0x9b, 0xcc, 0xc6, 0x51, 0xaa, 0xe5, 0xb0, 0x1d, 0xb7, 0x1d, 0x86, 0x65, 0xf3, 0xb6,
-	0xdd, 0xbc, 0x00, 0x3b, 0x3e, 0xaa, 0x5f, 0xf7, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5b,
-	0x1d, 0x41, 0x76, 0x78, 0x03, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0x9b, 0x90, 0x36, 0x1b, 0x5a, 0xa1, 0x55, 0xa9, 0x96, 0xa8, 0x32, 0x56, 0xd4, 0x83,
+	0x2f, 0x38, 0xa2, 0x20, 0xee, 0xe4, 0x80, 0x14, 0x21, 0xa4, 0xca, 0xe1, 0x43, 0xea, 0x05, 0x6d,
+	0xbc, 0x13, 0x7b, 0x15, 0x7b, 0xd7, 0xec, 0x6e, 0x50, 0x92, 0x5f, 0xc1, 0xcf, 0xe2, 0xd8, 0x23,
+	0x47, 0x94, 0xdc, 0x39, 0x73, 0x44, 0x76, 0x1c, 0xc7, 0x21, 0x81, 0x22, 0x4e, 0xf6, 0x7b, 0xf3,
+	0x66, 0xde, 0x78, 0x76, 0xbc, 0xe8, 0x31, 0x9b, 0x25, 0x20, 0x34, 0x97, 0xa2, 0xab, 0x64, 0x1c,
+	0xd3, 0x34, 0x5d, 0x3f, 0xbd, 0x54, 0x49, 0x23, 0xb1, 0x5d, 0x0a, 0xa6, 0xb3, 0xb9, 0x57, 0x02,
+	0xaf, 0x50, 0xb5, 0xcf, 0x42, 0x19, 0xca, 0x5c, 0xda, 0xcd, 0xde, 0x56, 0x59, 0xed, 0xce, 0x6e,
+	0x59, 0x6d, 0xa8, 0x81, 0x8f, 0x5c, 0x8c, 0xd6, 0x9a, 0x8b, 0x5d, 0xcd, 0x90, 0x8a, 0xf1, 0x2a,
+	0xda, 0xf9, 0x51, 0x47, 0x47, 0xfe, 0x8a, 0xc6, 0x17, 0xa8, 0x59, 0x28, 0xfa, 0x8c, 0x58, 0x8e,
+	0xe5, 0x36, 0xfd, 0x0d, 0x81, 0x09, 0x3a, 0x0a, 0x14, 0x50, 0x23, 0x15, 0x39, 0xcc, 0x63, 0x6b,
+	0x98, 0x45, 0x3e, 0x83, 0xca, 0x1c, 0x48, 0xcd, 0xb1, 0xdc, 0xba, 0xbf, 0x86, 0xd8, 0x41, 0xcd,
+	0x40, 0x32, 0x18, 0x18, 0x9a, 0xa4, 0xa4, 0x9e, 0x65, 0xf5, 0x0e, 0x89, 0xe5, 0x6f, 0x48, 0x7c,
+	0x89, 0x5a, 0x21, 0x08, 0xd0, 0x5c, 0x5f, 0x53, 0x13, 0x91, 0x7b, 0xa5, 0xa6, 0x4a, 0xe3, 0x17,
+	0xe8, 0x2c, 0xa1, 0xd3, 0x0f, 0xdc, 0x44, 0x91, 0x8c, 0x19, 0x17, 0x61, 0x2f, 0x96, 0xc1, 0x58,
+	0x93, 0x46, 0x66, 0x97, 0xcb, 0xf7, 0xc6, 0xf1, 0x25, 0x3a, 0x49, 0xe8, 0x74, 0x00, 0x9f, 0x26,
+	0x20, 0x02, 0x50, 0x9a, 0x1c, 0xe5, 0xfd, 0x6d, 0x93, 0xf8, 0x39, 0x7a, 0x98, 0x82, 0x4a, 0xb8,
+	0xce, 0x7a, 0x06, 0xf6, 0x92, 0x31, 0x05, 0x5a, 0x83, 0x26, 0xc7, 0x4e, 0xcd, 0x6d, 0xfa, 0xfb,
+	0x83, 0x78, 0x80, 0x4e, 0x8c, 0x1c, 0x83, 0x78, 0x03, 0x86, 0x32, 0x6a, 0x28, 0x69, 0x3a, 0x35,
+	0xb7, 0x75, 0xf5, 0xc4, 0xfb, 0xfb, 0x49, 0x7a, 0x6f, 0xab, 0x49, 0xfe, 0x76, 0x0d, 0xdc, 0x46,
+	0xc7, 0x41, 0xcc, 0x41, 0x98, 0x3e, 0x23, 0x28, 0x9f, 0x72, 0x89, 0xb1, 0x8d, 0x50, 0x10, 0x51,
+	0x21, 0x20, 0xee, 0x33, 0x4d, 0x5a, 0x79, 0x6f, 0x15, 0x06, 0xbf, 0x47, 0xa7, 0x29, 0x88, 0xec,
+	0xeb, 0xdf, 0xa5, 0xa1, 0xa2, 0x0c, 0xc8, 0x7d, 0xc7, 0x72, 0x5b, 0x57, 0xde, 0x5d, 0x1d, 0x15,
+	0xe7, 0x5f, 0x64, 0xf9, 0xbf, 0x55, 0xc9, 0xc6, 0xc3, 0xb8, 0x4e, 0x27, 0x06, 0xae, 0x41, 0x71,
+	0xc9, 0xfa, 0xa2, 0x98, 0xfe, 0x49, 0x3e, 0xcc, 0xfd, 0x41, 0x7c, 0x8e, 0x1a, 0x23, 0x25, 0xe7,
+	0x20, 0xc8, 0xa9, 0x63, 0xb9, 0xc7, 0x7e, 0x81, 0x3a, 0x3d, 0x74, 0xba, 0xed, 0x57, 0x5d, 0x1f,
+	0x6b, 0x7b, 0x7d, 0xce, 0x51, 0x23, 0x02, 0x1e, 0x46, 0x26, 0xdf, 0xb8, 0xba, 0x5f, 0xa0, 0xce,
+	0x4f, 0xab, 0x2c, 0x32, 0x98, 0x24, 0x09, 0x55, 0xb3, 0x3b, 0x76, 0xf7, 0x06, 0x3d, 0x88, 0xa9,
+	0x01, 0x6d, 0x06, 0xd9, 0xdf, 0xd1, 0x17, 0x0c, 0xa6, 0xe4, 0xf0, 0xdf, 0x86, 0x53, 0x64, 0x8c,
+	0x64, 0x9e, 0xe5, 0xef, 0xd4, 0xc1, 0x31, 0x7a, 0xb4, 0xe2, 0x5e, 0x71, 0x41, 0x63, 0x3e, 0x07,
+	0x56, 0x31, 0xa9, 0xfd, 0x97, 0xc9, 0x9f, 0x0b, 0xf6, 0x5e, 0x7f, 0x5d, 0xd8, 0xd6, 0xed, 0xc2,
+	0xb6, 0xbe, 0x2f, 0x6c, 0xeb, 0xcb, 0xd2, 0x3e, 0xb8, 0x5d, 0xda, 0x07, 0xdf, 0x96, 0xf6, 0xc1,
+	0xcd, 0xd3, 0x90, 0x9b, 0x68, 0x32, 0xf4, 0x02, 0x99, 0x74, 0xab, 0x76, 0x1b, 0xd0, 0x9d, 0x96,
+	0x37, 0x80, 0x99, 0xa5, 0xa0, 0x87, 0x8d, 0xfc, 0x0e, 0x78, 0xf6, 0x6b, 0x00, 0xa8, 0xd2, 0x67,
+	0xa7, 0x9e, 0x04, 0x00, 0x00,
 }
 
 func (m *Rollapp) Marshal() (dAtA []byte, err error) {
@@ -273,6 +387,49 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PendingUpgrade != nil {
+		{
+			size, err := m.PendingUpgrade.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollapp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintRollapp(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintRollapp(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if len(m.TokenMetadata) > 0 {
 		for iNdEx := len(m.TokenMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -342,6 +499,39 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollappUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RollappSummary) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -450,6 +640,41 @@ func (m *Rollapp) Size() (n int) {
 			n += 1 + l + sovRollapp(uint64(l))
 		}
 	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovRollapp(uint64(l))
+		}
+	}
+	if m.PendingUpgrade != nil {
+		l = m.PendingUpgrade.Size()
+		n += 1 + l + sovRollapp(uint64(l))
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *RollappUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovRollapp(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRollapp(uint64(m.Height))
+	}
 	return n
 }
 
@@ -760,6 +985,233 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpgrade", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingUpgrade == nil {
+				m.PendingUpgrade = &RollappUpgrade{}
+			}
+			if err := m.PendingUpgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollappUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
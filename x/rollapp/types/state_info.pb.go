@@ -107,6 +107,9 @@ type StateInfo struct {
 	// BDs is a list of block description objects (one per block)
 	// the list must be ordered by height, starting from startHeight to startHeight+numBlocks-1
 	BDs BlockDescriptors `protobuf:"bytes,9,opt,name=BDs,proto3" json:"BDs"`
+	// finalizationHeight is the block height at which the state is finalized, if not disputed.
+	// The states of a rollapp are finalized in the order of their index.
+	FinalizationHeight uint64 `protobuf:"varint,10,opt,name=finalizationHeight,proto3" json:"finalizationHeight,omitempty"`
 }
 
 func (m *StateInfo) Reset()         { *m = StateInfo{} }
@@ -205,6 +208,13 @@ func (m *StateInfo) GetBDs() BlockDescriptors {
 	return BlockDescriptors{}
 }
 
+func (m *StateInfo) GetFinalizationHeight() uint64 {
+	if m != nil {
+		return m.FinalizationHeight
+	}
+	return 0
+}
+
 // StateInfoSummary is a compact representation of StateInfo
 type StateInfoSummary struct {
 	// stateInfoIndex defines what rollapp the state belongs to
@@ -339,37 +349,37 @@ func init() {
 
 var fileDescriptor_17fce0215a9cbbfb = []byte{
 	// 484 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xdf, 0x8a, 0xd3, 0x40,
-	0x14, 0xc6, 0x3b, 0x4d, 0xb7, 0x6b, 0x66, 0xa1, 0xe8, 0xb0, 0xc8, 0xb0, 0x48, 0x0c, 0x41, 0xa4,
-	0x20, 0x24, 0xba, 0x3e, 0x81, 0xb5, 0xc8, 0x16, 0x6f, 0x34, 0xf5, 0x4a, 0x84, 0x25, 0x4d, 0xa7,
-	0xed, 0x60, 0x33, 0x13, 0x67, 0x26, 0xd2, 0xee, 0x53, 0xf8, 0x28, 0x5e, 0xf8, 0x10, 0x7b, 0xb9,
-	0x97, 0xde, 0x28, 0xd2, 0xbe, 0x88, 0xcc, 0x64, 0x4c, 0xba, 0xed, 0xfa, 0x87, 0x82, 0x37, 0x21,
-	0xe7, 0x9b, 0xef, 0xfc, 0xe6, 0xcc, 0x97, 0x0c, 0x0c, 0xc6, 0xcb, 0x8c, 0x30, 0x49, 0x39, 0x8b,
-	0x04, 0x9f, 0xcf, 0x93, 0x3c, 0x8f, 0xa4, 0x4a, 0x14, 0x39, 0xa7, 0x6c, 0xc2, 0xc3, 0x5c, 0x70,
-	0xc5, 0x91, 0x57, 0x79, 0x16, 0xcb, 0x8b, 0xb0, 0x2a, 0x42, 0xdb, 0x70, 0x72, 0x3c, 0xe5, 0x53,
-	0x6e, 0xac, 0x91, 0x7e, 0x2b, 0xbb, 0x4e, 0xba, 0xbb, 0xe4, 0xd1, 0x9c, 0xa7, 0xef, 0xcf, 0xc7,
-	0x44, 0xa6, 0x82, 0xe6, 0x8a, 0x0b, 0xeb, 0x7c, 0xf0, 0xbb, 0x19, 0xf4, 0xb3, 0x90, 0xa5, 0x2b,
-	0xe8, 0xc3, 0xce, 0x50, 0xab, 0x03, 0x36, 0xe1, 0x03, 0x36, 0x26, 0x0b, 0x74, 0x0f, 0xba, 0xd6,
-	0x3f, 0x18, 0x63, 0xe0, 0x83, 0xae, 0x1b, 0xd7, 0x02, 0x3a, 0x86, 0x07, 0x54, 0xdb, 0x70, 0xd3,
-	0x07, 0xdd, 0x56, 0x5c, 0x16, 0xc1, 0x67, 0x07, 0xba, 0x15, 0x06, 0xbd, 0x83, 0x1d, 0x79, 0x8d,
-	0x69, 0x30, 0x47, 0xa7, 0x61, 0xf8, 0xe7, 0x23, 0x87, 0xd7, 0x27, 0xe9, 0xb5, 0x2e, 0xbf, 0xdf,
-	0x6f, 0xc4, 0x5b, 0x2c, 0x3d, 0x9f, 0x24, 0x1f, 0x0a, 0xc2, 0x52, 0x22, 0xcc, 0x14, 0x6e, 0x5c,
-	0x0b, 0xc8, 0x87, 0x47, 0x52, 0x25, 0x42, 0x9d, 0x11, 0x3a, 0x9d, 0x29, 0xec, 0x98, 0x29, 0x37,
-	0x25, 0xdd, 0xcf, 0x8a, 0xac, 0xa7, 0x43, 0x93, 0xb8, 0x65, 0xd6, 0x6b, 0x01, 0xdd, 0x85, 0xed,
-	0xfe, 0xb3, 0x57, 0x89, 0x9a, 0xe1, 0x03, 0x83, 0xb6, 0x15, 0xc2, 0xf0, 0xf0, 0x23, 0x11, 0x7a,
-	0x5a, 0xdc, 0x36, 0x3d, 0xbf, 0x4a, 0xf4, 0x10, 0x76, 0x52, 0x41, 0x12, 0x45, 0x39, 0xb3, 0x9b,
-	0x1e, 0x1a, 0xc3, 0x96, 0x8a, 0x9e, 0xc3, 0x76, 0x99, 0x3c, 0xbe, 0xe5, 0x83, 0x6e, 0xe7, 0xf4,
-	0xd1, 0x3f, 0xa5, 0x31, 0x34, 0x2d, 0xb1, 0x6d, 0x45, 0x67, 0xd0, 0xe9, 0xf5, 0x25, 0x76, 0x4d,
-	0x9e, 0x8f, 0xff, 0x46, 0x30, 0x67, 0xea, 0x57, 0x3f, 0x86, 0xb4, 0x89, 0x6a, 0x44, 0xf0, 0x0d,
-	0xc0, 0xdb, 0x55, 0xde, 0xc3, 0x22, 0xcb, 0x12, 0xb1, 0xfc, 0xcf, 0x5f, 0xae, 0x4e, 0xa0, 0xb9,
-	0x7f, 0x02, 0xbb, 0x71, 0x3b, 0x37, 0xc5, 0x1d, 0x7c, 0x01, 0xd0, 0x33, 0xe7, 0x2f, 0xeb, 0x37,
-	0xfc, 0x05, 0x65, 0xc9, 0x9c, 0x5e, 0x18, 0xcf, 0xeb, 0x82, 0x14, 0x04, 0x85, 0x10, 0x4d, 0x36,
-	0x44, 0x8b, 0x03, 0x06, 0x77, 0xc3, 0x0a, 0x1a, 0xc1, 0x3b, 0x93, 0x6d, 0x08, 0x6e, 0xfa, 0xce,
-	0xde, 0x01, 0xed, 0xe2, 0x7a, 0x2f, 0x2f, 0x57, 0x1e, 0xb8, 0x5a, 0x79, 0xe0, 0xc7, 0xca, 0x03,
-	0x9f, 0xd6, 0x5e, 0xe3, 0x6a, 0xed, 0x35, 0xbe, 0xae, 0xbd, 0xc6, 0xdb, 0x27, 0x53, 0xaa, 0x66,
-	0xc5, 0x28, 0x4c, 0x79, 0x16, 0x6d, 0x6e, 0x56, 0x17, 0xd1, 0xa2, 0xba, 0xe9, 0x6a, 0x99, 0x13,
-	0x39, 0x6a, 0x9b, 0x3b, 0xfe, 0xf4, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x38, 0x26, 0x06,
-	0x8f, 0x04, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0xed, 0xb4, 0xdd, 0xae, 0xb9, 0x0b, 0x41, 0x87, 0x45, 0x86, 0x45, 0x62, 0x08, 0x22, 0x05,
+	0x21, 0xd1, 0xf5, 0x0b, 0xac, 0x45, 0xb6, 0xf8, 0xa2, 0xa9, 0x4f, 0x22, 0x2c, 0x69, 0x3a, 0x6d,
+	0x07, 0x9b, 0x99, 0x38, 0x33, 0x91, 0x76, 0xbf, 0xc2, 0x8f, 0xf1, 0x23, 0xf6, 0x71, 0x1f, 0x7d,
+	0x51, 0x96, 0xf6, 0x47, 0x24, 0x93, 0x98, 0x74, 0xb7, 0x5b, 0x95, 0x85, 0x7d, 0x09, 0xb9, 0x77,
+	0xce, 0x39, 0x73, 0xe6, 0xdc, 0x61, 0xc0, 0x1b, 0x2f, 0x13, 0xca, 0x15, 0x13, 0x3c, 0x90, 0x62,
+	0x3e, 0x8f, 0xd2, 0x34, 0x50, 0x3a, 0xd2, 0xf4, 0x94, 0xf1, 0x89, 0xf0, 0x53, 0x29, 0xb4, 0xc0,
+	0x4e, 0x85, 0x59, 0x2c, 0xcf, 0xfc, 0xaa, 0xf0, 0x4b, 0xc2, 0xd1, 0xe1, 0x54, 0x4c, 0x85, 0x81,
+	0x06, 0xf9, 0x5f, 0xc1, 0x3a, 0xea, 0x6e, 0x2b, 0x8f, 0xe6, 0x22, 0xfe, 0x7c, 0x3a, 0xa6, 0x2a,
+	0x96, 0x2c, 0xd5, 0x42, 0x96, 0xc8, 0x27, 0xbb, 0x3c, 0xe4, 0xdf, 0x4c, 0x15, 0x28, 0xaf, 0x0f,
+	0xf6, 0x30, 0xef, 0x0e, 0xf8, 0x44, 0x0c, 0xf8, 0x98, 0x2e, 0xf0, 0x23, 0xb0, 0x4a, 0xfc, 0x60,
+	0x4c, 0x90, 0x8b, 0xba, 0x56, 0x58, 0x37, 0xf0, 0x21, 0xec, 0xb1, 0x1c, 0x46, 0x9a, 0x2e, 0xea,
+	0xb6, 0xc3, 0xa2, 0xf0, 0x2e, 0x5b, 0x60, 0x55, 0x32, 0xf8, 0x13, 0xd8, 0xea, 0x8a, 0xa6, 0x91,
+	0x39, 0x38, 0xf6, 0xfd, 0xbf, 0x1f, 0xd9, 0xbf, 0xea, 0xa4, 0xd7, 0x3e, 0xff, 0xf5, 0xb8, 0x11,
+	0xda, 0x6a, 0xcb, 0x9f, 0xa2, 0x5f, 0x32, 0xca, 0x63, 0x2a, 0x8d, 0x0b, 0x2b, 0xac, 0x1b, 0xd8,
+	0x85, 0x03, 0xa5, 0x23, 0xa9, 0x4f, 0x28, 0x9b, 0xce, 0x34, 0x69, 0x19, 0x97, 0x9b, 0xad, 0x9c,
+	0xcf, 0xb3, 0xa4, 0x97, 0x87, 0xa6, 0x48, 0xdb, 0xac, 0xd7, 0x0d, 0xfc, 0x10, 0x3a, 0xfd, 0x57,
+	0xef, 0x22, 0x3d, 0x23, 0x7b, 0x46, 0xba, 0xac, 0x30, 0x81, 0xfd, 0xaf, 0x54, 0xe6, 0x6e, 0x49,
+	0xc7, 0x70, 0xfe, 0x94, 0xf8, 0x29, 0xd8, 0xb1, 0xa4, 0x91, 0x66, 0x82, 0x97, 0x9b, 0xee, 0x1b,
+	0xc0, 0xb5, 0x2e, 0x7e, 0x0d, 0x9d, 0x22, 0x79, 0x72, 0xcf, 0x45, 0x5d, 0xfb, 0xf8, 0xd9, 0x7f,
+	0xa5, 0x31, 0x34, 0x94, 0xb0, 0xa4, 0xe2, 0x13, 0x68, 0xf5, 0xfa, 0x8a, 0x58, 0x26, 0xcf, 0xe7,
+	0xff, 0x52, 0x30, 0x67, 0xea, 0x57, 0x17, 0x43, 0x95, 0x89, 0xe6, 0x12, 0xd8, 0x07, 0x3c, 0x61,
+	0x3c, 0x9a, 0xb3, 0xb3, 0x4d, 0xeb, 0x60, 0xac, 0xdf, 0xb0, 0xe2, 0xfd, 0x44, 0x70, 0xbf, 0x9a,
+	0xcf, 0x30, 0x4b, 0x92, 0x48, 0x2e, 0xef, 0x78, 0xd2, 0x75, 0x62, 0xcd, 0xdb, 0x27, 0xb6, 0x3d,
+	0x9e, 0xd6, 0x4d, 0xe3, 0xf1, 0xbe, 0x23, 0x70, 0x4c, 0x5e, 0x45, 0xfd, 0x41, 0xbc, 0xd9, 0xc8,
+	0xe0, 0x7d, 0x46, 0x33, 0xba, 0x23, 0x32, 0xb4, 0x2b, 0x32, 0x3c, 0x82, 0x07, 0x93, 0xeb, 0x22,
+	0xa4, 0xe9, 0xb6, 0x6e, 0x1d, 0xd0, 0xb6, 0x5c, 0xef, 0xed, 0xf9, 0xca, 0x41, 0x17, 0x2b, 0x07,
+	0x5d, 0xae, 0x1c, 0xf4, 0x6d, 0xed, 0x34, 0x2e, 0xd6, 0x4e, 0xe3, 0xc7, 0xda, 0x69, 0x7c, 0x7c,
+	0x31, 0x65, 0x7a, 0x96, 0x8d, 0xfc, 0x58, 0x24, 0xc1, 0xe6, 0x66, 0x75, 0x11, 0x2c, 0xaa, 0x97,
+	0x41, 0x2f, 0x53, 0xaa, 0x46, 0x1d, 0xf3, 0x26, 0xbc, 0xfc, 0x3d, 0x00, 0x64, 0xcc, 0xe6, 0xe6,
+	0xbf, 0x04, 0x00, 0x00,
 }
 
 func (m *StateInfoIndex) Marshal() (dAtA []byte, err error) {
@@ -427,6 +437,11 @@ func (m *StateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizationHeight != 0 {
+		i = encodeVarintStateInfo(dAtA, i, uint64(m.FinalizationHeight))
+		i--
+		dAtA[i] = 0x50
+	}
 	{
 		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -634,6 +649,9 @@ func (m *StateInfo) Size() (n int) {
 	}
 	l = m.BDs.Size()
 	n += 1 + l + sovStateInfo(uint64(l))
+	if m.FinalizationHeight != 0 {
+		n += 1 + sovStateInfo(uint64(m.FinalizationHeight))
+	}
 	return n
 }
 
@@ -1033,6 +1051,25 @@ func (m *StateInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationHeight", wireType)
+			}
+			m.FinalizationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
@@ -30,18 +30,23 @@ const (
 	STATE_STATUS_RECEIVED StateStatus = 1
 	// STATE_STATUS_FINALIZED defines a rollapp state where the the "Dispute Period" has ended and this state is considered final
 	STATE_STATUS_FINALIZED StateStatus = 2
+	// STATE_STATUS_REVERTED defines a rollapp state that was disputed during its "Dispute Period" and
+	// was rolled back together with every state that followed it
+	STATE_STATUS_REVERTED StateStatus = 3
 )
 
 var StateStatus_name = map[int32]string{
 	0: "STATE_STATUS_UNSPECIFIED",
 	1: "STATE_STATUS_RECEIVED",
 	2: "STATE_STATUS_FINALIZED",
+	3: "STATE_STATUS_REVERTED",
 }
 
 var StateStatus_value = map[string]int32{
 	"STATE_STATUS_UNSPECIFIED": 0,
 	"STATE_STATUS_RECEIVED":    1,
 	"STATE_STATUS_FINALIZED":   2,
+	"STATE_STATUS_REVERTED":    3,
 }
 
 func (x StateStatus) String() string {
@@ -61,19 +66,20 @@ func init() {
 }
 
 var fileDescriptor_b039e93a8767dffd = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
+	// 230 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0xca, 0xcf, 0xc9, 0x49, 0x2c, 0x28, 0xd0, 0x2f, 0x2e,
 	0x49, 0x2c, 0x49, 0x8d, 0x07, 0x91, 0xa5, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x72,
 	0x70, 0x55, 0x15, 0x95, 0x55, 0x7a, 0x70, 0x8e, 0x1e, 0x54, 0x8b, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x58, 0xa9, 0x3e, 0x88, 0x05, 0xd1, 0xa5, 0x95, 0xc1, 0xc5, 0x1d, 0x0c, 0x32, 0x2b, 0x18,
-	0x6c, 0x94, 0x90, 0x0c, 0x97, 0x44, 0x70, 0x88, 0x63, 0x88, 0x6b, 0x3c, 0x88, 0x0c, 0x0d, 0x8e,
-	0x0f, 0xf5, 0x0b, 0x0e, 0x70, 0x75, 0xf6, 0x74, 0xf3, 0x74, 0x75, 0x11, 0x60, 0x10, 0x92, 0xe4,
-	0x12, 0x45, 0x91, 0x0d, 0x72, 0x75, 0x76, 0xf5, 0x0c, 0x73, 0x75, 0x11, 0x60, 0x14, 0x92, 0xe2,
-	0x12, 0x43, 0x91, 0x72, 0xf3, 0xf4, 0x73, 0xf4, 0xf1, 0x8c, 0x72, 0x75, 0x11, 0x60, 0x92, 0x62,
-	0xe9, 0x58, 0x2c, 0xc7, 0xe0, 0xe4, 0x7d, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f,
-	0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c,
-	0x51, 0x86, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc8, 0x9e, 0x40,
-	0x70, 0xf4, 0x2b, 0xe0, 0x3e, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xbb, 0xde, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xa1, 0x76, 0x35, 0x1b, 0x01, 0x00, 0x00,
+	0x3e, 0x58, 0xa9, 0x3e, 0x88, 0x05, 0xd1, 0xa5, 0xd5, 0xcc, 0xc8, 0xc5, 0x1d, 0x0c, 0x32, 0x2c,
+	0x18, 0x6c, 0x96, 0x90, 0x0c, 0x97, 0x44, 0x70, 0x88, 0x63, 0x88, 0x6b, 0x3c, 0x88, 0x0c, 0x0d,
+	0x8e, 0x0f, 0xf5, 0x0b, 0x0e, 0x70, 0x75, 0xf6, 0x74, 0xf3, 0x74, 0x75, 0x11, 0x60, 0x10, 0x92,
+	0xe4, 0x12, 0x45, 0x91, 0x0d, 0x72, 0x75, 0x76, 0xf5, 0x0c, 0x73, 0x75, 0x11, 0x60, 0x14, 0x92,
+	0xe2, 0x12, 0x43, 0x91, 0x72, 0xf3, 0xf4, 0x73, 0xf4, 0xf1, 0x8c, 0x72, 0x75, 0x11, 0x60, 0xc2,
+	0xa2, 0x2d, 0xcc, 0x35, 0x28, 0xc4, 0xd5, 0x45, 0x80, 0x59, 0x8a, 0xa5, 0x63, 0xb1, 0x1c, 0x83,
+	0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1,
+	0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa6, 0x67, 0x96,
+	0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x23, 0x7b, 0x10, 0xc1, 0xd1, 0xaf, 0x80, 0x87,
+	0x4a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x67, 0xc6, 0x80, 0x01, 0x00, 0xad, 0xb5,
+	0xbb, 0x5c, 0x37, 0x01, 0x00, 0x00,
 }
@@ -320,479 +320,3095 @@ func (m *MsgUpdateStateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateStateResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
-	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
-	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
-	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
+// ===================== MsgSubmitFraud
+// Disputing a rollapp state during its dispute period.
+// The disputed state and every state that followed it are reverted.
+type MsgSubmitFraud struct {
+	// authority is the bech32-encoded address allowed to adjudicate disputes (the gov module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rollappId is the rollapp whose state is disputed
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// stateIndex is the index of the first StateInfo to revert.
+	// It must still be in STATE_STATUS_RECEIVED
+	StateIndex uint64 `protobuf:"varint,3,opt,name=stateIndex,proto3" json:"stateIndex,omitempty"`
+	// fraudProof is a description of the fraud evidence (e.g. the DA path of the proof)
+	FraudProof string `protobuf:"bytes,4,opt,name=fraudProof,proto3" json:"fraudProof,omitempty"`
 }
 
-func init() { proto.RegisterFile("dymension/rollapp/tx.proto", fileDescriptor_935cc363af28220c) }
-
-var fileDescriptor_935cc363af28220c = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6f, 0xd3, 0x3e,
-	0x18, 0x6d, 0xda, 0xae, 0xfb, 0xd5, 0xd5, 0x7e, 0x42, 0xd6, 0x98, 0xac, 0x68, 0x0a, 0x55, 0xb5,
-	0x43, 0x2e, 0xa4, 0x50, 0xd0, 0xc4, 0x75, 0xa5, 0x87, 0x21, 0x54, 0x09, 0x32, 0x10, 0x12, 0x17,
-	0xe4, 0xc6, 0x9f, 0x92, 0xa8, 0x4d, 0x1c, 0x6c, 0x17, 0xa5, 0x70, 0xe5, 0x0f, 0xe0, 0xcf, 0xda,
-	0x71, 0x47, 0x4e, 0x08, 0xb5, 0x7f, 0xc5, 0x6e, 0xc8, 0x69, 0xd2, 0xa4, 0xa3, 0x62, 0x4c, 0xdc,
-	0xfc, 0xbd, 0xf7, 0xbd, 0x67, 0xfb, 0x7d, 0x96, 0x91, 0xc9, 0x16, 0x11, 0xc4, 0x32, 0xe4, 0x71,
-	0x5f, 0xf0, 0xd9, 0x8c, 0x26, 0x49, 0x5f, 0xa5, 0x4e, 0x22, 0xb8, 0xe2, 0xd8, 0xda, 0x70, 0xe9,
-	0xe2, 0xb3, 0xb3, 0x29, 0x9c, 0xbc, 0xd1, 0xb4, 0x7f, 0xd7, 0x4e, 0x66, 0xdc, 0x9b, 0x7e, 0x60,
-	0x20, 0x3d, 0x11, 0x26, 0x8a, 0x8b, 0xb5, 0x93, 0x79, 0xbc, 0xa3, 0x93, 0xc6, 0xd3, 0x9c, 0x3d,
-	0xf4, 0xb9, 0xcf, 0xb3, 0x65, 0x5f, 0xaf, 0xd6, 0x68, 0xef, 0xba, 0x8e, 0xee, 0x8d, 0xa5, 0xff,
-	0x5c, 0x00, 0x55, 0xe0, 0xae, 0x55, 0x98, 0xa0, 0x7d, 0x4f, 0x03, 0x5c, 0x10, 0xa3, 0x6b, 0xd8,
-	0x6d, 0xb7, 0x28, 0xf1, 0x31, 0x6a, 0xe7, 0xd6, 0x2f, 0x18, 0xa9, 0x67, 0x5c, 0x09, 0xe0, 0x2e,
-	0x6a, 0x7b, 0x9c, 0xc1, 0x85, 0xa2, 0x51, 0x42, 0x1a, 0x9a, 0x1d, 0xd6, 0x89, 0xe1, 0x96, 0x20,
-	0x3e, 0x41, 0x1d, 0x1f, 0x62, 0x90, 0xa1, 0x7c, 0x45, 0x55, 0x40, 0x9a, 0x9b, 0x9e, 0x2a, 0x8c,
-	0x4f, 0xd1, 0x61, 0x44, 0xd3, 0x77, 0xa1, 0x0a, 0x02, 0x3e, 0x63, 0x61, 0xec, 0x0f, 0xf5, 0x85,
-	0x25, 0xd9, 0xeb, 0x1a, 0x76, 0x33, 0x6b, 0xdf, 0xc9, 0xe3, 0x13, 0x74, 0x10, 0xd1, 0xf4, 0x02,
-	0x3e, 0xce, 0x21, 0xf6, 0x40, 0x48, 0xd2, 0xd2, 0x02, 0x77, 0x1b, 0xc4, 0x4f, 0xd1, 0xfd, 0x04,
-	0x44, 0x14, 0x4a, 0x9d, 0x14, 0xb0, 0x33, 0xc6, 0x04, 0x48, 0x09, 0x92, 0xec, 0x77, 0x1b, 0x76,
-	0xdb, 0xdd, 0x4d, 0xe2, 0xd7, 0xa8, 0x1d, 0x81, 0xa2, 0x8c, 0x2a, 0x2a, 0xc9, 0x7f, 0xdd, 0x86,
-	0xdd, 0x19, 0x3c, 0x74, 0xfe, 0x3c, 0x3a, 0xe7, 0x0d, 0x9f, 0x42, 0x3c, 0xce, 0x55, 0xc3, 0xe6,
-	0xe5, 0x8f, 0x07, 0x35, 0xb7, 0x74, 0xe9, 0x99, 0x88, 0xdc, 0x8c, 0xde, 0x05, 0x99, 0xf0, 0x58,
-	0x42, 0xef, 0x6b, 0x1d, 0xfd, 0x3f, 0x96, 0xfe, 0xdb, 0x84, 0x51, 0xa5, 0xb3, 0x53, 0xf0, 0x0f,
-	0x53, 0xe9, 0x48, 0x45, 0x85, 0x3a, 0x87, 0xd0, 0x0f, 0x54, 0x36, 0x97, 0xa6, 0x5b, 0x85, 0xb4,
-	0x3e, 0x9e, 0x47, 0x79, 0xc8, 0xcd, 0x8c, 0x2f, 0x01, 0x7c, 0x84, 0x5a, 0xa3, 0xb3, 0x6c, 0x5c,
-	0x7b, 0x99, 0x75, 0x5e, 0xe9, 0xf3, 0x7c, 0x02, 0xa1, 0x2f, 0x9c, 0xe7, 0x5c, 0x94, 0xf8, 0x1c,
-	0x35, 0x86, 0x23, 0x9d, 0xa7, 0x61, 0x77, 0x06, 0x8f, 0x6e, 0x4b, 0x29, 0xdb, 0x66, 0xb4, 0x79,
-	0xcc, 0x32, 0x0f, 0x4a, 0x5b, 0xf4, 0x08, 0x3a, 0xda, 0x4e, 0xa1, 0x08, 0x68, 0x70, 0x6d, 0xa0,
-	0xc6, 0x58, 0xfa, 0xf8, 0x0b, 0x3a, 0xd8, 0x7e, 0xbc, 0xb7, 0xee, 0x77, 0x33, 0x73, 0xf3, 0xd9,
-	0x5d, 0x15, 0xc5, 0x21, 0xf0, 0x1c, 0x75, 0xaa, 0x13, 0x72, 0xfe, 0xc2, 0xa8, 0xd2, 0x6f, 0x9e,
-	0xde, 0xad, 0xbf, 0xd8, 0x76, 0xf8, 0xf2, 0x72, 0x69, 0x19, 0x57, 0x4b, 0xcb, 0xf8, 0xb9, 0xb4,
-	0x8c, 0x6f, 0x2b, 0xab, 0x76, 0xb5, 0xb2, 0x6a, 0xdf, 0x57, 0x56, 0xed, 0xfd, 0x63, 0x3f, 0x54,
-	0xc1, 0x7c, 0xe2, 0x78, 0x3c, 0xea, 0x57, 0xbd, 0xcb, 0xa2, 0x9f, 0x96, 0x5f, 0xd0, 0x22, 0x01,
-	0x39, 0x69, 0x65, 0x1f, 0xc1, 0x93, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x3e, 0x6c, 0x7e,
-	0xa4, 0x04, 0x00, 0x00,
+func (m *MsgSubmitFraud) Reset()         { *m = MsgSubmitFraud{} }
+func (m *MsgSubmitFraud) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraud) ProtoMessage()    {}
+func (*MsgSubmitFraud) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{4}
+}
+func (m *MsgSubmitFraud) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraud) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraud.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitFraud) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraud.Merge(m, src)
+}
+func (m *MsgSubmitFraud) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraud) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraud.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSubmitFraud proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
-	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
+func (m *MsgSubmitFraud) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitFraud) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSubmitFraud) GetStateIndex() uint64 {
+	if m != nil {
+		return m.StateIndex
+	}
+	return 0
 }
 
-func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
-	out := new(MsgCreateRollappResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFraud) GetFraudProof() string {
+	if m != nil {
+		return m.FraudProof
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
-	out := new(MsgUpdateStateResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgSubmitFraudResponse struct {
+}
+
+func (m *MsgSubmitFraudResponse) Reset()         { *m = MsgSubmitFraudResponse{} }
+func (m *MsgSubmitFraudResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFraudResponse) ProtoMessage()    {}
+func (*MsgSubmitFraudResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{5}
+}
+func (m *MsgSubmitFraudResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFraudResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFraudResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitFraudResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFraudResponse.Merge(m, src)
+}
+func (m *MsgSubmitFraudResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFraudResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFraudResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
-	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
+var xxx_messageInfo_MsgSubmitFraudResponse proto.InternalMessageInfo
+
+// ===================== MsgSetCanonicalClient
+// Binding a rollapp to the IBC client (and optionally channels) its packets are received through
+type MsgSetCanonicalClient struct {
+	// creator is the bech32-encoded address of the rollapp creator or of its current proposer
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollappId is the rollapp to bind
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// clientId is the hub IBC client tracking the rollapp chain
+	ClientId string `protobuf:"bytes,3,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	// channelIds optionally restricts the transfer channels of the client considered as the rollapp's
+	ChannelIds []string `protobuf:"bytes,4,rep,name=channelIds,proto3" json:"channelIds,omitempty"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgSetCanonicalClient) Reset()         { *m = MsgSetCanonicalClient{} }
+func (m *MsgSetCanonicalClient) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCanonicalClient) ProtoMessage()    {}
+func (*MsgSetCanonicalClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{6}
+}
+func (m *MsgSetCanonicalClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCanonicalClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCanonicalClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCanonicalClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCanonicalClient.Merge(m, src)
+}
+func (m *MsgSetCanonicalClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCanonicalClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCanonicalClient.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+var xxx_messageInfo_MsgSetCanonicalClient proto.InternalMessageInfo
+
+func (m *MsgSetCanonicalClient) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+
+func (m *MsgSetCanonicalClient) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgSetCanonicalClient) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRollapp)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSetCanonicalClient) GetChannelIds() []string {
+	if m != nil {
+		return m.ChannelIds
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRollapp(ctx, in)
+	return nil
+}
+
+type MsgSetCanonicalClientResponse struct {
+}
+
+func (m *MsgSetCanonicalClientResponse) Reset()         { *m = MsgSetCanonicalClientResponse{} }
+func (m *MsgSetCanonicalClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCanonicalClientResponse) ProtoMessage()    {}
+func (*MsgSetCanonicalClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{7}
+}
+func (m *MsgSetCanonicalClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCanonicalClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCanonicalClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
+}
+func (m *MsgSetCanonicalClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCanonicalClientResponse.Merge(m, src)
+}
+func (m *MsgSetCanonicalClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCanonicalClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCanonicalClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCanonicalClientResponse proto.InternalMessageInfo
+
+// ===================== MsgUpdateRollapp
+// Updating the sequencers permissions and the token metadata of a rollapp
+type MsgUpdateRollapp struct {
+	// creator is the bech32-encoded address of the rollapp creator or of the gov module account
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollappId is the rollapp to update
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// addPermissionedAddresses is a bech32-encoded address list of sequencers to permission.
+	// Permissioning sequencers on a permissionless rollapp makes it permissioned
+	AddPermissionedAddresses []string `protobuf:"bytes,3,rep,name=addPermissionedAddresses,proto3" json:"addPermissionedAddresses,omitempty"`
+	// removePermissionedAddresses is a bech32-encoded address list of sequencers to revoke.
+	// Registered sequencers that lose their permission stay bonded, but can no longer propose.
+	// A permissioned rollapp can not become permissionless by revoking all its sequencers
+	RemovePermissionedAddresses []string `protobuf:"bytes,4,rep,name=removePermissionedAddresses,proto3" json:"removePermissionedAddresses,omitempty"`
+	// maxSequencers is the new maximum number of sequencers. It can only be raised.
+	// Zero keeps the current value
+	MaxSequencers uint64 `protobuf:"varint,5,opt,name=maxSequencers,proto3" json:"maxSequencers,omitempty"`
+	// addMetadatas provides the client information of new tokens of the rollapp
+	AddMetadatas []TokenMetadata `protobuf:"bytes,6,rep,name=addMetadatas,proto3" json:"addMetadatas"`
+	// disputePeriodInBlocks is the new dispute period of the rollapp states.
+	// The states pending finalization are requeued accordingly. Zero keeps the current value
+	DisputePeriodInBlocks uint64 `protobuf:"varint,7,opt,name=disputePeriodInBlocks,proto3" json:"disputePeriodInBlocks,omitempty"`
+}
+
+func (m *MsgUpdateRollapp) Reset()         { *m = MsgUpdateRollapp{} }
+func (m *MsgUpdateRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRollapp) ProtoMessage()    {}
+func (*MsgUpdateRollapp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{8}
+}
+func (m *MsgUpdateRollapp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRollapp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
+}
+func (m *MsgUpdateRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRollapp.Merge(m, src)
+}
+func (m *MsgUpdateRollapp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRollapp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRollapp proto.InternalMessageInfo
+
+func (m *MsgUpdateRollapp) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateState)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateRollapp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateState(ctx, in)
+	return ""
+}
+
+func (m *MsgUpdateRollapp) GetAddPermissionedAddresses() []string {
+	if m != nil {
+		return m.AddPermissionedAddresses
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
+	return nil
+}
+
+func (m *MsgUpdateRollapp) GetRemovePermissionedAddresses() []string {
+	if m != nil {
+		return m.RemovePermissionedAddresses
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
+	return nil
+}
+
+func (m *MsgUpdateRollapp) GetMaxSequencers() uint64 {
+	if m != nil {
+		return m.MaxSequencers
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateRollapp",
-			Handler:    _Msg_CreateRollapp_Handler,
-		},
-		{
-			MethodName: "UpdateState",
-			Handler:    _Msg_UpdateState_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymension/rollapp/tx.proto",
+func (m *MsgUpdateRollapp) GetAddMetadatas() []TokenMetadata {
+	if m != nil {
+		return m.AddMetadatas
+	}
+	return nil
 }
 
-func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateRollappResponse struct {
 }
 
-func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadatas) > 0 {
-		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
+func (m *MsgUpdateRollappResponse) Reset()         { *m = MsgUpdateRollappResponse{} }
+func (m *MsgUpdateRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRollappResponse) ProtoMessage()    {}
+func (*MsgUpdateRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{9}
+}
+func (m *MsgUpdateRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PermissionedAddresses) > 0 {
-		for iNdEx := len(m.PermissionedAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PermissionedAddresses[iNdEx])
-			copy(dAtA[i:], m.PermissionedAddresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.PermissionedAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
+}
+func (m *MsgUpdateRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRollappResponse.Merge(m, src)
+}
+func (m *MsgUpdateRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRollappResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRollappResponse proto.InternalMessageInfo
+
+// ===================== MsgScheduleRollappUpgrade
+// Scheduling an upgrade of the rollapp software version.
+// A pending upgrade of the rollapp is replaced, unless the rollapp already submitted states running it
+type MsgScheduleRollappUpgrade struct {
+	// creator is the bech32-encoded address of the rollapp creator or of the gov module account
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollappId is the rollapp to upgrade
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+	// version is the rollapp version after the upgrade. It must be greater than the current version
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// height is the first rollapp height running the new version.
+	// It can not be lower than the start height of the next state update
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *MsgScheduleRollappUpgrade) Reset()         { *m = MsgScheduleRollappUpgrade{} }
+func (m *MsgScheduleRollappUpgrade) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRollappUpgrade) ProtoMessage()    {}
+func (*MsgScheduleRollappUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{10}
+}
+func (m *MsgScheduleRollappUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRollappUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRollappUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.MaxSequencers != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxSequencers))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.MaxWithholdingBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxWithholdingBlocks))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.GenesisPath) > 0 {
-		i -= len(m.GenesisPath)
-		copy(dAtA[i:], m.GenesisPath)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisPath)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CodeStamp) > 0 {
-		i -= len(m.CodeStamp)
-		copy(dAtA[i:], m.CodeStamp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeStamp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgScheduleRollappUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRollappUpgrade.Merge(m, src)
+}
+func (m *MsgScheduleRollappUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRollappUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRollappUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleRollappUpgrade proto.InternalMessageInfo
+
+func (m *MsgScheduleRollappUpgrade) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgScheduleRollappUpgrade) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleRollappUpgrade) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgScheduleRollappUpgrade) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgScheduleRollappUpgradeResponse struct {
 }
 
-func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleRollappUpgradeResponse) Reset()         { *m = MsgScheduleRollappUpgradeResponse{} }
+func (m *MsgScheduleRollappUpgradeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleRollappUpgradeResponse) ProtoMessage()    {}
+func (*MsgScheduleRollappUpgradeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{11}
+}
+func (m *MsgScheduleRollappUpgradeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleRollappUpgradeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleRollappUpgradeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgScheduleRollappUpgradeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleRollappUpgradeResponse.Merge(m, src)
+}
+func (m *MsgScheduleRollappUpgradeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleRollappUpgradeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleRollappUpgradeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgScheduleRollappUpgradeResponse proto.InternalMessageInfo
+
+// ===================== MsgFreezeRollapp
+// Halting a misbehaving rollapp
+type MsgFreezeRollapp struct {
+	// authority is the bech32-encoded address allowed to freeze rollapps (the gov module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rollappId is the rollapp to freeze
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
 }
 
-func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgFreezeRollapp) Reset()         { *m = MsgFreezeRollapp{} }
+func (m *MsgFreezeRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeRollapp) ProtoMessage()    {}
+func (*MsgFreezeRollapp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{12}
+}
+func (m *MsgFreezeRollapp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeRollapp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.Version != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.DAPath) > 0 {
-		i -= len(m.DAPath)
-		copy(dAtA[i:], m.DAPath)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.NumBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.StartHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgFreezeRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeRollapp.Merge(m, src)
+}
+func (m *MsgFreezeRollapp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeRollapp.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFreezeRollapp proto.InternalMessageInfo
+
+func (m *MsgFreezeRollapp) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgFreezeRollapp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
 }
 
-func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgFreezeRollappResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgFreezeRollappResponse) Reset()         { *m = MsgFreezeRollappResponse{} }
+func (m *MsgFreezeRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeRollappResponse) ProtoMessage()    {}
+func (*MsgFreezeRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{13}
 }
-func (m *MsgCreateRollapp) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFreezeRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgFreezeRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeRollappResponse.Merge(m, src)
+}
+func (m *MsgFreezeRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeRollappResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeRollappResponse proto.InternalMessageInfo
+
+// ===================== MsgDeregisterRollapp
+// Removing a frozen rollapp, its states and its sequencers
+type MsgDeregisterRollapp struct {
+	// authority is the bech32-encoded address allowed to deregister rollapps (the gov module account)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rollappId is the rollapp to deregister. It must be frozen
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *MsgDeregisterRollapp) Reset()         { *m = MsgDeregisterRollapp{} }
+func (m *MsgDeregisterRollapp) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterRollapp) ProtoMessage()    {}
+func (*MsgDeregisterRollapp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{14}
+}
+func (m *MsgDeregisterRollapp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterRollapp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterRollapp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDeregisterRollapp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterRollapp.Merge(m, src)
+}
+func (m *MsgDeregisterRollapp) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterRollapp) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterRollapp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterRollapp proto.InternalMessageInfo
+
+func (m *MsgDeregisterRollapp) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	l = len(m.CodeStamp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDeregisterRollapp) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
 	}
-	l = len(m.GenesisPath)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+type MsgDeregisterRollappResponse struct {
+}
+
+func (m *MsgDeregisterRollappResponse) Reset()         { *m = MsgDeregisterRollappResponse{} }
+func (m *MsgDeregisterRollappResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterRollappResponse) ProtoMessage()    {}
+func (*MsgDeregisterRollappResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_935cc363af28220c, []int{15}
+}
+func (m *MsgDeregisterRollappResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterRollappResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterRollappResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.MaxWithholdingBlocks != 0 {
-		n += 1 + sovTx(uint64(m.MaxWithholdingBlocks))
+}
+func (m *MsgDeregisterRollappResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterRollappResponse.Merge(m, src)
+}
+func (m *MsgDeregisterRollappResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterRollappResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterRollappResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeregisterRollappResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollapp")
+	proto.RegisterType((*MsgCreateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgCreateRollappResponse")
+	proto.RegisterType((*MsgUpdateState)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateState")
+	proto.RegisterType((*MsgUpdateStateResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateStateResponse")
+	proto.RegisterType((*MsgSubmitFraud)(nil), "dymensionxyz.dymension.rollapp.MsgSubmitFraud")
+	proto.RegisterType((*MsgSubmitFraudResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSubmitFraudResponse")
+	proto.RegisterType((*MsgSetCanonicalClient)(nil), "dymensionxyz.dymension.rollapp.MsgSetCanonicalClient")
+	proto.RegisterType((*MsgSetCanonicalClientResponse)(nil), "dymensionxyz.dymension.rollapp.MsgSetCanonicalClientResponse")
+	proto.RegisterType((*MsgUpdateRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollapp")
+	proto.RegisterType((*MsgUpdateRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateRollappResponse")
+	proto.RegisterType((*MsgScheduleRollappUpgrade)(nil), "dymensionxyz.dymension.rollapp.MsgScheduleRollappUpgrade")
+	proto.RegisterType((*MsgScheduleRollappUpgradeResponse)(nil), "dymensionxyz.dymension.rollapp.MsgScheduleRollappUpgradeResponse")
+	proto.RegisterType((*MsgFreezeRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgFreezeRollapp")
+	proto.RegisterType((*MsgFreezeRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgFreezeRollappResponse")
+	proto.RegisterType((*MsgDeregisterRollapp)(nil), "dymensionxyz.dymension.rollapp.MsgDeregisterRollapp")
+	proto.RegisterType((*MsgDeregisterRollappResponse)(nil), "dymensionxyz.dymension.rollapp.MsgDeregisterRollappResponse")
+}
+
+func init() { proto.RegisterFile("dymension/rollapp/tx.proto", fileDescriptor_935cc363af28220c) }
+
+var fileDescriptor_935cc363af28220c = []byte{
+	// 919 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x9b, 0x34, 0x6d, 0x5e, 0x28, 0x02, 0xab, 0x5b, 0x19, 0x53, 0xb2, 0x21, 0xec, 0x21,
+	0x17, 0x92, 0xa5, 0x2c, 0xd5, 0x82, 0x40, 0xa2, 0x69, 0xb5, 0xda, 0x08, 0x05, 0x15, 0x97, 0xd5,
+	0x4a, 0x5c, 0xd0, 0xd4, 0xf3, 0xd6, 0xb6, 0x1a, 0x7b, 0xcc, 0xcc, 0x78, 0x95, 0xee, 0x5e, 0x41,
+	0x48, 0xc0, 0x81, 0x13, 0x07, 0xfe, 0xa2, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xed, 0x5f, 0xc1, 0x01,
+	0x09, 0x8d, 0xed, 0x38, 0xf6, 0xc6, 0x6d, 0x93, 0x74, 0x6f, 0x99, 0xf7, 0xf3, 0x9b, 0xef, 0xcd,
+	0xfb, 0xdc, 0x82, 0x49, 0xcf, 0x7c, 0x0c, 0x84, 0xc7, 0x82, 0x1e, 0x67, 0xa3, 0x11, 0x09, 0xc3,
+	0x9e, 0x1c, 0x77, 0x43, 0xce, 0x24, 0xd3, 0x9b, 0x99, 0x6f, 0x7c, 0xf6, 0xac, 0x9b, 0x1d, 0xba,
+	0x69, 0xa0, 0xd9, 0x99, 0xcd, 0x3d, 0x19, 0x31, 0xfb, 0xf4, 0x7b, 0x8a, 0xc2, 0xe6, 0x5e, 0x28,
+	0x19, 0x4f, 0x2a, 0x99, 0x3b, 0x25, 0x91, 0x24, 0x38, 0x4d, 0xbd, 0x5b, 0x0e, 0x73, 0x58, 0xfc,
+	0xb3, 0xa7, 0x7e, 0x25, 0xd6, 0xf6, 0xbf, 0xab, 0xf0, 0xd6, 0x50, 0x38, 0x07, 0x1c, 0x89, 0x44,
+	0x2b, 0xc9, 0xd2, 0x0d, 0x58, 0xb7, 0x95, 0x81, 0x71, 0x43, 0x6b, 0x69, 0x9d, 0xba, 0x35, 0x39,
+	0xea, 0x3b, 0x50, 0x4f, 0x4b, 0x0f, 0xa8, 0xb1, 0x1a, 0xfb, 0xa6, 0x06, 0xbd, 0x05, 0x75, 0x9b,
+	0x51, 0x3c, 0x96, 0xc4, 0x0f, 0x8d, 0x8a, 0xf2, 0xf6, 0x57, 0x0d, 0xcd, 0x9a, 0x1a, 0xf5, 0x3b,
+	0xd0, 0x70, 0x30, 0x40, 0xe1, 0x89, 0x23, 0x22, 0x5d, 0xa3, 0x9a, 0xc5, 0xe4, 0xcd, 0xfa, 0x1e,
+	0x6c, 0xf9, 0x64, 0xfc, 0xd8, 0x93, 0xae, 0xcb, 0x46, 0xd4, 0x0b, 0x9c, 0xbe, 0xba, 0xb0, 0x30,
+	0xd6, 0x5a, 0x5a, 0xa7, 0x1a, 0x87, 0x97, 0xfa, 0xf5, 0x3b, 0xb0, 0xe9, 0x93, 0xf1, 0x31, 0xfe,
+	0x10, 0x61, 0x60, 0x23, 0x17, 0x46, 0x4d, 0x25, 0x58, 0x45, 0xa3, 0x7e, 0x0f, 0x6e, 0x85, 0xc8,
+	0x7d, 0x4f, 0x28, 0xa6, 0x90, 0xee, 0x53, 0xca, 0x51, 0x08, 0x14, 0xc6, 0x7a, 0xab, 0xd2, 0xa9,
+	0x5b, 0xe5, 0x4e, 0xfd, 0x1b, 0xa8, 0xfb, 0x28, 0x09, 0x25, 0x92, 0x08, 0x63, 0xa3, 0x55, 0xe9,
+	0x34, 0x76, 0x3f, 0xec, 0x5e, 0x3d, 0xba, 0xee, 0xb7, 0xec, 0x14, 0x83, 0x61, 0x9a, 0xd5, 0xaf,
+	0xbe, 0xf8, 0xfb, 0xf6, 0x8a, 0x35, 0xad, 0xd2, 0x36, 0xc1, 0x78, 0x95, 0x7a, 0x0b, 0x45, 0xc8,
+	0x02, 0x81, 0xed, 0x1f, 0x57, 0xe1, 0xcd, 0xa1, 0x70, 0x1e, 0x85, 0x94, 0x48, 0xc5, 0x9d, 0xc4,
+	0x1b, 0x4c, 0xa5, 0x21, 0x24, 0xe1, 0xf2, 0x21, 0x7a, 0x8e, 0x2b, 0xe3, 0xb9, 0x54, 0xad, 0xbc,
+	0x49, 0xe5, 0x07, 0x91, 0x9f, 0x92, 0x5c, 0x8d, 0xfd, 0x53, 0x83, 0xbe, 0x0d, 0xb5, 0xc3, 0xfd,
+	0x78, 0x5c, 0x6b, 0x71, 0xe9, 0xf4, 0xa4, 0xf0, 0x3c, 0x45, 0xae, 0x2e, 0x9c, 0xf2, 0x3c, 0x39,
+	0xea, 0x0f, 0xa1, 0xd2, 0x3f, 0x54, 0x7c, 0x6a, 0x9d, 0xc6, 0xee, 0xdd, 0xeb, 0x58, 0x8a, 0xdb,
+	0x1c, 0x66, 0x8f, 0x59, 0xa4, 0x44, 0xa9, 0x12, 0x6d, 0x03, 0xb6, 0x8b, 0x2c, 0x64, 0x04, 0xfd,
+	0xa6, 0xc5, 0x04, 0x1d, 0x47, 0x27, 0xbe, 0x27, 0x1f, 0x70, 0x12, 0x51, 0x75, 0x0d, 0x12, 0x49,
+	0x97, 0x71, 0x4f, 0x9e, 0xa5, 0x14, 0x4d, 0x0d, 0xd7, 0x90, 0xd4, 0x04, 0x10, 0xaa, 0xfe, 0x20,
+	0xa0, 0x38, 0x4e, 0x39, 0xca, 0x59, 0x94, 0xff, 0x89, 0x6a, 0x72, 0xc4, 0x19, 0x7b, 0x92, 0xbc,
+	0x5b, 0x2b, 0x67, 0x49, 0x81, 0xe6, 0xd0, 0x64, 0x40, 0x7f, 0xd5, 0xe0, 0x96, 0x72, 0xa1, 0x3c,
+	0x20, 0x01, 0x0b, 0x3c, 0x9b, 0x8c, 0x0e, 0x46, 0x1e, 0x06, 0x72, 0xe9, 0x81, 0x9a, 0xb0, 0x61,
+	0xc7, 0x15, 0x06, 0x34, 0xd9, 0x32, 0x2b, 0x3b, 0x2b, 0x9c, 0xb6, 0x4b, 0x82, 0x00, 0x47, 0x03,
+	0xaa, 0x66, 0xa9, 0x5e, 0x74, 0xce, 0xd2, 0xbe, 0x0d, 0xef, 0x95, 0x82, 0xc9, 0xe0, 0xfe, 0x97,
+	0x08, 0x42, 0x42, 0xf9, 0x4d, 0x05, 0xe1, 0x33, 0x30, 0x08, 0xa5, 0x47, 0xa5, 0xdb, 0x56, 0x89,
+	0xb1, 0x5d, 0xea, 0xd7, 0xbf, 0x84, 0x77, 0x39, 0xfa, 0xec, 0x29, 0x96, 0xa7, 0x27, 0x57, 0xbb,
+	0x2a, 0x64, 0x56, 0x0e, 0xd6, 0xca, 0xe4, 0xe0, 0x31, 0xbc, 0x41, 0x28, 0x1d, 0x66, 0xbb, 0x5d,
+	0x5b, 0x7e, 0xb7, 0x0b, 0x85, 0x94, 0xce, 0x50, 0x4f, 0x84, 0x91, 0x54, 0xf0, 0x3c, 0x46, 0x07,
+	0x41, 0xba, 0x61, 0xeb, 0x31, 0x8c, 0x72, 0x67, 0x2a, 0x0a, 0x05, 0xfa, 0xb3, 0xd9, 0xfc, 0xa4,
+	0xc1, 0x3b, 0x6a, 0x7a, 0xb6, 0x8b, 0x34, 0x1a, 0x4d, 0xdc, 0x8f, 0x42, 0x87, 0x13, 0xba, 0xbc,
+	0x3e, 0xe4, 0xf6, 0xb8, 0x52, 0xdc, 0xe3, 0x6d, 0xa8, 0xb9, 0x89, 0x68, 0x24, 0xa2, 0x90, 0x9e,
+	0xda, 0x1f, 0xc0, 0xfb, 0x97, 0xc2, 0xc8, 0xc0, 0x7e, 0x1d, 0xbf, 0xa3, 0x07, 0x1c, 0xf1, 0x59,
+	0xf6, 0x8e, 0x6e, 0xb0, 0xa1, 0x29, 0x31, 0x85, 0x7a, 0x59, 0x2f, 0x0b, 0xb6, 0x86, 0xc2, 0x39,
+	0x44, 0x8e, 0x8e, 0x27, 0x24, 0xf2, 0xd7, 0xd1, 0xaf, 0x09, 0x3b, 0x65, 0x35, 0x27, 0x3d, 0x77,
+	0xff, 0xdc, 0x80, 0xca, 0x50, 0x38, 0xfa, 0x73, 0xd8, 0x2c, 0x7e, 0x3d, 0xaf, 0x15, 0xbc, 0x57,
+	0x45, 0xdf, 0xbc, 0xbf, 0x68, 0xc6, 0x04, 0x84, 0x1e, 0x41, 0x23, 0xff, 0x89, 0xe8, 0xce, 0x51,
+	0x28, 0x17, 0x6f, 0xee, 0x2d, 0x16, 0x9f, 0x6f, 0x9b, 0x17, 0xde, 0x79, 0xda, 0xe6, 0xe2, 0xcd,
+	0xbd, 0xc5, 0xe2, 0xb3, 0xb6, 0xbf, 0x68, 0xa0, 0x97, 0xe8, 0xe8, 0x27, 0xf3, 0x94, 0x9b, 0x49,
+	0x33, 0xbf, 0x58, 0x2a, 0x2d, 0x03, 0xf3, 0x1c, 0x36, 0x8b, 0x22, 0x79, 0x77, 0x6e, 0x32, 0x17,
+	0x99, 0x7b, 0xa9, 0x12, 0xe8, 0x7f, 0x68, 0xb0, 0x7d, 0x89, 0x0c, 0x7c, 0x3a, 0xcf, 0xb5, 0x4a,
+	0x53, 0xcd, 0xfd, 0xa5, 0x53, 0xf3, 0xac, 0x14, 0x57, 0x7e, 0x1e, 0x56, 0x0a, 0x19, 0xe6, 0xfd,
+	0x45, 0x33, 0xb2, 0xe6, 0x3f, 0x6b, 0xf0, 0xf6, 0xac, 0x08, 0xdc, 0x9b, 0xa3, 0xde, 0x4c, 0x96,
+	0xf9, 0xf9, 0x32, 0x59, 0x13, 0x24, 0xfd, 0xaf, 0x5e, 0x9c, 0x37, 0xb5, 0x97, 0xe7, 0x4d, 0xed,
+	0x9f, 0xf3, 0xa6, 0xf6, 0xfb, 0x45, 0x73, 0xe5, 0xe5, 0x45, 0x73, 0xe5, 0xaf, 0x8b, 0xe6, 0xca,
+	0x77, 0x1f, 0x39, 0x9e, 0x74, 0xa3, 0x93, 0xae, 0xcd, 0xfc, 0x5e, 0xbe, 0xc3, 0xf4, 0xd0, 0x1b,
+	0x4f, 0xff, 0x49, 0x38, 0x0b, 0x51, 0x9c, 0xd4, 0xe2, 0x3f, 0xd5, 0x3f, 0xfe, 0x7f, 0x00, 0x28,
+	0x1e, 0x83, 0xa9, 0x46, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error)
+	UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error)
+	SubmitFraud(ctx context.Context, in *MsgSubmitFraud, opts ...grpc.CallOption) (*MsgSubmitFraudResponse, error)
+	SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error)
+	UpdateRollapp(ctx context.Context, in *MsgUpdateRollapp, opts ...grpc.CallOption) (*MsgUpdateRollappResponse, error)
+	ScheduleRollappUpgrade(ctx context.Context, in *MsgScheduleRollappUpgrade, opts ...grpc.CallOption) (*MsgScheduleRollappUpgradeResponse, error)
+	FreezeRollapp(ctx context.Context, in *MsgFreezeRollapp, opts ...grpc.CallOption) (*MsgFreezeRollappResponse, error)
+	DeregisterRollapp(ctx context.Context, in *MsgDeregisterRollapp, opts ...grpc.CallOption) (*MsgDeregisterRollappResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateRollapp(ctx context.Context, in *MsgCreateRollapp, opts ...grpc.CallOption) (*MsgCreateRollappResponse, error) {
+	out := new(MsgCreateRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxSequencers != 0 {
-		n += 1 + sovTx(uint64(m.MaxSequencers))
+	return out, nil
+}
+
+func (c *msgClient) UpdateState(ctx context.Context, in *MsgUpdateState, opts ...grpc.CallOption) (*MsgUpdateStateResponse, error) {
+	out := new(MsgUpdateStateResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PermissionedAddresses) > 0 {
-		for _, s := range m.PermissionedAddresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SubmitFraud(ctx context.Context, in *MsgSubmitFraud, opts ...grpc.CallOption) (*MsgSubmitFraudResponse, error) {
+	out := new(MsgSubmitFraudResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SubmitFraud", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Metadatas) > 0 {
-		for _, e := range m.Metadatas {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SetCanonicalClient(ctx context.Context, in *MsgSetCanonicalClient, opts ...grpc.CallOption) (*MsgSetCanonicalClientResponse, error) {
+	out := new(MsgSetCanonicalClientResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/SetCanonicalClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateRollapp(ctx context.Context, in *MsgUpdateRollapp, opts ...grpc.CallOption) (*MsgUpdateRollappResponse, error) {
+	out := new(MsgUpdateRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleRollappUpgrade(ctx context.Context, in *MsgScheduleRollappUpgrade, opts ...grpc.CallOption) (*MsgScheduleRollappUpgradeResponse, error) {
+	out := new(MsgScheduleRollappUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/ScheduleRollappUpgrade", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeRollapp(ctx context.Context, in *MsgFreezeRollapp, opts ...grpc.CallOption) (*MsgFreezeRollappResponse, error) {
+	out := new(MsgFreezeRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/FreezeRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterRollapp(ctx context.Context, in *MsgDeregisterRollapp, opts ...grpc.CallOption) (*MsgDeregisterRollappResponse, error) {
+	out := new(MsgDeregisterRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/DeregisterRollapp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateRollapp(context.Context, *MsgCreateRollapp) (*MsgCreateRollappResponse, error)
+	UpdateState(context.Context, *MsgUpdateState) (*MsgUpdateStateResponse, error)
+	SubmitFraud(context.Context, *MsgSubmitFraud) (*MsgSubmitFraudResponse, error)
+	SetCanonicalClient(context.Context, *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error)
+	UpdateRollapp(context.Context, *MsgUpdateRollapp) (*MsgUpdateRollappResponse, error)
+	ScheduleRollappUpgrade(context.Context, *MsgScheduleRollappUpgrade) (*MsgScheduleRollappUpgradeResponse, error)
+	FreezeRollapp(context.Context, *MsgFreezeRollapp) (*MsgFreezeRollappResponse, error)
+	DeregisterRollapp(context.Context, *MsgDeregisterRollapp) (*MsgDeregisterRollappResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateRollapp(ctx context.Context, req *MsgCreateRollapp) (*MsgCreateRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRollapp not implemented")
+}
+func (*UnimplementedMsgServer) UpdateState(ctx context.Context, req *MsgUpdateState) (*MsgUpdateStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateState not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFraud(ctx context.Context, req *MsgSubmitFraud) (*MsgSubmitFraudResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFraud not implemented")
+}
+func (*UnimplementedMsgServer) SetCanonicalClient(ctx context.Context, req *MsgSetCanonicalClient) (*MsgSetCanonicalClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCanonicalClient not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRollapp(ctx context.Context, req *MsgUpdateRollapp) (*MsgUpdateRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRollapp not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleRollappUpgrade(ctx context.Context, req *MsgScheduleRollappUpgrade) (*MsgScheduleRollappUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRollappUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) FreezeRollapp(ctx context.Context, req *MsgFreezeRollapp) (*MsgFreezeRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeRollapp not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterRollapp(ctx context.Context, req *MsgDeregisterRollapp) (*MsgDeregisterRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterRollapp not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/CreateRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRollapp(ctx, req.(*MsgCreateRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateState(ctx, req.(*MsgUpdateState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFraud_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFraud)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFraud(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SubmitFraud",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFraud(ctx, req.(*MsgSubmitFraud))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCanonicalClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCanonicalClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCanonicalClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/SetCanonicalClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCanonicalClient(ctx, req.(*MsgSetCanonicalClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRollapp(ctx, req.(*MsgUpdateRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleRollappUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleRollappUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleRollappUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/ScheduleRollappUpgrade",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleRollappUpgrade(ctx, req.(*MsgScheduleRollappUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/FreezeRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeRollapp(ctx, req.(*MsgFreezeRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterRollapp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterRollapp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/DeregisterRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterRollapp(ctx, req.(*MsgDeregisterRollapp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRollapp",
+			Handler:    _Msg_CreateRollapp_Handler,
+		},
+		{
+			MethodName: "UpdateState",
+			Handler:    _Msg_UpdateState_Handler,
+		},
+		{
+			MethodName: "SubmitFraud",
+			Handler:    _Msg_SubmitFraud_Handler,
+		},
+		{
+			MethodName: "SetCanonicalClient",
+			Handler:    _Msg_SetCanonicalClient_Handler,
+		},
+		{
+			MethodName: "UpdateRollapp",
+			Handler:    _Msg_UpdateRollapp_Handler,
+		},
+		{
+			MethodName: "ScheduleRollappUpgrade",
+			Handler:    _Msg_ScheduleRollappUpgrade_Handler,
+		},
+		{
+			MethodName: "FreezeRollapp",
+			Handler:    _Msg_FreezeRollapp_Handler,
+		},
+		{
+			MethodName: "DeregisterRollapp",
+			Handler:    _Msg_DeregisterRollapp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymension/rollapp/tx.proto",
+}
+
+func (m *MsgCreateRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PermissionedAddresses) > 0 {
+		for iNdEx := len(m.PermissionedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PermissionedAddresses[iNdEx])
+			copy(dAtA[i:], m.PermissionedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.PermissionedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MaxSequencers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSequencers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxWithholdingBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxWithholdingBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GenesisPath) > 0 {
+		i -= len(m.GenesisPath)
+		copy(dAtA[i:], m.GenesisPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisPath)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CodeStamp) > 0 {
+		i -= len(m.CodeStamp)
+		copy(dAtA[i:], m.CodeStamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeStamp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BDs.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DAPath) > 0 {
+		i -= len(m.DAPath)
+		copy(dAtA[i:], m.DAPath)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DAPath)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraud) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraud) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraud) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FraudProof) > 0 {
+		i -= len(m.FraudProof)
+		copy(dAtA[i:], m.FraudProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FraudProof)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StateIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StateIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFraudResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFraudResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFraudResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCanonicalClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelIds) > 0 {
+		for iNdEx := len(m.ChannelIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChannelIds[iNdEx])
+			copy(dAtA[i:], m.ChannelIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCanonicalClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCanonicalClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCanonicalClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AddMetadatas) > 0 {
+		for iNdEx := len(m.AddMetadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddMetadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxSequencers != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxSequencers))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RemovePermissionedAddresses) > 0 {
+		for iNdEx := len(m.RemovePermissionedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovePermissionedAddresses[iNdEx])
+			copy(dAtA[i:], m.RemovePermissionedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.RemovePermissionedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddPermissionedAddresses) > 0 {
+		for iNdEx := len(m.AddPermissionedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AddPermissionedAddresses[iNdEx])
+			copy(dAtA[i:], m.AddPermissionedAddresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AddPermissionedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRollappUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRollappUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRollappUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleRollappUpgradeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleRollappUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleRollappUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterRollapp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterRollapp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeStamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxWithholdingBlocks != 0 {
+		n += 1 + sovTx(uint64(m.MaxWithholdingBlocks))
+	}
+	if m.MaxSequencers != 0 {
+		n += 1 + sovTx(uint64(m.MaxSequencers))
+	}
+	if len(m.PermissionedAddresses) > 0 {
+		for _, s := range m.PermissionedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovTx(uint64(m.NumBlocks))
+	}
+	l = len(m.DAPath)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	l = m.BDs.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFraud) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StateIndex != 0 {
+		n += 1 + sovTx(uint64(m.StateIndex))
+	}
+	l = len(m.FraudProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFraudResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCanonicalClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChannelIds) > 0 {
+		for _, s := range m.ChannelIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetCanonicalClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AddPermissionedAddresses) > 0 {
+		for _, s := range m.AddPermissionedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemovePermissionedAddresses) > 0 {
+		for _, s := range m.RemovePermissionedAddresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxSequencers != 0 {
+		n += 1 + sovTx(uint64(m.MaxSequencers))
+	}
+	if len(m.AddMetadatas) > 0 {
+		for _, e := range m.AddMetadatas {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DisputePeriodInBlocks))
+	}
+	return n
+}
+
+func (m *MsgUpdateRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleRollappUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgScheduleRollappUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterRollapp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeregisterRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeStamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeStamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithholdingBlocks", wireType)
+			}
+			m.MaxWithholdingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithholdingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSequencers", wireType)
+			}
+			m.MaxSequencers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSequencers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PermissionedAddresses = append(m.PermissionedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, TokenMetadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DAPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraud) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraud: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraud: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateIndex", wireType)
+			}
+			m.StateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FraudProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFraudResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFraudResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFraudResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCanonicalClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelIds = append(m.ChannelIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
-	}
-	if m.NumBlocks != 0 {
-		n += 1 + sovTx(uint64(m.NumBlocks))
-	}
-	l = len(m.DAPath)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BDs.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgUpdateStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetCanonicalClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCanonicalClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRollapp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,10 +3431,10 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollapp: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRollapp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -887,7 +3503,7 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeStamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddPermissionedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -915,11 +3531,11 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeStamp = string(dAtA[iNdEx:postIndex])
+			m.AddPermissionedAddresses = append(m.AddPermissionedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovePermissionedAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -947,13 +3563,185 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisPath = string(dAtA[iNdEx:postIndex])
+			m.RemovePermissionedAddresses = append(m.RemovePermissionedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithholdingBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSequencers", wireType)
+			}
+			m.MaxSequencers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSequencers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddMetadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddMetadatas = append(m.AddMetadatas, TokenMetadata{})
+			if err := m.AddMetadatas[len(m.AddMetadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleRollappUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleRollappUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleRollappUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			m.MaxWithholdingBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -963,33 +3751,27 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWithholdingBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSequencers", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.MaxSequencers = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxSequencers |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermissionedAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1017,13 +3799,13 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PermissionedAddresses = append(m.PermissionedAddresses, string(dAtA[iNdEx:postIndex]))
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1033,26 +3815,30 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.Metadatas = append(m.Metadatas, TokenMetadata{})
-			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1074,7 +3860,7 @@ func (m *MsgCreateRollapp) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleRollappUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1097,10 +3883,10 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleRollappUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleRollappUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1124,7 +3910,7 @@ func (m *MsgCreateRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
+func (m *MsgFreezeRollapp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,15 +3933,15 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFreezeRollapp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFreezeRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,7 +3969,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1217,47 +4003,109 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NumBlocks = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumBlocks |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterRollapp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterRollapp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterRollapp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DAPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1285,32 +4133,13 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DAPath = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1320,24 +4149,23 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BDs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1360,7 +4188,7 @@ func (m *MsgUpdateState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterRollappResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1383,10 +4211,10 @@ func (m *MsgUpdateStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterRollappResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
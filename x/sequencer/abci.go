@@ -0,0 +1,14 @@
+package sequencer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/x/sequencer/keeper"
+)
+
+// EndBlocker is called every block to complete the unbonding of sequencers whose unbonding time has passed
+// and to deactivate idle proposers.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.UnbondAllMatureSequencers(ctx)
+	k.HandleIdleProposers(ctx)
+}
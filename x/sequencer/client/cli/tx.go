@@ -26,6 +26,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdCreateSequencer())
+	cmd.AddCommand(CmdUnbond())
+	cmd.AddCommand(CmdRotateProposer())
+	cmd.AddCommand(CmdLeaveProposer())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -12,10 +12,16 @@ import (
 	"github.com/spf13/cobra"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	// FlagBond is the flag of the tokens bonded by the sequencer
+	FlagBond = "bond"
+)
+
 func CmdCreateSequencer() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-sequencer [pubkey] [rollapp-id] [description]",
@@ -40,11 +46,24 @@ func CmdCreateSequencer() *cobra.Command {
 				return err
 			}
 
+			var bond sdk.Coin
+			bondStr, err := cmd.Flags().GetString(FlagBond)
+			if err != nil {
+				return err
+			}
+			if bondStr != "" {
+				bond, err = sdk.ParseCoinNormalized(bondStr)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg, err := types.NewMsgCreateSequencer(
 				clientCtx.GetFromAddress().String(),
 				pk,
 				argRollappId,
 				argDescription,
+				bond,
 			)
 			if err != nil {
 				return err
@@ -54,6 +73,7 @@ func CmdCreateSequencer() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagBond, "", "The tokens to bond, must cover the minimum bond (e.g. 1000000udym)")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
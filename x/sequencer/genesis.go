@@ -12,10 +12,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// Set all the sequencer
 	for _, elem := range genState.SequencerList {
 		k.SetSequencer(ctx, elem)
+		// rebuild the unbonding queue
+		if elem.Status == types.Unbonding {
+			k.SetUnbondingQueue(ctx, elem.UnbondTime, elem.SequencerAddress)
+		}
 	}
 	// Set all the sequencersByRollapp
 	for _, elem := range genState.SequencersByRollappList {
 		k.SetSequencersByRollapp(ctx, elem)
+		// rebuild the liveness queue
+		if elem.Proposer != "" {
+			k.SetLivenessQueue(ctx, uint64(elem.LastUpdateHeight)+genState.Params.LivenessPeriodInBlocks, elem.RollappId)
+		}
 	}
 	// Set all the scheduler
 	for _, elem := range genState.SchedulerList {
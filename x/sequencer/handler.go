@@ -20,6 +20,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgCreateSequencer:
 			res, err := msgServer.CreateSequencer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnbond:
+			res, err := msgServer.Unbond(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRotateProposer:
+			res, err := msgServer.RotateProposer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgLeaveProposer:
+			res, err := msgServer.LeaveProposer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -53,5 +53,67 @@ func (hook rollapphook) BeforeUpdateState(ctx sdk.Context, seqAddr string, rolla
 	if scheduler.Status != types.Proposer {
 		return types.ErrNotActiveSequencer
 	}
+
+	// a sequencer may propose one rollapp while being inactive in another,
+	// so check it is the current proposer of this rollapp
+	sequencersByRollapp, found := hook.k.GetSequencersByRollapp(ctx, rollappId)
+	if found && sequencersByRollapp.Proposer != seqAddr {
+		return types.ErrNotActiveSequencer
+	}
+
+	// record the proposer activity for liveness tracking
+	if found {
+		sequencersByRollapp.LastUpdateHeight = ctx.BlockHeight()
+		hook.k.SetSequencersByRollapp(ctx, sequencersByRollapp)
+	}
+	return nil
+}
+
+// AfterSequencerPermissionRevoked hands over the rollapp to the next sequencer if its proposer lost its permission.
+// If no sequencer can take over, the rollapp is left without a proposer until one is elected.
+// The sequencer stays registered and bonded.
+func (hook rollapphook) AfterSequencerPermissionRevoked(ctx sdk.Context, rollappId string, seqAddr string) error {
+	sequencersByRollapp, found := hook.k.GetSequencersByRollapp(ctx, rollappId)
+	if !found || sequencersByRollapp.Proposer != seqAddr {
+		return nil
+	}
+	hook.k.rotateOrClearProposer(ctx, rollappId)
+	return nil
+}
+
+// AfterRollappDeregistered releases the sequencers of a deregistered rollapp.
+// Sequencers left without rollapps start unbonding, the others stay bonded for their other rollapps.
+func (hook rollapphook) AfterRollappDeregistered(ctx sdk.Context, rollappId string) error {
+	sequencersByRollapp, found := hook.k.GetSequencersByRollapp(ctx, rollappId)
+	if !found {
+		return nil
+	}
+	hook.k.RemoveSequencersByRollapp(ctx, rollappId)
+
+	for _, seqAddr := range sequencersByRollapp.Sequencers.Addresses {
+		sequencer, found := hook.k.GetSequencer(ctx, seqAddr)
+		if !found {
+			continue
+		}
+		rollappIDs := make([]string, 0, len(sequencer.RollappIDs))
+		for _, id := range sequencer.RollappIDs {
+			if id != rollappId {
+				rollappIDs = append(rollappIDs, id)
+			}
+		}
+		sequencer.RollappIDs = rollappIDs
+		hook.k.SetSequencer(ctx, sequencer)
+
+		if len(sequencer.RollappIDs) == 0 && sequencer.Status == types.Bonded {
+			hook.k.startUnbonding(ctx, sequencer)
+			continue
+		}
+		if sequencersByRollapp.Proposer == seqAddr && !hook.k.isProposerOfOtherRollapp(ctx, seqAddr, rollappId) {
+			hook.k.SetScheduler(ctx, types.Scheduler{
+				SequencerAddress: seqAddr,
+				Status:           types.Inactive,
+			})
+		}
+	}
 	return nil
 }
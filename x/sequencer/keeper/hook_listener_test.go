@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+func (suite *SequencerTestSuite) TestDeregisterRollappReleasesSequencers() {
+	suite.SetupTest()
+	minBond := suite.setBondParams(time.Hour)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	for _, rollappId := range []string{"rollapp1", "rollapp2"} {
+		suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+			RollappId:     rollappId,
+			Creator:       alice,
+			MaxSequencers: 10,
+		})
+	}
+
+	proposer, err := suite.createBondedSequencer("rollapp1", minBond)
+	suite.Require().Nil(err)
+	otherProposer, err := suite.createBondedSequencer("rollapp2", minBond)
+	suite.Require().Nil(err)
+
+	rollappMsgServer := rollappkeeper.NewMsgServerImpl(suite.app.RollappKeeper)
+	authority := suite.app.RollappKeeper.GetAuthority()
+	_, err = rollappMsgServer.FreezeRollapp(goCtx, rollapptypes.NewMsgFreezeRollapp(authority, "rollapp1"))
+	suite.Require().Nil(err)
+
+	// no sequencer can join a frozen rollapp
+	_, err = suite.createBondedSequencer("rollapp1", minBond)
+	suite.Require().ErrorIs(err, types.ErrRollappFrozen)
+
+	_, err = rollappMsgServer.DeregisterRollapp(goCtx, rollapptypes.NewMsgDeregisterRollapp(authority, "rollapp1"))
+	suite.Require().Nil(err)
+
+	// the sequencer left without a rollapp starts unbonding
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, proposer)
+	suite.Require().Empty(sequencer.RollappIDs)
+	suite.Require().Equal(types.Unbonding, sequencer.Status)
+	scheduler, _ := suite.app.SequencerKeeper.GetScheduler(suite.ctx, proposer)
+	suite.Require().Equal(types.Inactive, scheduler.Status)
+	_, found := suite.app.SequencerKeeper.GetSequencersByRollapp(suite.ctx, "rollapp1")
+	suite.Require().False(found)
+
+	// the sequencers of the other rollapps are untouched
+	sequencer, _ = suite.app.SequencerKeeper.GetSequencer(suite.ctx, otherProposer)
+	suite.Require().Equal(types.Bonded, sequencer.Status)
+	suite.assertProposer("rollapp2", otherProposer, []string{otherProposer})
+}
+
+func (suite *SequencerTestSuite) TestRevokeProposerPermissionNoCandidate() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 1)
+	other := sample.AccAddress()
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	rollapp.PermissionedAddresses = []string{sequencers[0], other}
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+
+	rollappMsgServer := rollappkeeper.NewMsgServerImpl(suite.app.RollappKeeper)
+	_, err := rollappMsgServer.UpdateRollapp(goCtx, rollapptypes.NewMsgUpdateRollapp(alice, "rollapp1", nil, []string{sequencers[0]}, 0, nil, 0))
+	suite.Require().Nil(err)
+
+	// no one can take over, the revoked sequencer doesn't stay the proposer
+	suite.assertProposer("rollapp1", "", sequencers)
+	_, err = suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().ErrorIs(err, types.ErrNoProposerCandidate)
+}
+
+func (suite *SequencerTestSuite) TestGrantFirstPermissionsRevokesOthers() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 3)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+
+	// the permissionless rollapp becomes permissioned without its proposer
+	rollappMsgServer := rollappkeeper.NewMsgServerImpl(suite.app.RollappKeeper)
+	_, err := rollappMsgServer.UpdateRollapp(goCtx, rollapptypes.NewMsgUpdateRollapp(alice, "rollapp1", []string{sequencers[2]}, nil, 0, nil, 0))
+	suite.Require().Nil(err)
+
+	// the permissioned sequencer takes over
+	suite.assertProposer("rollapp1", sequencers[2], sequencers)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// SetLivenessQueue schedules a liveness check of the rollapp at the given hub height.
+// A rollapp has a single scheduled check, which replaces the previous one if any
+func (k Keeper) SetLivenessQueue(ctx sdk.Context, height uint64, rollappId string) {
+	k.removeLivenessCheck(ctx, rollappId)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	store.Set(types.LivenessQueueKey(height, rollappId), []byte(rollappId))
+	checkStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessCheckKeyPrefix))
+	checkStore.Set(types.LivenessCheckKey(rollappId), sdk.Uint64ToBigEndian(height))
+}
+
+// RemoveLivenessQueue removes a liveness check of the rollapp
+func (k Keeper) RemoveLivenessQueue(ctx sdk.Context, height uint64, rollappId string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	store.Delete(types.LivenessQueueKey(height, rollappId))
+
+	checkStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessCheckKeyPrefix))
+	if checkHeight, found := k.getLivenessCheckHeight(ctx, rollappId); found && checkHeight == height {
+		checkStore.Delete(types.LivenessCheckKey(rollappId))
+	}
+}
+
+// getLivenessCheckHeight returns the hub height of the scheduled liveness check of the rollapp
+func (k Keeper) getLivenessCheckHeight(ctx sdk.Context, rollappId string) (uint64, bool) {
+	checkStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessCheckKeyPrefix))
+	b := checkStore.Get(types.LivenessCheckKey(rollappId))
+	if b == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(b), true
+}
+
+// removeLivenessCheck removes the scheduled liveness check of the rollapp, if any
+func (k Keeper) removeLivenessCheck(ctx sdk.Context, rollappId string) {
+	if height, found := k.getLivenessCheckHeight(ctx, rollappId); found {
+		k.RemoveLivenessQueue(ctx, height, rollappId)
+	}
+}
+
+// setProposerElected resets the liveness tracking of the rollapp when a new proposer is elected.
+// The check scheduled for the previous proposer is replaced
+func (k Keeper) setProposerElected(ctx sdk.Context, sequencersByRollapp *types.SequencersByRollapp) {
+	sequencersByRollapp.LastUpdateHeight = ctx.BlockHeight()
+	k.SetLivenessQueue(ctx, uint64(ctx.BlockHeight())+k.LivenessPeriodInBlocks(ctx), sequencersByRollapp.RollappId)
+}
+
+// HandleIdleProposers sets inactive every proposer that did not update its rollapp state
+// for LivenessPeriodInBlocks, and hands over its rollapp to the next sequencer.
+// Liveness checks are rescheduled lazily: when a check is due and the proposer updated
+// the state in the meantime, the next check is scheduled relatively to its last update.
+func (k Keeper) HandleIdleProposers(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(types.LivenessQueueHeightKey(uint64(ctx.BlockHeight()))))
+
+	type livenessCheck struct {
+		height    uint64
+		rollappId string
+	}
+	var dueChecks []livenessCheck
+	for ; iterator.Valid(); iterator.Next() {
+		dueChecks = append(dueChecks, livenessCheck{
+			height:    binary.BigEndian.Uint64(iterator.Key()[:8]),
+			rollappId: string(iterator.Value()),
+		})
+	}
+	iterator.Close() // nolint: errcheck
+
+	livenessPeriodInBlocks := k.LivenessPeriodInBlocks(ctx)
+	for _, check := range dueChecks {
+		k.RemoveLivenessQueue(ctx, check.height, check.rollappId)
+
+		sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, check.rollappId)
+		if !found || sequencersByRollapp.Proposer == "" {
+			continue
+		}
+		// the proposer of a frozen rollapp can not update its state
+		if rollapp, found := k.rollappKeeper.GetRollapp(ctx, check.rollappId); found && rollapp.Frozen {
+			continue
+		}
+		proposer := sequencersByRollapp.Proposer
+		scheduler, found := k.GetScheduler(ctx, proposer)
+		if !found || scheduler.Status != types.Proposer {
+			// there is no active proposer to track
+			continue
+		}
+
+		deadline := sequencersByRollapp.LastUpdateHeight + int64(livenessPeriodInBlocks)
+		if ctx.BlockHeight() < deadline {
+			k.SetLivenessQueue(ctx, uint64(deadline), check.rollappId)
+			continue
+		}
+
+		k.Logger(ctx).Info("proposer is idle", "rollappId", check.rollappId, "proposer", proposer)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeProposerInactive,
+				sdk.NewAttribute(types.AttributeKeyRollappId, check.rollappId),
+				sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+				sdk.NewAttribute(types.AttributeKeyLastUpdateHeight, strconv.FormatInt(sequencersByRollapp.LastUpdateHeight, 10)),
+			),
+		)
+
+		k.rotateOrClearProposer(ctx, check.rollappId)
+	}
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+func (suite *SequencerTestSuite) setLivenessPeriod(livenessPeriodInBlocks uint64) {
+	params := suite.app.SequencerKeeper.GetParams(suite.ctx)
+	params.LivenessPeriodInBlocks = livenessPeriodInBlocks
+	suite.app.SequencerKeeper.SetParams(suite.ctx, params)
+}
+
+func (suite *SequencerTestSuite) endBlockAt(height int64) {
+	suite.ctx = suite.ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	suite.app.SequencerKeeper.HandleIdleProposers(suite.ctx)
+}
+
+// livenessChecks returns the hub heights of the liveness checks scheduled for the rollapp
+func (suite *SequencerTestSuite) livenessChecks(rollappId string) []uint64 {
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+
+	var heights []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if string(iterator.Value()) == rollappId {
+			heights = append(heights, binary.BigEndian.Uint64(iterator.Key()[:8]))
+		}
+	}
+	return heights
+}
+
+func (suite *SequencerTestSuite) TestHandleIdleProposers() {
+	suite.SetupTest()
+	suite.setLivenessPeriod(5)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 2)
+
+	// the proposer updates the state in time
+	suite.endBlockAt(4)
+	suite.Require().Nil(suite.app.SequencerKeeper.RollappHooks().BeforeUpdateState(suite.ctx, sequencers[0], "rollapp1"))
+	suite.endBlockAt(6)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+	suite.endBlockAt(8)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+
+	// the proposer is idle for 5 blocks since its last update at height 4
+	suite.endBlockAt(9)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+
+	eventTypes := make(map[string]bool)
+	for _, event := range suite.ctx.EventManager().Events() {
+		eventTypes[event.Type] = true
+	}
+	suite.Require().True(eventTypes[types.EventTypeProposerInactive])
+	suite.Require().True(eventTypes[types.EventTypeProposerRotation])
+
+	// the new proposer gets a full liveness period from its election
+	suite.endBlockAt(13)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+	suite.endBlockAt(14)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+}
+
+func (suite *SequencerTestSuite) TestHandleIdleProposersNoCandidate() {
+	suite.SetupTest()
+	suite.setLivenessPeriod(5)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 1)
+
+	suite.endBlockAt(6)
+
+	// the idle proposer is deactivated although no one can take over
+	scheduler, _ := suite.app.SequencerKeeper.GetScheduler(suite.ctx, sequencers[0])
+	suite.Require().Equal(types.Inactive, scheduler.Status)
+	err := suite.app.SequencerKeeper.RollappHooks().BeforeUpdateState(suite.ctx, sequencers[0], "rollapp1")
+	suite.Require().ErrorIs(err, types.ErrNotActiveSequencer)
+}
+
+func (suite *SequencerTestSuite) TestRotationReplacesLivenessCheck() {
+	suite.SetupTest()
+	suite.setLivenessPeriod(5)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 2)
+	suite.Require().Equal([]uint64{6}, suite.livenessChecks("rollapp1"))
+
+	// the check of the previous proposer is replaced by the one of the new proposer
+	suite.ctx = suite.ctx.WithBlockHeight(3)
+	_, err := suite.app.SequencerKeeper.RotateProposer(suite.ctx, "rollapp1")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{8}, suite.livenessChecks("rollapp1"))
+
+	// a rescheduled check is replaced as well
+	suite.Require().Nil(suite.app.SequencerKeeper.RollappHooks().BeforeUpdateState(suite.ctx.WithBlockHeight(7), sequencers[1], "rollapp1"))
+	suite.endBlockAt(8)
+	suite.Require().Equal([]uint64{12}, suite.livenessChecks("rollapp1"))
+
+	// the rollapp left without a proposer has no check
+	_, err = suite.msgServer.Unbond(sdk.WrapSDKContext(suite.ctx), &types.MsgUnbond{Creator: sequencers[0]})
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Unbond(sdk.WrapSDKContext(suite.ctx), &types.MsgUnbond{Creator: sequencers[1]})
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.livenessChecks("rollapp1"))
+}
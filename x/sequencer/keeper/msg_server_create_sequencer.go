@@ -23,6 +23,10 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 	if !found {
 		return nil, types.ErrUnknownRollappID
 	}
+	// a frozen rollapp does not take new sequencers
+	if rollapp.Frozen {
+		return nil, types.ErrRollappFrozen
+	}
 	// check if there are permissionedAddresses.
 	// if the list is not empty, it means that only premissioned sequencers can be added
 	permissionedAddresses := rollapp.PermissionedAddresses
@@ -42,6 +46,16 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		}
 	}
 
+	// validate the bond against the minimum bond
+	minBond := k.MinBond(ctx)
+	bond := msg.Bond
+	if bond.IsNil() {
+		bond = sdk.NewCoin(minBond.Denom, sdk.ZeroInt())
+	}
+	if bond.Denom != minBond.Denom {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientBond, "bond denom %s does not match %s", bond.Denom, minBond.Denom)
+	}
+
 	// check to see if the sequencer has been registered before
 	sequencer, found := k.GetSequencer(ctx, msg.Creator)
 	if !found {
@@ -50,9 +64,8 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 			DymintPubKey:     msg.DymintPubKey,
 			Description:      msg.Description,
 			RollappIDs:       []string{msg.RollappId},
+			Status:           types.Bonded,
 		}
-
-		k.SetSequencer(ctx, sequencer)
 	} else {
 		//validate same data of the sequencer
 		if !bytes.Equal(sequencer.DymintPubKey.GetValue(), msg.DymintPubKey.GetValue()) {
@@ -66,11 +79,30 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 				return nil, types.ErrSequencerAlreadyRegistered
 			}
 		}
+		// an unbonding or unbonded sequencer can not serve new rollapps
+		if sequencer.Status != types.Bonded {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidBondStatus, "sequencer status: %s", sequencer.Status)
+		}
 		// add rollappId to sequencer
 		sequencer.RollappIDs = append(sequencer.RollappIDs, msg.RollappId)
-		k.SetSequencer(ctx, sequencer)
 	}
 
+	// escrow the bond in the module account
+	if sequencer.Tokens.AmountOf(minBond.Denom).Add(bond.Amount).LT(minBond.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientBond, "bond %s is lower than the minimum %s", sequencer.Tokens.Add(bond), minBond)
+	}
+	if bond.IsPositive() {
+		creator, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(bond)); err != nil {
+			return nil, err
+		}
+		sequencer.Tokens = sequencer.Tokens.Add(bond)
+	}
+	k.SetSequencer(ctx, sequencer)
+
 	// update sequencers list
 	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, msg.RollappId)
 	if found {
@@ -86,16 +118,29 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 		}
 		// add sequencer to list
 		sequencersByRollapp.Sequencers.Addresses = append(sequencersByRollapp.Sequencers.Addresses, sequencer.SequencerAddress)
-		// it's not the first sequencer, make it INACTIVE
-		scheduler := types.Scheduler{
-			SequencerAddress: msg.Creator,
-			Status:           types.Inactive,
+		if sequencersByRollapp.Proposer == "" {
+			// the rollapp was left without a proposer, make it the PROPOSER
+			sequencersByRollapp.Proposer = msg.Creator
+			k.setProposerElected(ctx, &sequencersByRollapp)
+			k.SetScheduler(ctx, types.Scheduler{
+				SequencerAddress: msg.Creator,
+				Status:           types.Proposer,
+			})
+		} else if _, found := k.GetScheduler(ctx, msg.Creator); !found {
+			// it's not the first sequencer, make it INACTIVE.
+			// the scheduler is shared by the sequencer's rollapps, don't override
+			// the status of a sequencer proposing another rollapp
+			k.SetScheduler(ctx, types.Scheduler{
+				SequencerAddress: msg.Creator,
+				Status:           types.Inactive,
+			})
 		}
-		k.SetScheduler(ctx, scheduler)
 	} else {
 		// this is the first sequencer, make it a PROPOSER
 		sequencersByRollapp.RollappId = msg.RollappId
 		sequencersByRollapp.Sequencers.Addresses = append(sequencersByRollapp.Sequencers.Addresses, msg.Creator)
+		sequencersByRollapp.Proposer = msg.Creator
+		k.setProposerElected(ctx, &sequencersByRollapp)
 		scheduler := types.Scheduler{
 			SequencerAddress: msg.Creator,
 			Status:           types.Proposer,
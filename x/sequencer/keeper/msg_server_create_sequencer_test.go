@@ -90,6 +90,7 @@ func (suite *SequencerTestSuite) TestCreateSequencer() {
 				DymintPubKey:     sequencerMsg.GetDymintPubKey(),
 				RollappIDs:       []string{rollappId},
 				Description:      sequencerMsg.GetDescription(),
+				Status:           types.Bonded,
 			}
 			// create sequencer
 			createResponse, err := suite.msgServer.CreateSequencer(goCtx, &sequencerMsg)
@@ -229,6 +230,7 @@ func (suite *SequencerTestSuite) TestCreatePermissionedSequencer() {
 		DymintPubKey:     sequencerMsg.GetDymintPubKey(),
 		RollappIDs:       []string{rollappId},
 		Description:      sequencerMsg.GetDescription(),
+		Status:           types.Bonded,
 	}
 	equalSequencer(suite, &sequencerExpect, &queryResponse.SequencerInfo.Sequencer)
 }
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// LeaveProposer defines a method for the proposer of a rollapp to hand over its role to the next sequencer
+func (k msgServer) LeaveProposer(goCtx context.Context, msg *types.MsgLeaveProposer) (*types.MsgLeaveProposerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.rollappKeeper.GetRollapp(ctx, msg.RollappId); !found {
+		return nil, types.ErrUnknownRollappID
+	}
+	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, msg.RollappId)
+	if !found || sequencersByRollapp.Proposer != msg.Creator {
+		return nil, types.ErrNotActiveSequencer
+	}
+
+	proposer, err := k.Keeper.RotateProposer(ctx, msg.RollappId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLeaveProposerResponse{
+		Proposer: proposer,
+	}, nil
+}
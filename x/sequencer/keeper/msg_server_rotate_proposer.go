@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// RotateProposer defines a method for the rollapp creator to replace the proposer of the rollapp
+func (k msgServer) RotateProposer(goCtx context.Context, msg *types.MsgRotateProposer) (*types.MsgRotateProposerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, found := k.rollappKeeper.GetRollapp(ctx, msg.RollappId)
+	if !found {
+		return nil, types.ErrUnknownRollappID
+	}
+	if rollapp.Creator != msg.Creator {
+		return nil, types.ErrNotRollappCreator
+	}
+
+	proposer, err := k.Keeper.RotateProposer(ctx, msg.RollappId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateProposerResponse{
+		Proposer: proposer,
+	}, nil
+}
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// createRollappWithSequencers registers a rollapp created by alice with numOfSequencers sequencers,
+// the first of them being the proposer
+func (suite *SequencerTestSuite) createRollappWithSequencers(rollappId string, numOfSequencers int) []string {
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+		RollappId:     rollappId,
+		Creator:       alice,
+		MaxSequencers: uint64(numOfSequencers),
+	})
+
+	var addresses []string
+	for i := 0; i < numOfSequencers; i++ {
+		pubkey := secp256k1.GenPrivKey().PubKey()
+		addr := sdk.AccAddress(pubkey.Address())
+		pkAny, err := codectypes.NewAnyWithValue(pubkey)
+		suite.Require().Nil(err)
+		_, err = suite.msgServer.CreateSequencer(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSequencer{
+			Creator:      addr.String(),
+			DymintPubKey: pkAny,
+			RollappId:    rollappId,
+			Description:  types.Description{},
+		})
+		suite.Require().Nil(err)
+		addresses = append(addresses, addr.String())
+	}
+	return addresses
+}
+
+func (suite *SequencerTestSuite) assertProposer(rollappId string, proposer string, sequencers []string) {
+	sequencersByRollapp, found := suite.app.SequencerKeeper.GetSequencersByRollapp(suite.ctx, rollappId)
+	suite.Require().True(found)
+	suite.Require().Equal(proposer, sequencersByRollapp.Proposer)
+
+	hooks := suite.app.SequencerKeeper.RollappHooks()
+	for _, seqAddr := range sequencers {
+		scheduler, _ := suite.app.SequencerKeeper.GetScheduler(suite.ctx, seqAddr)
+		// don't persist the side effects of the hook
+		cacheCtx, _ := suite.ctx.CacheContext()
+		err := hooks.BeforeUpdateState(cacheCtx, seqAddr, rollappId)
+		if seqAddr == proposer {
+			suite.Require().Equal(types.Proposer, scheduler.Status)
+			suite.Require().Nil(err)
+		} else {
+			suite.Require().Equal(types.Inactive, scheduler.Status)
+			suite.Require().ErrorIs(err, types.ErrNotActiveSequencer)
+		}
+	}
+}
+
+func (suite *SequencerTestSuite) TestLeaveProposer() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 3)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+
+	// only the proposer can leave
+	_, err := suite.msgServer.LeaveProposer(goCtx, &types.MsgLeaveProposer{Creator: sequencers[1], RollappId: "rollapp1"})
+	suite.Require().ErrorIs(err, types.ErrNotActiveSequencer)
+	_, err = suite.msgServer.LeaveProposer(goCtx, &types.MsgLeaveProposer{Creator: sequencers[0], RollappId: "unknown"})
+	suite.Require().ErrorIs(err, types.ErrUnknownRollappID)
+
+	res, err := suite.msgServer.LeaveProposer(goCtx, &types.MsgLeaveProposer{Creator: sequencers[0], RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[1], res.Proposer)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+
+	// the new proposer is announced
+	events := suite.ctx.EventManager().Events()
+	event := events[len(events)-1]
+	suite.Require().Equal(types.EventTypeProposerRotation, event.Type)
+	attrs := make(map[string]string)
+	for _, attr := range event.Attributes {
+		attrs[string(attr.Key)] = string(attr.Value)
+	}
+	suite.Require().Equal("rollapp1", attrs[types.AttributeKeyRollappId])
+	suite.Require().Equal(sequencers[0], attrs[types.AttributeKeyPrevProposer])
+	suite.Require().Equal(sequencers[1], attrs[types.AttributeKeyProposer])
+	suite.Require().Equal("1", attrs[types.AttributeKeyNextStartHeight])
+}
+
+func (suite *SequencerTestSuite) TestRotateProposer() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 3)
+
+	// only the rollapp creator can rotate
+	_, err := suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: bob, RollappId: "rollapp1"})
+	suite.Require().ErrorIs(err, types.ErrNotRollappCreator)
+
+	res, err := suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[1], res.Proposer)
+
+	res, err = suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[2], res.Proposer)
+
+	// the election wraps around the sequencers list
+	res, err = suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[0], res.Proposer)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+
+	// unbonded sequencers are not elected
+	_, err = suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: sequencers[1]})
+	suite.Require().Nil(err)
+	res, err = suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[2], res.Proposer)
+}
+
+func (suite *SequencerTestSuite) TestRotateProposerNoCandidate() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 1)
+
+	_, err := suite.msgServer.LeaveProposer(goCtx, &types.MsgLeaveProposer{Creator: sequencers[0], RollappId: "rollapp1"})
+	suite.Require().ErrorIs(err, types.ErrNoProposerCandidate)
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+}
+
+func (suite *SequencerTestSuite) TestUnbondProposer() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 2)
+
+	// the proposer hands over the rollapp when unbonding
+	_, err := suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: sequencers[0]})
+	suite.Require().Nil(err)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+}
+
+func (suite *SequencerTestSuite) TestRevokeProposerPermission() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 3)
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	rollapp.PermissionedAddresses = sequencers
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+	rollappMsgServer := rollappkeeper.NewMsgServerImpl(suite.app.RollappKeeper)
+
+	// the proposer losing its permission hands over the rollapp, but stays bonded
+	_, err := rollappMsgServer.UpdateRollapp(goCtx, rollapptypes.NewMsgUpdateRollapp(alice, "rollapp1", nil, sequencers[:1], 0, nil, 0))
+	suite.Require().Nil(err)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, sequencers[0])
+	suite.Require().Equal(types.Bonded, sequencer.Status)
+
+	// unpermissioned sequencers are not elected
+	_, err = rollappMsgServer.UpdateRollapp(goCtx, rollapptypes.NewMsgUpdateRollapp(alice, "rollapp1", nil, sequencers[2:], 0, nil, 0))
+	suite.Require().Nil(err)
+	suite.assertProposer("rollapp1", sequencers[1], sequencers)
+	_, err = suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().ErrorIs(err, types.ErrNoProposerCandidate)
+
+	// permissioned again, the sequencer can be elected
+	_, err = rollappMsgServer.UpdateRollapp(goCtx, rollapptypes.NewMsgUpdateRollapp(alice, "rollapp1", sequencers[2:], nil, 0, nil, 0))
+	suite.Require().Nil(err)
+	res, err := suite.msgServer.RotateProposer(goCtx, &types.MsgRotateProposer{Creator: alice, RollappId: "rollapp1"})
+	suite.Require().Nil(err)
+	suite.Require().Equal(sequencers[2], res.Proposer)
+}
+
+func (suite *SequencerTestSuite) TestUnbondProposerNoCandidate() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	sequencers := suite.createRollappWithSequencers("rollapp1", 1)
+
+	// no one can take over, the rollapp is left without a proposer
+	_, err := suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: sequencers[0]})
+	suite.Require().Nil(err)
+	suite.assertProposer("rollapp1", "", sequencers)
+
+	// the next registered sequencer is elected
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp1")
+	rollapp.MaxSequencers = 2
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+	seqAddr, err := suite.createBondedSequencer("rollapp1", sdk.Coin{})
+	suite.Require().Nil(err)
+	suite.assertProposer("rollapp1", seqAddr, append(sequencers, seqAddr))
+}
+
+func (suite *SequencerTestSuite) TestCreateSequencerKeepsProposerOfOtherRollapp() {
+	suite.SetupTest()
+	sequencers := suite.createRollappWithSequencers("rollapp1", 1)
+	suite.createRollappWithSequencers("rollapp2", 1)
+
+	// the proposer of rollapp1 registers to rollapp2, where it is not the proposer
+	rollapp, _ := suite.app.RollappKeeper.GetRollapp(suite.ctx, "rollapp2")
+	rollapp.MaxSequencers = 2
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapp)
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, sequencers[0])
+	_, err := suite.msgServer.CreateSequencer(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSequencer{
+		Creator:      sequencers[0],
+		DymintPubKey: sequencer.DymintPubKey,
+		RollappId:    "rollapp2",
+		Description:  types.Description{},
+	})
+	suite.Require().Nil(err)
+
+	suite.assertProposer("rollapp1", sequencers[0], sequencers)
+}
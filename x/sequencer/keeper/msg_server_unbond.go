@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// Unbond defines a method for starting the unbonding of a sequencer's bond
+func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.MsgUnbondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sequencer, found := k.GetSequencer(ctx, msg.Creator)
+	if !found {
+		return nil, types.ErrUnknownSequencer
+	}
+	if sequencer.Status != types.Bonded {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidBondStatus, "sequencer status: %s", sequencer.Status)
+	}
+
+	completionTime := k.startUnbonding(ctx, sequencer)
+
+	return &types.MsgUnbondResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// startUnbonding starts the unbonding of the sequencer bond and hands over the rollapps it proposes.
+// It returns the unbonding completion time.
+func (k Keeper) startUnbonding(ctx sdk.Context, sequencer types.Sequencer) time.Time {
+	completionTime := ctx.BlockTime().Add(k.UnbondingTime(ctx))
+	sequencer.Status = types.Unbonding
+	sequencer.UnbondTime = completionTime
+	k.SetSequencer(ctx, sequencer)
+	k.SetUnbondingQueue(ctx, completionTime, sequencer.SequencerAddress)
+
+	// an unbonding sequencer can not propose anymore, hand over its rollapps
+	for _, rollappId := range sequencer.RollappIDs {
+		sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, rollappId)
+		if !found || sequencersByRollapp.Proposer != sequencer.SequencerAddress {
+			continue
+		}
+		k.rotateOrClearProposer(ctx, rollappId)
+	}
+	scheduler, found := k.GetScheduler(ctx, sequencer.SequencerAddress)
+	if found && scheduler.Status != types.Inactive {
+		scheduler.Status = types.Inactive
+		k.SetScheduler(ctx, scheduler)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUnbonding,
+			sdk.NewAttribute(types.AttributeKeySequencer, sequencer.SequencerAddress),
+			sdk.NewAttribute(types.AttributeKeyBond, sequencer.Tokens.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+		),
+	)
+
+	return completionTime
+}
@@ -0,0 +1,161 @@
+package keeper_test
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/app"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// setBondParams sets a positive min bond in the staking denom and returns it
+func (suite *SequencerTestSuite) setBondParams(unbondingTime time.Duration) sdk.Coin {
+	minBond := sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), 100)
+	suite.app.SequencerKeeper.SetParams(suite.ctx, types.NewParams(minBond, unbondingTime, types.DefaultLivenessPeriodInBlocks))
+	return minBond
+}
+
+// createBondedSequencer funds a new account and registers it as a sequencer of rollappId with the given bond
+func (suite *SequencerTestSuite) createBondedSequencer(rollappId string, bond sdk.Coin) (string, error) {
+	pubkey := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pubkey.Address())
+	pkAny, err := codectypes.NewAnyWithValue(pubkey)
+	suite.Require().Nil(err)
+	app.FundAccount(suite.app, suite.ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(suite.app.StakingKeeper.BondDenom(suite.ctx), 1000)))
+
+	_, err = suite.msgServer.CreateSequencer(sdk.WrapSDKContext(suite.ctx), &types.MsgCreateSequencer{
+		Creator:      addr.String(),
+		DymintPubKey: pkAny,
+		RollappId:    rollappId,
+		Description:  types.Description{},
+		Bond:         bond,
+	})
+	return addr.String(), err
+}
+
+func (suite *SequencerTestSuite) TestCreateSequencerBond() {
+	suite.SetupTest()
+	minBond := suite.setBondParams(time.Hour)
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		MaxSequencers: 10,
+	})
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// bond lower than the minimum
+	_, err := suite.createBondedSequencer("rollapp1", minBond.SubAmount(sdk.OneInt()))
+	suite.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	// wrong denom
+	_, err = suite.createBondedSequencer("rollapp1", sdk.NewInt64Coin("otherdenom", 1000))
+	suite.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	// no bond at all
+	_, err = suite.createBondedSequencer("rollapp1", sdk.Coin{})
+	suite.Require().ErrorIs(err, types.ErrInsufficientBond)
+
+	bond := minBond.AddAmount(sdk.NewInt(50))
+	seqAddr, err := suite.createBondedSequencer("rollapp1", bond)
+	suite.Require().Nil(err)
+
+	sequencer, found := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(types.Bonded, sequencer.Status)
+	suite.Require().Equal(sdk.NewCoins(bond), sequencer.Tokens)
+	suite.Require().Equal(bond, suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bond.Denom))
+}
+
+func (suite *SequencerTestSuite) TestUnbond() {
+	suite.SetupTest()
+	unbondingTime := time.Hour
+	minBond := suite.setBondParams(unbondingTime)
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		MaxSequencers: 10,
+	})
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1000, 0))
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	seqAddr, err := suite.createBondedSequencer("rollapp1", minBond)
+	suite.Require().Nil(err)
+	seqAccAddr := sdk.MustAccAddressFromBech32(seqAddr)
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, seqAccAddr, minBond.Denom)
+
+	_, err = suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: bob})
+	suite.Require().ErrorIs(err, types.ErrUnknownSequencer)
+
+	res, err := suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: seqAddr})
+	suite.Require().Nil(err)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(unbondingTime), res.CompletionTime)
+
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Unbonding, sequencer.Status)
+	scheduler, _ := suite.app.SequencerKeeper.GetScheduler(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Inactive, scheduler.Status)
+
+	// can't unbond twice
+	_, err = suite.msgServer.Unbond(goCtx, &types.MsgUnbond{Creator: seqAddr})
+	suite.Require().ErrorIs(err, types.ErrInvalidBondStatus)
+
+	// the bond is kept until the unbonding time passes
+	suite.ctx = suite.ctx.WithBlockTime(res.CompletionTime.Add(-time.Second))
+	suite.app.SequencerKeeper.UnbondAllMatureSequencers(suite.ctx)
+	seq, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Unbonding, seq.Status)
+	suite.Require().Equal(balanceBefore, suite.app.BankKeeper.GetBalance(suite.ctx, seqAccAddr, minBond.Denom))
+
+	suite.ctx = suite.ctx.WithBlockTime(res.CompletionTime)
+	suite.app.SequencerKeeper.UnbondAllMatureSequencers(suite.ctx)
+	seq, _ = suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Unbonded, seq.Status)
+	suite.Require().True(seq.Tokens.IsZero())
+	suite.Require().Equal(balanceBefore.Add(minBond), suite.app.BankKeeper.GetBalance(suite.ctx, seqAccAddr, minBond.Denom))
+	suite.Require().Empty(suite.app.SequencerKeeper.GetMatureUnbondingSequencers(suite.ctx, res.CompletionTime))
+}
+
+func (suite *SequencerTestSuite) TestUnbondFailedRefundIsRetried() {
+	suite.SetupTest()
+	unbondingTime := time.Hour
+	minBond := suite.setBondParams(unbondingTime)
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		MaxSequencers: 10,
+	})
+	suite.ctx = suite.ctx.WithBlockTime(time.Unix(1000, 0))
+
+	seqAddr, err := suite.createBondedSequencer("rollapp1", minBond)
+	suite.Require().Nil(err)
+	seqAccAddr := sdk.MustAccAddressFromBech32(seqAddr)
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, seqAccAddr, minBond.Denom)
+
+	res, err := suite.msgServer.Unbond(sdk.WrapSDKContext(suite.ctx), &types.MsgUnbond{Creator: seqAddr})
+	suite.Require().Nil(err)
+
+	// the module account can't cover the recorded bond, the refund fails
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	sequencer.Tokens = sequencer.Tokens.Add(minBond)
+	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencer)
+
+	suite.ctx = suite.ctx.WithBlockTime(res.CompletionTime)
+	suite.app.SequencerKeeper.UnbondAllMatureSequencers(suite.ctx)
+	seq, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Unbonding, seq.Status)
+	suite.Require().Equal(sequencer.Tokens, seq.Tokens)
+	suite.Require().Equal([]string{seqAddr}, suite.app.SequencerKeeper.GetMatureUnbondingSequencers(suite.ctx, res.CompletionTime))
+
+	// the unbonding is retried on the next block
+	sequencer.Tokens = sdk.NewCoins(minBond)
+	suite.app.SequencerKeeper.SetSequencer(suite.ctx, sequencer)
+	suite.app.SequencerKeeper.UnbondAllMatureSequencers(suite.ctx)
+	seq, _ = suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().Equal(types.Unbonded, seq.Status)
+	suite.Require().Equal(balanceBefore.Add(minBond), suite.app.BankKeeper.GetBalance(suite.ctx, seqAccAddr, minBond.Denom))
+	suite.Require().Empty(suite.app.SequencerKeeper.GetMatureUnbondingSequencers(suite.ctx, res.CompletionTime))
+}
@@ -1,16 +1,40 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/x/sequencer/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.MinBond(ctx),
+		k.UnbondingTime(ctx),
+		k.LivenessPeriodInBlocks(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// MinBond returns the minimum bond of a sequencer
+func (k Keeper) MinBond(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyMinBond, &res)
+	return
+}
+
+// UnbondingTime returns the time it takes a sequencer to unbond
+func (k Keeper) UnbondingTime(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyUnbondingTime, &res)
+	return
+}
+
+// LivenessPeriodInBlocks returns the number of blocks a proposer can go without updating the rollapp state
+func (k Keeper) LivenessPeriodInBlocks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLivenessPeriodInBlocks, &res)
+	return
+}
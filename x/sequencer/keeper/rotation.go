@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// RotateProposer elects a new proposer for the rollapp: the first bonded and inactive
+// sequencer following the current proposer in the rollapp's sequencers list.
+// The previous proposer becomes inactive, unless it is still proposing another rollapp.
+// The rollapp state is kept as is, so the new proposer continues from the next expected height.
+func (k Keeper) RotateProposer(ctx sdk.Context, rollappId string) (string, error) {
+	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, rollappId)
+	if !found {
+		return "", sdkerrors.Wrapf(types.ErrNoProposerCandidate, "no sequencers for rollappId: %s", rollappId)
+	}
+
+	prevProposer := sequencersByRollapp.Proposer
+	addresses := sequencersByRollapp.Sequencers.Addresses
+
+	// start looking right after the current proposer, wrapping around the list
+	start := 0
+	for i, addr := range addresses {
+		if addr == prevProposer {
+			start = i + 1
+			break
+		}
+	}
+	newProposer := ""
+	for i := 0; i < len(addresses); i++ {
+		addr := addresses[(start+i)%len(addresses)]
+		if addr != prevProposer && k.isProposerCandidate(ctx, rollappId, addr) {
+			newProposer = addr
+			break
+		}
+	}
+	if newProposer == "" {
+		return "", sdkerrors.Wrapf(types.ErrNoProposerCandidate, "rollappId: %s", rollappId)
+	}
+
+	sequencersByRollapp.Proposer = newProposer
+	k.setProposerElected(ctx, &sequencersByRollapp)
+	k.SetSequencersByRollapp(ctx, sequencersByRollapp)
+	k.SetScheduler(ctx, types.Scheduler{
+		SequencerAddress: newProposer,
+		Status:           types.Proposer,
+	})
+	if prevProposer != "" && !k.isProposerOfOtherRollapp(ctx, prevProposer, rollappId) {
+		k.SetScheduler(ctx, types.Scheduler{
+			SequencerAddress: prevProposer,
+			Status:           types.Inactive,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeProposerRotation,
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollappId),
+			sdk.NewAttribute(types.AttributeKeyPrevProposer, prevProposer),
+			sdk.NewAttribute(types.AttributeKeyProposer, newProposer),
+			sdk.NewAttribute(types.AttributeKeyNextStartHeight, strconv.FormatUint(k.nextStartHeight(ctx, rollappId), 10)),
+		),
+	)
+
+	return newProposer, nil
+}
+
+// rotateOrClearProposer hands over the rollapp to the next sequencer. If no sequencer can take over,
+// the rollapp is left without a proposer until one is elected, and the previous proposer is deactivated.
+func (k Keeper) rotateOrClearProposer(ctx sdk.Context, rollappId string) {
+	_, err := k.RotateProposer(ctx, rollappId)
+	if err == nil {
+		return
+	}
+	k.Logger(ctx).Info("no proposer to take over rollapp", "rollappId", rollappId, "error", err.Error())
+
+	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, rollappId)
+	if !found || sequencersByRollapp.Proposer == "" {
+		return
+	}
+	prevProposer := sequencersByRollapp.Proposer
+	sequencersByRollapp.Proposer = ""
+	k.SetSequencersByRollapp(ctx, sequencersByRollapp)
+	// there is no proposer to track until one is elected
+	k.removeLivenessCheck(ctx, rollappId)
+	if !k.isProposerOfOtherRollapp(ctx, prevProposer, rollappId) {
+		k.SetScheduler(ctx, types.Scheduler{
+			SequencerAddress: prevProposer,
+			Status:           types.Inactive,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeProposerRotation,
+			sdk.NewAttribute(types.AttributeKeyRollappId, rollappId),
+			sdk.NewAttribute(types.AttributeKeyPrevProposer, prevProposer),
+			sdk.NewAttribute(types.AttributeKeyProposer, ""),
+		),
+	)
+}
+
+// isProposerCandidate returns true if the sequencer is bonded, permissioned for the rollapp and not proposing any rollapp
+func (k Keeper) isProposerCandidate(ctx sdk.Context, rollappId string, seqAddr string) bool {
+	sequencer, found := k.GetSequencer(ctx, seqAddr)
+	if !found || sequencer.Status != types.Bonded {
+		return false
+	}
+	if !k.isPermissioned(ctx, rollappId, seqAddr) {
+		return false
+	}
+	scheduler, found := k.GetScheduler(ctx, seqAddr)
+	return found && scheduler.Status == types.Inactive
+}
+
+// isPermissioned returns true if the rollapp is permissionless or the sequencer is in its permissioned addresses.
+// Registered sequencers may lose their permission when the rollapp is updated
+func (k Keeper) isPermissioned(ctx sdk.Context, rollappId string, seqAddr string) bool {
+	rollapp, found := k.rollappKeeper.GetRollapp(ctx, rollappId)
+	if !found {
+		return false
+	}
+	if len(rollapp.PermissionedAddresses) == 0 {
+		return true
+	}
+	for _, addr := range rollapp.PermissionedAddresses {
+		if addr == seqAddr {
+			return true
+		}
+	}
+	return false
+}
+
+// isProposerOfOtherRollapp returns true if the sequencer is the proposer of one of its rollapps, other than rollappId
+func (k Keeper) isProposerOfOtherRollapp(ctx sdk.Context, seqAddr string, rollappId string) bool {
+	sequencer, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return false
+	}
+	for _, id := range sequencer.RollappIDs {
+		if id == rollappId {
+			continue
+		}
+		sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, id)
+		if found && sequencersByRollapp.Proposer == seqAddr {
+			return true
+		}
+	}
+	return false
+}
+
+// nextStartHeight returns the rollapp height the next state update is expected to start from
+func (k Keeper) nextStartHeight(ctx sdk.Context, rollappId string) uint64 {
+	latestStateInfoIndex, found := k.rollappKeeper.GetLatestStateInfoIndex(ctx, rollappId)
+	if !found {
+		return 1
+	}
+	stateInfo, found := k.rollappKeeper.GetStateInfo(ctx, rollappId, latestStateInfoIndex.Index)
+	if !found {
+		return 1
+	}
+	return stateInfo.StartHeight + stateInfo.NumBlocks
+}
@@ -34,6 +34,24 @@ func (k Keeper) GetSequencersByRollapp(
 	return val, true
 }
 
+// GetRollappProposer returns the address of the sequencer currently proposing a rollapp
+func (k Keeper) GetRollappProposer(ctx sdk.Context, rollappId string) (string, bool) {
+	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, rollappId)
+	if !found || sequencersByRollapp.Proposer == "" {
+		return "", false
+	}
+	return sequencersByRollapp.Proposer, true
+}
+
+// GetRollappSequencers returns the addresses of the sequencers registered to a rollapp
+func (k Keeper) GetRollappSequencers(ctx sdk.Context, rollappId string) []string {
+	sequencersByRollapp, found := k.GetSequencersByRollapp(ctx, rollappId)
+	if !found {
+		return nil
+	}
+	return sequencersByRollapp.Sequencers.Addresses
+}
+
 // RemoveSequencersByRollapp removes a sequencersByRollapp from the store
 func (k Keeper) RemoveSequencersByRollapp(
 	ctx sdk.Context,
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// Slash burns the given fraction of the bond of a sequencer.
+// Both bonded and unbonding sequencers can be slashed, so a sequencer can not
+// escape a penalty by unbonding right after misbehaving.
+func (k Keeper) Slash(ctx sdk.Context, seqAddr string, fraction sdk.Dec) error {
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "slash fraction must be between 0 and 1: %s", fraction)
+	}
+
+	sequencer, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return types.ErrUnknownSequencer
+	}
+	if sequencer.Status != types.Bonded && sequencer.Status != types.Unbonding {
+		return sdkerrors.Wrapf(types.ErrInvalidBondStatus, "sequencer status: %s", sequencer.Status)
+	}
+
+	slashed := sdk.Coins{}
+	for _, coin := range sequencer.Tokens {
+		amt := fraction.MulInt(coin.Amount).TruncateInt()
+		if amt.IsPositive() {
+			slashed = slashed.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	if !slashed.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, slashed); err != nil {
+			return err
+		}
+		sequencer.Tokens = sequencer.Tokens.Sub(slashed...)
+		k.SetSequencer(ctx, sequencer)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("sequencer %s slashed by %s", seqAddr, slashed))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeySequencer, seqAddr),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+func (suite *SequencerTestSuite) TestSlash() {
+	suite.SetupTest()
+	minBond := suite.setBondParams(time.Hour)
+	suite.app.RollappKeeper.SetRollapp(suite.ctx, rollapptypes.Rollapp{
+		RollappId:     "rollapp1",
+		Creator:       alice,
+		MaxSequencers: 10,
+	})
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, minBond.Denom)
+
+	bond := sdk.NewCoin(minBond.Denom, sdk.NewInt(1000))
+	seqAddr, err := suite.createBondedSequencer("rollapp1", bond)
+	suite.Require().Nil(err)
+
+	// invalid fraction
+	err = suite.app.SequencerKeeper.Slash(suite.ctx, seqAddr, sdk.NewDecWithPrec(11, 1))
+	suite.Require().Error(err)
+	err = suite.app.SequencerKeeper.Slash(suite.ctx, bob, sdk.NewDecWithPrec(1, 1))
+	suite.Require().ErrorIs(err, types.ErrUnknownSequencer)
+
+	err = suite.app.SequencerKeeper.Slash(suite.ctx, seqAddr, sdk.NewDecWithPrec(25, 2))
+	suite.Require().Nil(err)
+
+	sequencer, _ := suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	expected := sdk.NewCoin(minBond.Denom, sdk.NewInt(750))
+	suite.Require().Equal(sdk.NewCoins(expected), sequencer.Tokens)
+	suite.Require().Equal(expected, suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, minBond.Denom))
+	// the slashed tokens were burned (the sequencer was funded with 1000)
+	suite.Require().Equal(supplyBefore.AddAmount(sdk.NewInt(750)), suite.app.BankKeeper.GetSupply(suite.ctx, minBond.Denom))
+
+	// an unbonding sequencer can still be slashed
+	_, err = suite.msgServer.Unbond(sdk.WrapSDKContext(suite.ctx), &types.MsgUnbond{Creator: seqAddr})
+	suite.Require().Nil(err)
+	err = suite.app.SequencerKeeper.Slash(suite.ctx, seqAddr, sdk.OneDec())
+	suite.Require().Nil(err)
+	sequencer, _ = suite.app.SequencerKeeper.GetSequencer(suite.ctx, seqAddr)
+	suite.Require().True(sequencer.Tokens.IsZero())
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// SetUnbondingQueue adds a sequencer to the unbonding queue at its completion time
+func (k Keeper) SetUnbondingQueue(ctx sdk.Context, completionTime time.Time, sequencerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnbondingQueueKeyPrefix))
+	store.Set(types.UnbondingQueueKey(completionTime, sequencerAddress), []byte(sequencerAddress))
+}
+
+// RemoveUnbondingQueue removes a sequencer from the unbonding queue
+func (k Keeper) RemoveUnbondingQueue(ctx sdk.Context, completionTime time.Time, sequencerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnbondingQueueKeyPrefix))
+	store.Delete(types.UnbondingQueueKey(completionTime, sequencerAddress))
+}
+
+// GetMatureUnbondingSequencers returns the addresses of all the sequencers
+// whose unbonding completes at or before endTime
+func (k Keeper) GetMatureUnbondingSequencers(ctx sdk.Context, endTime time.Time) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.UnbondingQueueKeyPrefix))
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(types.UnbondingQueueTimeKey(endTime)))
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// UnbondAllMatureSequencers completes the unbonding of all the sequencers whose
+// unbonding time has passed, returning their bond
+func (k Keeper) UnbondAllMatureSequencers(ctx sdk.Context) {
+	for _, seqAddr := range k.GetMatureUnbondingSequencers(ctx, ctx.BlockTime()) {
+		// a failed unbonding is discarded as a whole and keeps the sequencer
+		// in the queue, so it is retried on the next block
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.completeUnbonding(cacheCtx, seqAddr); err != nil {
+			k.Logger(ctx).Error("failed to complete unbonding", "sequencer", seqAddr, "error", err.Error())
+			continue
+		}
+		writeCache()
+	}
+}
+
+func (k Keeper) completeUnbonding(ctx sdk.Context, seqAddr string) error {
+	sequencer, found := k.GetSequencer(ctx, seqAddr)
+	if !found {
+		return types.ErrUnknownSequencer
+	}
+
+	if !sequencer.Tokens.IsZero() {
+		addr, err := sdk.AccAddressFromBech32(seqAddr)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sequencer.Tokens); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUnbonded,
+			sdk.NewAttribute(types.AttributeKeySequencer, seqAddr),
+			sdk.NewAttribute(types.AttributeKeyBond, sequencer.Tokens.String()),
+		),
+	)
+
+	k.RemoveUnbondingQueue(ctx, sequencer.UnbondTime, seqAddr)
+	sequencer.Tokens = sdk.Coins{}
+	sequencer.Status = types.Unbonded
+	k.SetSequencer(ctx, sequencer)
+	return nil
+}
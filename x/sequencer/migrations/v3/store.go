@@ -0,0 +1,118 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The params added since v2 are set to their defaults, the registered sequencers,
+// which had no bond, are considered bonded with an empty bond, and the proposer of
+// every rollapp is recorded in its sequencers list and tracked for liveness.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	paramstore.Set(ctx, types.KeyMinBond, types.DefaultMinBond)
+	paramstore.Set(ctx, types.KeyUnbondingTime, types.DefaultUnbondingTime)
+	paramstore.Set(ctx, types.KeyLivenessPeriodInBlocks, types.DefaultLivenessPeriodInBlocks)
+
+	if err := migrateSequencers(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	if err := migrateProposers(ctx, storeKey, cdc); err != nil {
+		return err
+	}
+	return migrateLiveness(ctx, storeKey, cdc, types.DefaultLivenessPeriodInBlocks)
+}
+
+// migrateSequencers sets the bond status of the registered sequencers to bonded
+func migrateSequencers(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SequencerKeyPrefix))
+
+	var sequencers []types.Sequencer
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var sequencer types.Sequencer
+		if err := cdc.Unmarshal(iterator.Value(), &sequencer); err != nil {
+			iterator.Close() // nolint: errcheck
+			return err
+		}
+		if sequencer.Status == types.BondStatusUnspecified {
+			sequencers = append(sequencers, sequencer)
+		}
+	}
+	iterator.Close() // nolint: errcheck
+
+	for i := range sequencers {
+		sequencers[i].Status = types.Bonded
+		store.Set(types.SequencerKey(sequencers[i].SequencerAddress), cdc.MustMarshal(&sequencers[i]))
+	}
+
+	return nil
+}
+
+// migrateProposers sets the proposer of the rollapps. Before v3 the proposer was never rotated,
+// so it is the first sequencer registered to the rollapp.
+func migrateProposers(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SequencersByRollappKeyPrefix))
+	schedulerStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SchedulerKeyPrefix))
+
+	var sequencersByRollapps []types.SequencersByRollapp
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var sequencersByRollapp types.SequencersByRollapp
+		if err := cdc.Unmarshal(iterator.Value(), &sequencersByRollapp); err != nil {
+			iterator.Close() // nolint: errcheck
+			return err
+		}
+		if sequencersByRollapp.Proposer == "" && len(sequencersByRollapp.Sequencers.Addresses) > 0 {
+			sequencersByRollapps = append(sequencersByRollapps, sequencersByRollapp)
+		}
+	}
+	iterator.Close() // nolint: errcheck
+
+	for i := range sequencersByRollapps {
+		proposer := sequencersByRollapps[i].Sequencers.Addresses[0]
+		sequencersByRollapps[i].Proposer = proposer
+		store.Set(types.SequencersByRollappKey(sequencersByRollapps[i].RollappId), cdc.MustMarshal(&sequencersByRollapps[i]))
+		// the proposer status may have been overridden by the registration to another rollapp
+		scheduler := types.Scheduler{SequencerAddress: proposer, Status: types.Proposer}
+		schedulerStore.Set(types.SchedulerKey(proposer), cdc.MustMarshal(&scheduler))
+	}
+
+	return nil
+}
+
+// migrateLiveness schedules the first liveness check of every rollapp proposer,
+// giving it a full liveness period from the migration
+func migrateLiveness(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, livenessPeriodInBlocks uint64) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SequencersByRollappKeyPrefix))
+	livenessQueueStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	livenessCheckStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.LivenessCheckKeyPrefix))
+
+	var sequencersByRollapps []types.SequencersByRollapp
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var sequencersByRollapp types.SequencersByRollapp
+		if err := cdc.Unmarshal(iterator.Value(), &sequencersByRollapp); err != nil {
+			iterator.Close() // nolint: errcheck
+			return err
+		}
+		if sequencersByRollapp.Proposer != "" {
+			sequencersByRollapps = append(sequencersByRollapps, sequencersByRollapp)
+		}
+	}
+	iterator.Close() // nolint: errcheck
+
+	checkHeight := uint64(ctx.BlockHeight()) + livenessPeriodInBlocks
+	for i := range sequencersByRollapps {
+		sequencersByRollapps[i].LastUpdateHeight = ctx.BlockHeight()
+		store.Set(types.SequencersByRollappKey(sequencersByRollapps[i].RollappId), cdc.MustMarshal(&sequencersByRollapps[i]))
+		livenessQueueStore.Set(types.LivenessQueueKey(checkHeight, sequencersByRollapps[i].RollappId), []byte(sequencersByRollapps[i].RollappId))
+		livenessCheckStore.Set(types.LivenessCheckKey(sequencersByRollapps[i].RollappId), sdk.Uint64ToBigEndian(checkHeight))
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/dymensionxyz/dymension/x/sequencer/migrations/v3"
+	"github.com/dymensionxyz/dymension/x/sequencer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey).WithBlockHeight(100)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SequencerKeyPrefix))
+
+	sequencer := types.Sequencer{
+		SequencerAddress: "sequencer1",
+		RollappIDs:       []string{"rollapp1"},
+	}
+	store.Set(types.SequencerKey(sequencer.SequencerAddress), cdc.MustMarshal(&sequencer))
+
+	sequencersByRollappStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SequencersByRollappKeyPrefix))
+	sequencersByRollapp := types.SequencersByRollapp{
+		RollappId:  "rollapp1",
+		Sequencers: types.Sequencers{Addresses: []string{"sequencer1", "sequencer2"}},
+	}
+	sequencersByRollappStore.Set(types.SequencersByRollappKey("rollapp1"), cdc.MustMarshal(&sequencersByRollapp))
+	schedulerStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.SchedulerKeyPrefix))
+	for _, addr := range sequencersByRollapp.Sequencers.Addresses {
+		scheduler := types.Scheduler{SequencerAddress: addr, Status: types.Inactive}
+		schedulerStore.Set(types.SchedulerKey(addr), cdc.MustMarshal(&scheduler))
+	}
+
+	require.False(t, paramstore.Has(ctx, types.KeyMinBond))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc, paramstore))
+
+	// the new params are set to their defaults
+	var minBond sdk.Coin
+	paramstore.Get(ctx, types.KeyMinBond, &minBond)
+	require.Equal(t, types.DefaultMinBond, minBond)
+	require.True(t, paramstore.Has(ctx, types.KeyUnbondingTime))
+	require.True(t, paramstore.Has(ctx, types.KeyLivenessPeriodInBlocks))
+
+	// the registered sequencers are bonded
+	var migrated types.Sequencer
+	cdc.MustUnmarshal(store.Get(types.SequencerKey(sequencer.SequencerAddress)), &migrated)
+	require.Equal(t, types.Bonded, migrated.Status)
+	require.True(t, migrated.Tokens.IsZero())
+
+	// the first sequencer of the rollapp is its proposer
+	cdc.MustUnmarshal(sequencersByRollappStore.Get(types.SequencersByRollappKey("rollapp1")), &sequencersByRollapp)
+	require.Equal(t, "sequencer1", sequencersByRollapp.Proposer)
+	require.Equal(t, int64(100), sequencersByRollapp.LastUpdateHeight)
+	var scheduler types.Scheduler
+	cdc.MustUnmarshal(schedulerStore.Get(types.SchedulerKey("sequencer1")), &scheduler)
+	require.Equal(t, types.Proposer, scheduler.Status)
+	cdc.MustUnmarshal(schedulerStore.Get(types.SchedulerKey("sequencer2")), &scheduler)
+	require.Equal(t, types.Inactive, scheduler.Status)
+
+	// the proposer liveness is checked after a full liveness period
+	livenessQueueStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.LivenessQueueKeyPrefix))
+	require.True(t, livenessQueueStore.Has(types.LivenessQueueKey(100+types.DefaultLivenessPeriodInBlocks, "rollapp1")))
+	livenessCheckStore := prefix.NewStore(ctx.KVStore(storeKey), types.KeyPrefix(types.LivenessCheckKeyPrefix))
+	require.Equal(t, sdk.Uint64ToBigEndian(100+types.DefaultLivenessPeriodInBlocks), livenessCheckStore.Get(types.LivenessCheckKey("rollapp1")))
+}
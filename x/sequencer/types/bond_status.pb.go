@@ -0,0 +1,88 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymension/sequencer/bond_status.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BondStatus defines the status of the bond of a sequencer
+type BondStatus int32
+
+const (
+	// BOND_STATUS_UNSPECIFIED defines zero-value for bond status ordering
+	BondStatusUnspecified BondStatus = 0
+	// BOND_STATUS_BONDED defines a sequencer whose tokens are bonded and can serve its rollapps
+	Bonded BondStatus = 1
+	// BOND_STATUS_UNBONDING defines a sequencer whose tokens are waiting for the unbonding time to pass
+	Unbonding BondStatus = 2
+	// BOND_STATUS_UNBONDED defines a sequencer whose tokens were returned
+	Unbonded BondStatus = 3
+)
+
+var BondStatus_name = map[int32]string{
+	0: "BOND_STATUS_UNSPECIFIED",
+	1: "BOND_STATUS_BONDED",
+	2: "BOND_STATUS_UNBONDING",
+	3: "BOND_STATUS_UNBONDED",
+}
+
+var BondStatus_value = map[string]int32{
+	"BOND_STATUS_UNSPECIFIED": 0,
+	"BOND_STATUS_BONDED":      1,
+	"BOND_STATUS_UNBONDING":   2,
+	"BOND_STATUS_UNBONDED":    3,
+}
+
+func (x BondStatus) String() string {
+	return proto.EnumName(BondStatus_name, int32(x))
+}
+
+func (BondStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_91140418d7cf5e38, []int{0}
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.BondStatus", BondStatus_name, BondStatus_value)
+}
+
+func init() {
+	proto.RegisterFile("dymension/sequencer/bond_status.proto", fileDescriptor_91140418d7cf5e38)
+}
+
+var fileDescriptor_91140418d7cf5e38 = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xa9, 0xcc, 0x4d,
+	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x2d, 0xd2,
+	0x4f, 0xca, 0xcf, 0x4b, 0x89, 0x2f, 0x2e, 0x49, 0x2c, 0x29, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
+	0xc9, 0x17, 0x52, 0x80, 0x2b, 0xab, 0xa8, 0xac, 0xd2, 0x83, 0x73, 0xf4, 0xe0, 0x7a, 0xa4, 0x44,
+	0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x8a, 0xf5, 0x41, 0x2c, 0x88, 0x3e, 0xad, 0x03, 0x8c, 0x5c, 0x5c,
+	0x4e, 0xf9, 0x79, 0x29, 0xc1, 0x60, 0xc3, 0x84, 0xcc, 0xb8, 0xc4, 0x9d, 0xfc, 0xfd, 0x5c, 0xe2,
+	0x83, 0x43, 0x1c, 0x43, 0x42, 0x83, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c,
+	0x5d, 0x5d, 0x04, 0x18, 0xa4, 0x24, 0xbb, 0xe6, 0x2a, 0x88, 0x22, 0x14, 0x87, 0xe6, 0x15, 0x17,
+	0xa4, 0x26, 0x67, 0xa6, 0x65, 0xa6, 0xa6, 0x08, 0x29, 0x71, 0x09, 0x21, 0xeb, 0x03, 0xb1, 0x5d,
+	0x5d, 0x04, 0x18, 0xa5, 0xb8, 0xba, 0xe6, 0x2a, 0xb0, 0x81, 0xb4, 0xa4, 0xa6, 0x08, 0x69, 0x70,
+	0x89, 0xa2, 0x9a, 0x0d, 0xe2, 0x79, 0xfa, 0xb9, 0x0b, 0x30, 0x49, 0xf1, 0x76, 0xcd, 0x55, 0xe0,
+	0x0c, 0xcd, 0x03, 0x79, 0x2b, 0x33, 0x2f, 0x5d, 0x48, 0x8d, 0x4b, 0x04, 0x53, 0xa5, 0xab, 0x8b,
+	0x00, 0xb3, 0x14, 0x4f, 0xd7, 0x5c, 0x05, 0x0e, 0x88, 0xc2, 0xd4, 0x14, 0x29, 0x96, 0x8e, 0xc5,
+	0x72, 0x0c, 0x4e, 0xbe, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c,
+	0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x9c,
+	0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x1c, 0x3e, 0x08, 0x8e, 0x7e,
+	0x05, 0x52, 0xa8, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xc6, 0x18, 0x30, 0x00,
+	0xb0, 0xaa, 0x8a, 0x16, 0x79, 0x01, 0x00, 0x00,
+}
@@ -9,12 +9,18 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateSequencer{}, "sequencer/CreateSequencer", nil)
+	cdc.RegisterConcrete(&MsgUnbond{}, "sequencer/Unbond", nil)
+	cdc.RegisterConcrete(&MsgRotateProposer{}, "sequencer/RotateProposer", nil)
+	cdc.RegisterConcrete(&MsgLeaveProposer{}, "sequencer/LeaveProposer", nil)
 	// this line is used by starport scaffolding # 2
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateSequencer{},
+		&MsgUnbond{},
+		&MsgRotateProposer{},
+		&MsgLeaveProposer{},
 	)
 	// this line is used by starport scaffolding # 3
 
@@ -17,4 +17,9 @@ var (
 	ErrSequencerRollappMismatch   = sdkerrors.Register(ModuleName, 1006, "sequencer was not registered for this rollapp")
 	ErrNotActiveSequencer         = sdkerrors.Register(ModuleName, 1007, "sequencer is not active")
 	ErrSequencerAlreadyRegistered = sdkerrors.Register(ModuleName, 1008, "sequencer is already registered")
+	ErrInsufficientBond           = sdkerrors.Register(ModuleName, 1009, "insufficient sequencer bond")
+	ErrInvalidBondStatus          = sdkerrors.Register(ModuleName, 1010, "sequencer bond status does not allow this operation")
+	ErrNoProposerCandidate        = sdkerrors.Register(ModuleName, 1011, "no inactive sequencer available to become the proposer")
+	ErrNotRollappCreator          = sdkerrors.Register(ModuleName, 1012, "only the rollapp creator can perform this operation")
+	ErrRollappFrozen              = sdkerrors.Register(ModuleName, 1013, "rollapp is frozen")
 )
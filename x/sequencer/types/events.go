@@ -0,0 +1,19 @@
+package types
+
+const (
+	EventTypeUnbonding        = "unbonding"
+	EventTypeUnbonded         = "unbonded"
+	EventTypeSlash            = "slash"
+	EventTypeProposerRotation = "proposer_rotation"
+	EventTypeProposerInactive = "proposer_inactive"
+
+	AttributeKeySequencer        = "sequencer"
+	AttributeKeyBond             = "bond"
+	AttributeKeyCompletionTime   = "completion_time"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyRollappId        = "rollapp_id"
+	AttributeKeyPrevProposer     = "prev_proposer"
+	AttributeKeyProposer         = "proposer"
+	AttributeKeyNextStartHeight  = "next_start_height"
+	AttributeKeyLastUpdateHeight = "last_update_height"
+)
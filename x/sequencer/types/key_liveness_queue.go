@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// LivenessQueueKeyPrefix is the prefix to retrieve all the rollapps liveness checks
+	LivenessQueueKeyPrefix = "LivenessQueue/value/"
+	// LivenessCheckKeyPrefix is the prefix to retrieve the height of the scheduled liveness check of a rollapp
+	LivenessCheckKeyPrefix = "LivenessCheck/value/"
+)
+
+// LivenessQueueHeightKey returns the store key prefix of all the liveness checks at the given height
+func LivenessQueueHeightKey(height uint64) []byte {
+	var key []byte
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// LivenessQueueKey returns the store key of the liveness check of a rollapp
+func LivenessQueueKey(
+	height uint64,
+	rollappId string,
+) []byte {
+	var key []byte
+
+	rollappIdBytes := []byte(rollappId)
+	key = append(key, LivenessQueueHeightKey(height)...)
+	key = append(key, rollappIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// LivenessCheckKey returns the store key of the height of the scheduled liveness check of a rollapp
+func LivenessCheckKey(
+	rollappId string,
+) []byte {
+	var key []byte
+
+	rollappIdBytes := []byte(rollappId)
+	key = append(key, rollappIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// UnbondingQueueKeyPrefix is the prefix to retrieve all the unbonding sequencers
+	UnbondingQueueKeyPrefix = "UnbondingQueue/value/"
+)
+
+// UnbondingQueueTimeKey returns the store key prefix of all the sequencers completing
+// their unbonding at the given time
+func UnbondingQueueTimeKey(completionTime time.Time) []byte {
+	var key []byte
+
+	key = append(key, sdk.FormatTimeBytes(completionTime)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// UnbondingQueueKey returns the store key of an unbonding sequencer
+func UnbondingQueueKey(
+	completionTime time.Time,
+	sequencerAddress string,
+) []byte {
+	var key []byte
+
+	sequencerAddressBytes := []byte(sequencerAddress)
+	key = append(key, UnbondingQueueTimeKey(completionTime)...)
+	key = append(key, sequencerAddressBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
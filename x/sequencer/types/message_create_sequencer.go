@@ -20,7 +20,7 @@ func (msg MsgCreateSequencer) UnpackInterfaces(unpacker codectypes.AnyUnpacker)
 	return unpacker.UnpackAny(msg.DymintPubKey, &pubKey)
 }
 
-func NewMsgCreateSequencer(creator string, pubkey cryptotypes.PubKey, rollappId string, description *Description) (*MsgCreateSequencer, error) {
+func NewMsgCreateSequencer(creator string, pubkey cryptotypes.PubKey, rollappId string, description *Description, bond sdk.Coin) (*MsgCreateSequencer, error) {
 	var pkAny *codectypes.Any
 	if pubkey != nil {
 		var err error
@@ -33,6 +33,7 @@ func NewMsgCreateSequencer(creator string, pubkey cryptotypes.PubKey, rollappId
 		DymintPubKey: pkAny,
 		RollappId:    rollappId,
 		Description:  *description,
+		Bond:         bond,
 	}, nil
 }
 
@@ -81,5 +82,12 @@ func (msg *MsgCreateSequencer) ValidateBasic() error {
 		return err
 	}
 
+	// an empty bond is allowed, the sufficiency of the bond is checked by the application logic
+	if !msg.Bond.IsNil() {
+		if err := msg.Bond.Validate(); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bond (%s)", err)
+		}
+	}
+
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dymensionxyz/dymension/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -34,6 +35,21 @@ func TestMsgCreateSequencer_ValidateBasic(t *testing.T) {
 				Creator:      sample.AccAddress(),
 				DymintPubKey: pkAny,
 			},
+		}, {
+			name: "valid bond",
+			msg: MsgCreateSequencer{
+				Creator:      sample.AccAddress(),
+				DymintPubKey: pkAny,
+				Bond:         sdk.NewInt64Coin("udym", 100),
+			},
+		}, {
+			name: "invalid bond",
+			msg: MsgCreateSequencer{
+				Creator:      sample.AccAddress(),
+				DymintPubKey: pkAny,
+				Bond:         sdk.Coin{Denom: "1udym", Amount: sdk.NewInt(100)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
 		}, {
 			name: "valid description",
 			msg: MsgCreateSequencer{
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgLeaveProposer = "leave_proposer"
+
+var _ sdk.Msg = &MsgLeaveProposer{}
+
+func NewMsgLeaveProposer(creator string, rollappId string) *MsgLeaveProposer {
+	return &MsgLeaveProposer{
+		Creator:   creator,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgLeaveProposer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgLeaveProposer) Type() string {
+	return TypeMsgLeaveProposer
+}
+
+func (msg *MsgLeaveProposer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgLeaveProposer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgLeaveProposer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrUnknownRollappID, "rollappId can not be empty")
+	}
+	return nil
+}
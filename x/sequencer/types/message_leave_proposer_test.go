@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgLeaveProposer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgLeaveProposer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgLeaveProposer{
+				Creator:   "invalid_address",
+				RollappId: "rollapp1",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty rollapp id",
+			msg: MsgLeaveProposer{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrUnknownRollappID,
+		}, {
+			name: "valid",
+			msg: MsgLeaveProposer{
+				Creator:   sample.AccAddress(),
+				RollappId: "rollapp1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
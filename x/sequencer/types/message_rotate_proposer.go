@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRotateProposer = "rotate_proposer"
+
+var _ sdk.Msg = &MsgRotateProposer{}
+
+func NewMsgRotateProposer(creator string, rollappId string) *MsgRotateProposer {
+	return &MsgRotateProposer{
+		Creator:   creator,
+		RollappId: rollappId,
+	}
+}
+
+func (msg *MsgRotateProposer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRotateProposer) Type() string {
+	return TypeMsgRotateProposer
+}
+
+func (msg *MsgRotateProposer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRotateProposer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRotateProposer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.RollappId == "" {
+		return sdkerrors.Wrap(ErrUnknownRollappID, "rollappId can not be empty")
+	}
+	return nil
+}
@@ -1,35 +1,71 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	appparams "github.com/dymensionxyz/dymension/app/params"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	// KeyMinBond is store's key for MinBond Params
+	KeyMinBond = []byte("MinBond")
+	// KeyUnbondingTime is store's key for UnbondingTime Params
+	KeyUnbondingTime = []byte("UnbondingTime")
+	// KeyLivenessPeriodInBlocks is store's key for LivenessPeriodInBlocks Params
+	KeyLivenessPeriodInBlocks = []byte("LivenessPeriodInBlocks")
+	// DefaultMinBond is the default value of MinBond. A zero amount means bonding is optional.
+	DefaultMinBond = sdk.NewCoin(appparams.BaseDenom, sdk.ZeroInt())
+	// DefaultUnbondingTime is the default value of UnbondingTime (3 weeks)
+	DefaultUnbondingTime = time.Hour * 24 * 7 * 3
+	// DefaultLivenessPeriodInBlocks is the default value of LivenessPeriodInBlocks (~1 day of hub blocks)
+	DefaultLivenessPeriodInBlocks uint64 = 14400
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(minBond sdk.Coin, unbondingTime time.Duration, livenessPeriodInBlocks uint64) Params {
+	return Params{
+		MinBond:                minBond,
+		UnbondingTime:          unbondingTime,
+		LivenessPeriodInBlocks: livenessPeriodInBlocks,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultMinBond, DefaultUnbondingTime, DefaultLivenessPeriodInBlocks)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinBond, &p.MinBond, validateMinBond),
+		paramtypes.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
+		paramtypes.NewParamSetPair(KeyLivenessPeriodInBlocks, &p.LivenessPeriodInBlocks, validateLivenessPeriodInBlocks),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return nil
+	if err := validateMinBond(p.MinBond); err != nil {
+		return err
+	}
+
+	if err := validateUnbondingTime(p.UnbondingTime); err != nil {
+		return err
+	}
+
+	return validateLivenessPeriodInBlocks(p.LivenessPeriodInBlocks)
 }
 
 // String implements the Stringer interface.
@@ -37,3 +73,45 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+// validateMinBond validates the MinBond param
+func validateMinBond(v interface{}) error {
+	minBond, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if minBond.IsNil() {
+		return fmt.Errorf("min bond cannot be nil")
+	}
+
+	return minBond.Validate()
+}
+
+// validateUnbondingTime validates the UnbondingTime param
+func validateUnbondingTime(v interface{}) error {
+	unbondingTime, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if unbondingTime <= 0 {
+		return fmt.Errorf("unbonding time must be positive: %d", unbondingTime)
+	}
+
+	return nil
+}
+
+// validateLivenessPeriodInBlocks validates the LivenessPeriodInBlocks param
+func validateLivenessPeriodInBlocks(v interface{}) error {
+	livenessPeriodInBlocks, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if livenessPeriodInBlocks == 0 {
+		return fmt.Errorf("liveness period cannot be lower than 1 block")
+	}
+
+	return nil
+}
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +30,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// min_bond is the minimum amount of tokens a sequencer must bond
+	// in order to be registered.
+	MinBond types.Coin `protobuf:"bytes,1,opt,name=min_bond,json=minBond,proto3" json:"min_bond" yaml:"min_bond"`
+	// unbonding_time is the time it takes for the bond of a sequencer
+	// to be returned after it asked to unbond.
+	UnbondingTime time.Duration `protobuf:"bytes,2,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time" yaml:"unbonding_time"`
+	// liveness_period_in_blocks is the number of hub blocks a proposer
+	// can go without updating the rollapp state before it is set inactive.
+	LivenessPeriodInBlocks uint64 `protobuf:"varint,3,opt,name=liveness_period_in_blocks,json=livenessPeriodInBlocks,proto3" json:"liveness_period_in_blocks,omitempty" yaml:"liveness_period_in_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +73,27 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMinBond() types.Coin {
+	if m != nil {
+		return m.MinBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *Params) GetLivenessPeriodInBlocks() uint64 {
+	if m != nil {
+		return m.LivenessPeriodInBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
 }
@@ -66,17 +101,30 @@ func init() {
 func init() { proto.RegisterFile("dymension/sequencer/params.proto", fileDescriptor_d06545e8924ecfea) }
 
 var fileDescriptor_d06545e8924ecfea = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x2d, 0xd2,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x42, 0xa8, 0xa8,
-	0xa8, 0xac, 0xd2, 0x83, 0x73, 0xf4, 0xe0, 0xca, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x8a,
-	0xf5, 0x41, 0x2c, 0x88, 0x3e, 0x25, 0x3e, 0x2e, 0xb6, 0x00, 0xb0, 0x39, 0x56, 0x2c, 0x33, 0x16,
-	0xc8, 0x33, 0x38, 0xf9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71,
-	0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xb2, 0x65, 0x08, 0x8e, 0x7e,
-	0x05, 0x92, 0xeb, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xb6, 0x18, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x54, 0x8c, 0x7e, 0xf4, 0xc1, 0x00, 0x00, 0x00,
+	// 362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x3b, 0x4e, 0xf3, 0x40,
+	0x14, 0x85, 0xed, 0xfc, 0x51, 0x7e, 0x64, 0x04, 0x48, 0x16, 0x8f, 0x24, 0x85, 0x6d, 0x2c, 0x8a,
+	0x54, 0x33, 0x0a, 0xe9, 0x52, 0x1a, 0x1a, 0x8a, 0x48, 0x51, 0x44, 0x45, 0x63, 0xf9, 0x31, 0x98,
+	0x11, 0x9e, 0xb9, 0xc6, 0x63, 0x47, 0x31, 0xab, 0xa0, 0x4c, 0x89, 0xc4, 0x66, 0x52, 0xa6, 0xa4,
+	0x0a, 0x28, 0xd9, 0x41, 0x56, 0x80, 0xfc, 0xc8, 0x83, 0x82, 0x6e, 0xee, 0x3d, 0xe7, 0x3b, 0x3a,
+	0xa3, 0xab, 0x18, 0x7e, 0xc6, 0x08, 0x17, 0x14, 0x38, 0x16, 0xe4, 0x25, 0x25, 0xdc, 0x23, 0x31,
+	0x8e, 0x9c, 0xd8, 0x61, 0x02, 0x45, 0x31, 0x24, 0xa0, 0xee, 0x1c, 0x93, 0xec, 0x15, 0x6d, 0x07,
+	0xb4, 0xb5, 0xb7, 0x4f, 0x03, 0x08, 0xa0, 0x30, 0xe3, 0xfc, 0x55, 0x72, 0x6d, 0x2d, 0x00, 0x08,
+	0x42, 0x82, 0x8b, 0xc9, 0x4d, 0x1f, 0xb1, 0x9f, 0xc6, 0x4e, 0x92, 0x93, 0x95, 0xee, 0x81, 0x60,
+	0x20, 0xb0, 0xeb, 0x08, 0x82, 0xc7, 0x5d, 0x97, 0x24, 0x4e, 0x17, 0x7b, 0x40, 0x2b, 0xdd, 0xfc,
+	0xa8, 0x29, 0x8d, 0x61, 0x51, 0x44, 0x1d, 0x28, 0x07, 0x8c, 0x72, 0xdb, 0x05, 0xee, 0x37, 0x65,
+	0x43, 0xee, 0x1c, 0x5e, 0xb7, 0x50, 0x49, 0xa3, 0x9c, 0x46, 0x15, 0x8d, 0x6e, 0x80, 0x72, 0xeb,
+	0x62, 0xb6, 0xd0, 0xa5, 0xf5, 0x42, 0x3f, 0xc9, 0x1c, 0x16, 0xf6, 0xcd, 0x0d, 0x68, 0x8e, 0xfe,
+	0x33, 0xca, 0x2d, 0xe0, 0xbe, 0xea, 0x29, 0xc7, 0x29, 0xcf, 0x77, 0x94, 0x07, 0x76, 0x42, 0x19,
+	0x69, 0xd6, 0xaa, 0xd0, 0xb2, 0x32, 0xda, 0x54, 0x46, 0xb7, 0x55, 0x65, 0xeb, 0xb2, 0x0a, 0x3d,
+	0x2b, 0x43, 0x7f, 0xe3, 0xe6, 0xf4, 0x4b, 0x97, 0x47, 0x47, 0xdb, 0xe5, 0x3d, 0x65, 0x44, 0xb5,
+	0x95, 0x56, 0x48, 0xc7, 0x84, 0x13, 0x21, 0xec, 0x88, 0xc4, 0x14, 0x7c, 0x3b, 0x6f, 0x12, 0x82,
+	0xf7, 0x2c, 0x9a, 0xff, 0x0c, 0xb9, 0x53, 0xb7, 0xae, 0xd6, 0x0b, 0xdd, 0x28, 0x03, 0xff, 0xb4,
+	0x9a, 0xa3, 0xf3, 0x8d, 0x36, 0x2c, 0xa4, 0x3b, 0x6e, 0x15, 0x42, 0xbf, 0x3e, 0x7d, 0xd7, 0x25,
+	0x6b, 0x30, 0x5b, 0x6a, 0xf2, 0x7c, 0xa9, 0xc9, 0xdf, 0x4b, 0x4d, 0x7e, 0x5b, 0x69, 0xd2, 0x7c,
+	0xa5, 0x49, 0x9f, 0x2b, 0x4d, 0x7a, 0xe8, 0x05, 0x34, 0x79, 0x4a, 0x5d, 0xe4, 0x01, 0xc3, 0xfb,
+	0x27, 0xdc, 0x0d, 0x78, 0xb2, 0x77, 0xf3, 0x24, 0x8b, 0x88, 0x70, 0x1b, 0xc5, 0xd7, 0x7b, 0x3f,
+	0x03, 0x00, 0x69, 0xa4, 0xee, 0xb1, 0x17, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -99,6 +147,29 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LivenessPeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LivenessPeriodInBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.MinBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -119,6 +190,13 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.MinBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovParams(uint64(l))
+	if m.LivenessPeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.LivenessPeriodInBlocks))
+	}
 	return n
 }
 
@@ -157,6 +235,91 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessPeriodInBlocks", wireType)
+			}
+			m.LivenessPeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessPeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -7,17 +7,23 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -36,6 +42,12 @@ type Sequencer struct {
 	RollappIDs []string `protobuf:"bytes,3,rep,name=rollappIDs,proto3" json:"rollappIDs,omitempty"`
 	// description defines the descriptive terms for the sequencer.
 	Description Description `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	// tokens defines the bond of the sequencer, escrowed in the sequencer module account.
+	Tokens github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=tokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens"`
+	// status defines the status of the sequencer's bond.
+	Status BondStatus `protobuf:"varint,6,opt,name=status,proto3,enum=dymensionxyz.dymension.sequencer.BondStatus" json:"status,omitempty"`
+	// unbondTime defines, if unbonding, the time at which the unbonding will be completed.
+	UnbondTime time.Time `protobuf:"bytes,7,opt,name=unbondTime,proto3,stdtime" json:"unbondTime"`
 }
 
 func (m *Sequencer) Reset()         { *m = Sequencer{} }
@@ -99,6 +111,27 @@ func (m *Sequencer) GetDescription() Description {
 	return Description{}
 }
 
+func (m *Sequencer) GetTokens() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Sequencer) GetStatus() BondStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BondStatusUnspecified
+}
+
+func (m *Sequencer) GetUnbondTime() time.Time {
+	if m != nil {
+		return m.UnbondTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*Sequencer)(nil), "dymensionxyz.dymension.sequencer.Sequencer")
 }
@@ -108,28 +141,37 @@ func init() {
 }
 
 var fileDescriptor_17d99b644bf09274 = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x2d, 0x42,
-	0xb0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x14, 0xe0, 0x8a, 0x2a, 0x2a, 0xab, 0xf4, 0xe0,
-	0x1c, 0x3d, 0xb8, 0x3a, 0x29, 0x55, 0x6c, 0xc6, 0xa4, 0xa4, 0x16, 0x27, 0x17, 0x65, 0x16, 0x94,
-	0x80, 0x94, 0x82, 0x0d, 0x92, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x07, 0xf3, 0xf4,
-	0x21, 0x1c, 0x98, 0x54, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x3e, 0x98, 0x97, 0x54, 0x9a, 0xa6,
-	0x9f, 0x98, 0x57, 0x09, 0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x68, 0x01, 0xb1, 0x20, 0xa2,
-	0x4a, 0x4d, 0x4c, 0x5c, 0x9c, 0xc1, 0x30, 0xbb, 0x84, 0xb4, 0xb8, 0x04, 0xe0, 0x16, 0x3b, 0xa6,
-	0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x61, 0x88, 0x0b, 0x05,
-	0x71, 0xf1, 0xa4, 0x54, 0xe6, 0x66, 0xe6, 0x95, 0x04, 0x94, 0x26, 0x79, 0xa7, 0x56, 0x4a, 0x30,
-	0x29, 0x30, 0x6a, 0x70, 0x1b, 0x89, 0xe8, 0x41, 0x5c, 0xa0, 0x07, 0x73, 0x81, 0x9e, 0x63, 0x5e,
-	0xa5, 0x93, 0xc4, 0xa9, 0x2d, 0xba, 0x22, 0x50, 0x87, 0x26, 0x17, 0x55, 0x16, 0x94, 0xe4, 0xeb,
-	0x41, 0x74, 0x05, 0xa1, 0x98, 0x21, 0x24, 0xc7, 0xc5, 0x55, 0x94, 0x9f, 0x93, 0x93, 0x58, 0x50,
-	0xe0, 0xe9, 0x52, 0x2c, 0xc1, 0xac, 0xc0, 0xac, 0xc1, 0x19, 0x84, 0x24, 0x22, 0x14, 0xca, 0xc5,
-	0x8d, 0x14, 0x1c, 0x12, 0x2c, 0x60, 0x2b, 0x75, 0xf5, 0x08, 0x05, 0xac, 0x9e, 0x0b, 0x42, 0x93,
-	0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0xc8, 0xe6, 0x38, 0xf9, 0x9e, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x71, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xb2, 0x2d, 0x08, 0x8e, 0x7e, 0x05, 0x52, 0x5c, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27,
-	0xb1, 0x81, 0xfd, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x9a, 0x75, 0xc6, 0x16, 0x02,
-	0x00, 0x00,
+	// 470 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x4d, 0x6e, 0xd3, 0x40,
+	0x14, 0x8e, 0x49, 0x09, 0x64, 0x82, 0x10, 0xb2, 0xb2, 0x70, 0xbb, 0x70, 0x2c, 0x10, 0x92, 0x85,
+	0xc8, 0x0c, 0x4d, 0x4f, 0x50, 0x93, 0x0d, 0x42, 0x48, 0xc8, 0x2d, 0x1b, 0x36, 0x95, 0x7f, 0x06,
+	0x63, 0x35, 0x9e, 0x67, 0xfc, 0xc6, 0xa8, 0xc3, 0x29, 0x7a, 0x0e, 0xd6, 0x1c, 0xa2, 0x62, 0xd5,
+	0x25, 0x2b, 0x8a, 0x92, 0x23, 0x70, 0x01, 0xe4, 0xf1, 0x24, 0x31, 0x6d, 0xa5, 0xae, 0xec, 0xf7,
+	0xe6, 0xfb, 0xbe, 0xf9, 0xde, 0x37, 0x8f, 0x3c, 0x4b, 0x55, 0xc1, 0x05, 0xe6, 0x20, 0x18, 0xf2,
+	0x2f, 0x35, 0x17, 0x09, 0xaf, 0xb6, 0x7f, 0xb4, 0xac, 0x40, 0x82, 0xed, 0x6d, 0x40, 0x67, 0xea,
+	0x1b, 0xdd, 0x14, 0x74, 0x83, 0xdb, 0x7b, 0x7e, 0x9b, 0x4c, 0xca, 0x31, 0xa9, 0xf2, 0x52, 0x36,
+	0x50, 0x2d, 0x74, 0x3b, 0x2c, 0x06, 0x91, 0x9e, 0xa0, 0x8c, 0x64, 0x8d, 0x06, 0xb6, 0x9b, 0x00,
+	0x16, 0x80, 0x27, 0xba, 0x62, 0x6d, 0xb1, 0x3e, 0xca, 0x00, 0xb2, 0x05, 0x67, 0xba, 0x8a, 0xeb,
+	0x4f, 0x2c, 0x12, 0xca, 0x1c, 0x8d, 0x33, 0xc8, 0xa0, 0xa5, 0x34, 0x7f, 0xa6, 0x3b, 0xb9, 0x4e,
+	0x90, 0x79, 0xc1, 0x51, 0x46, 0x45, 0x69, 0x00, 0x6e, 0xab, 0xcf, 0xe2, 0x08, 0x39, 0xfb, 0xba,
+	0x1f, 0x73, 0x19, 0xed, 0xb3, 0x04, 0x72, 0xe3, 0xf9, 0xe9, 0xdf, 0x3e, 0x19, 0x1e, 0xad, 0xcd,
+	0xda, 0x2f, 0xc8, 0x93, 0x8d, 0xf3, 0xc3, 0x34, 0xad, 0x38, 0xa2, 0x63, 0x79, 0x96, 0x3f, 0x0c,
+	0x6f, 0xf4, 0xed, 0x90, 0x3c, 0x4a, 0x55, 0x91, 0x0b, 0xf9, 0xbe, 0x8e, 0xdf, 0x72, 0xe5, 0xdc,
+	0xf3, 0x2c, 0x7f, 0x34, 0x1b, 0xd3, 0xd6, 0x11, 0x5d, 0x3b, 0xa2, 0x87, 0x42, 0x05, 0xce, 0xcf,
+	0x1f, 0xd3, 0xb1, 0x99, 0x34, 0xa9, 0x54, 0x29, 0x81, 0xb6, 0xac, 0xf0, 0x3f, 0x0d, 0xdb, 0x25,
+	0xa4, 0x82, 0xc5, 0x22, 0x2a, 0xcb, 0x37, 0x73, 0x74, 0xfa, 0x5e, 0xdf, 0x1f, 0x86, 0x9d, 0x8e,
+	0xfd, 0x81, 0x8c, 0x3a, 0xb1, 0x3b, 0x3b, 0xfa, 0xca, 0x29, 0xbd, 0xeb, 0x01, 0xe9, 0x7c, 0x4b,
+	0x0a, 0x76, 0x2e, 0x7e, 0x4f, 0x7a, 0x61, 0x57, 0xc7, 0x4e, 0xc8, 0x40, 0xc2, 0x29, 0x17, 0xe8,
+	0xdc, 0xf7, 0xfa, 0xfe, 0x68, 0xb6, 0x4b, 0x8d, 0xd7, 0x26, 0x35, 0x6a, 0x52, 0xa3, 0xaf, 0x21,
+	0x17, 0xc1, 0xab, 0x86, 0xfd, 0xfd, 0x6a, 0xe2, 0x67, 0xb9, 0xfc, 0x5c, 0xc7, 0x34, 0x81, 0xc2,
+	0x3c, 0xa1, 0xf9, 0x4c, 0x31, 0x3d, 0x65, 0x52, 0x95, 0x1c, 0x35, 0x01, 0x43, 0x23, 0x6d, 0xcf,
+	0xc9, 0xa0, 0x5d, 0x03, 0x67, 0xe0, 0x59, 0xfe, 0xe3, 0xd9, 0xcb, 0xbb, 0x6d, 0x07, 0x20, 0xd2,
+	0x23, 0xcd, 0x09, 0x0d, 0xd7, 0x9e, 0x13, 0x52, 0x8b, 0x66, 0xa7, 0x8e, 0xf3, 0x82, 0x3b, 0x0f,
+	0x74, 0x00, 0x7b, 0x37, 0x32, 0x3f, 0x5e, 0x6f, 0x41, 0xf0, 0xb0, 0xf1, 0x7b, 0x7e, 0x35, 0xb1,
+	0xc2, 0x0e, 0x2f, 0x78, 0x77, 0xb1, 0x74, 0xad, 0xcb, 0xa5, 0x6b, 0xfd, 0x59, 0xba, 0xd6, 0xf9,
+	0xca, 0xed, 0x5d, 0xae, 0xdc, 0xde, 0xaf, 0x95, 0xdb, 0xfb, 0x78, 0xd0, 0x99, 0xab, 0xeb, 0x6f,
+	0x5b, 0xb0, 0xb3, 0xce, 0x76, 0xeb, 0x41, 0xe3, 0x81, 0xbe, 0xf8, 0xe0, 0xdf, 0x00, 0x9f, 0xc6,
+	0xa9, 0x1a, 0x6f, 0x03, 0x00, 0x00,
 }
 
 func (m *Sequencer) Marshal() (dAtA []byte, err error) {
@@ -152,6 +194,33 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSequencer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.Status != 0 {
+		i = encodeVarintSequencer(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSequencer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -226,6 +295,17 @@ func (m *Sequencer) Size() (n int) {
 	}
 	l = m.Description.Size()
 	n += 1 + l + sovSequencer(uint64(l))
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovSequencer(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovSequencer(uint64(m.Status))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondTime)
+	n += 1 + l + sovSequencer(uint64(l))
 	return n
 }
 
@@ -397,6 +477,92 @@ func (m *Sequencer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, types1.Coin{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UnbondTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSequencer(dAtA[iNdEx:])
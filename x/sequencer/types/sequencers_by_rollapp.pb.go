@@ -32,6 +32,11 @@ type SequencersByRollapp struct {
 	// list of sequencers' account address
 	// repeated string sequencers = 2;
 	Sequencers Sequencers `protobuf:"bytes,2,opt,name=sequencers,proto3" json:"sequencers"`
+	// proposer is the bech32-encoded address of the sequencer currently proposing the rollapp blocks.
+	Proposer string `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// lastUpdateHeight is the hub height of the last state update of the proposer,
+	// or of the proposer election if it did not update yet. Used for liveness tracking.
+	LastUpdateHeight int64 `protobuf:"varint,4,opt,name=lastUpdateHeight,proto3" json:"lastUpdateHeight,omitempty"`
 }
 
 func (m *SequencersByRollapp) Reset()         { *m = SequencersByRollapp{} }
@@ -81,6 +86,20 @@ func (m *SequencersByRollapp) GetSequencers() Sequencers {
 	return Sequencers{}
 }
 
+func (m *SequencersByRollapp) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *SequencersByRollapp) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
+	}
+	return 0
+}
+
 // Sequencers defines list of sequencers addresses.
 type Sequencers struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
@@ -136,22 +155,25 @@ func init() {
 }
 
 var fileDescriptor_f5a5805ac29a8f67 = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
+	// 279 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x4e, 0x2d, 0x2c, 0x4d, 0xcd, 0x4b, 0x4e, 0x2d, 0x42,
 	0xb0, 0x8a, 0xe3, 0x93, 0x2a, 0xe3, 0x8b, 0xf2, 0x73, 0x72, 0x12, 0x0b, 0x0a, 0xf4, 0x0a, 0x8a,
 	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0xe0, 0x1a, 0x2a, 0x2a, 0xab, 0xf4, 0xe0, 0x1c, 0x3d, 0xb8, 0x1e,
-	0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x62, 0x7d, 0x10, 0x0b, 0xa2, 0x4f, 0xa9, 0x9d, 0x91,
-	0x4b, 0x38, 0x18, 0x6e, 0xae, 0x53, 0x65, 0x10, 0xc4, 0x54, 0x21, 0x19, 0x2e, 0x4e, 0xa8, 0x05,
-	0x9e, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0xa1, 0x20, 0x2e, 0x2e, 0x84,
-	0x63, 0x24, 0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0x74, 0xf4, 0x08, 0x39, 0x41, 0x0f, 0xc9, 0x22,
-	0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0x90, 0x4c, 0x51, 0xd2, 0xe2, 0xe2, 0x42, 0xc8, 0x83, 0xec,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x4e, 0x2d, 0x96, 0x60, 0x54, 0x60, 0x06, 0xd9, 0x0f,
-	0x17, 0x70, 0xf2, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18,
-	0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xe3, 0xf4,
-	0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x64, 0xf7, 0x20, 0x05, 0x68, 0x05,
-	0x52, 0x90, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xc2, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x78, 0xcc, 0xbd, 0x09, 0x76, 0x01, 0x00, 0x00,
+	0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0xb0, 0x62, 0x7d, 0x10, 0x0b, 0xa2, 0x4f, 0xe9, 0x3c, 0x23,
+	0x97, 0x70, 0x30, 0xdc, 0x5c, 0xa7, 0xca, 0x20, 0x88, 0xa9, 0x42, 0x32, 0x5c, 0x9c, 0x50, 0x0b,
+	0x3c, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x10, 0x02, 0x42, 0x41, 0x5c, 0x5c, 0x08,
+	0xc7, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70, 0x1b, 0xe9, 0xe8, 0x11, 0x72, 0x82, 0x1e, 0x92, 0x45,
+	0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x99, 0x22, 0x24, 0xc5, 0xc5, 0x51, 0x50, 0x94, 0x5f,
+	0x90, 0x5f, 0x9c, 0x5a, 0x24, 0xc1, 0x0c, 0xb6, 0x10, 0xce, 0x17, 0xd2, 0xe2, 0x12, 0xc8, 0x49,
+	0x2c, 0x2e, 0x09, 0x2d, 0x48, 0x49, 0x2c, 0x49, 0xf5, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60,
+	0x51, 0x60, 0xd4, 0x60, 0x0e, 0xc2, 0x10, 0x57, 0xd2, 0xe2, 0xe2, 0x42, 0xd8, 0x03, 0xf2, 0x47,
+	0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0x71, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0x33, 0xc8, 0x1f, 0x70,
+	0x01, 0x27, 0xdf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
+	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4e, 0xcf,
+	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf6, 0x17, 0x82, 0xa3, 0x5f, 0x81,
+	0x14, 0x35, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x30, 0x35, 0x06, 0x0c, 0x00, 0xb8,
+	0xb2, 0x67, 0x8d, 0xbe, 0x01, 0x00, 0x00,
 }
 
 func (m *SequencersByRollapp) Marshal() (dAtA []byte, err error) {
@@ -174,6 +196,18 @@ func (m *SequencersByRollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintSequencersByRollapp(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintSequencersByRollapp(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.Sequencers.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -249,6 +283,13 @@ func (m *SequencersByRollapp) Size() (n int) {
 	}
 	l = m.Sequencers.Size()
 	n += 1 + l + sovSequencersByRollapp(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovSequencersByRollapp(uint64(l))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovSequencersByRollapp(uint64(m.LastUpdateHeight))
+	}
 	return n
 }
 
@@ -367,6 +408,57 @@ func (m *SequencersByRollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencersByRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencersByRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencersByRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencersByRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSequencersByRollapp(dAtA[iNdEx:])
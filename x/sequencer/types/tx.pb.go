@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +45,8 @@ type MsgCreateSequencer struct {
 	RollappId string `protobuf:"bytes,3,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
 	// description defines the descriptive terms for the sequencer.
 	Description Description `protobuf:"bytes,4,opt,name=description,proto3" json:"description"`
+	// bond defines the tokens the sequencer bonds. It must cover the min_bond param.
+	Bond types1.Coin `protobuf:"bytes,5,opt,name=bond,proto3" json:"bond"`
 }
 
 func (m *MsgCreateSequencer) Reset()         { *m = MsgCreateSequencer{} }
@@ -103,6 +110,13 @@ func (m *MsgCreateSequencer) GetDescription() Description {
 	return Description{}
 }
 
+func (m *MsgCreateSequencer) GetBond() types1.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types1.Coin{}
+}
+
 type MsgCreateSequencerResponse struct {
 }
 
@@ -139,38 +153,354 @@ func (m *MsgCreateSequencerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateSequencerResponse proto.InternalMessageInfo
 
+// MsgUnbond defines a SDK message for starting the unbonding of a sequencer's bond.
+type MsgUnbond struct {
+	// creator is the bech32-encoded address of the sequencer account which is the account that the message was sent from.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUnbond) Reset()         { *m = MsgUnbond{} }
+func (m *MsgUnbond) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbond) ProtoMessage()    {}
+func (*MsgUnbond) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{2}
+}
+func (m *MsgUnbond) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbond) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbond.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbond) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbond.Merge(m, src)
+}
+func (m *MsgUnbond) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbond) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbond.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbond proto.InternalMessageInfo
+
+func (m *MsgUnbond) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgUnbondResponse defines the Msg/Unbond response type.
+type MsgUnbondResponse struct {
+	// completionTime defines the time at which the unbonding will be completed.
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completionTime,proto3,stdtime" json:"completionTime"`
+}
+
+func (m *MsgUnbondResponse) Reset()         { *m = MsgUnbondResponse{} }
+func (m *MsgUnbondResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondResponse) ProtoMessage()    {}
+func (*MsgUnbondResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{3}
+}
+func (m *MsgUnbondResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondResponse.Merge(m, src)
+}
+func (m *MsgUnbondResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+// MsgRotateProposer defines a SDK message for replacing the proposer of a rollapp,
+// for example when the proposer went offline. The next inactive sequencer of the rollapp is elected.
+type MsgRotateProposer struct {
+	// creator is the bech32-encoded address of the rollapp creator.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollappId defines the rollapp whose proposer is rotated.
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *MsgRotateProposer) Reset()         { *m = MsgRotateProposer{} }
+func (m *MsgRotateProposer) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateProposer) ProtoMessage()    {}
+func (*MsgRotateProposer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{4}
+}
+func (m *MsgRotateProposer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateProposer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateProposer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateProposer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateProposer.Merge(m, src)
+}
+func (m *MsgRotateProposer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateProposer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateProposer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateProposer proto.InternalMessageInfo
+
+func (m *MsgRotateProposer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRotateProposer) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+// MsgRotateProposerResponse defines the Msg/RotateProposer response type.
+type MsgRotateProposerResponse struct {
+	// proposer is the bech32-encoded address of the new proposer.
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgRotateProposerResponse) Reset()         { *m = MsgRotateProposerResponse{} }
+func (m *MsgRotateProposerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateProposerResponse) ProtoMessage()    {}
+func (*MsgRotateProposerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{5}
+}
+func (m *MsgRotateProposerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateProposerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateProposerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateProposerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateProposerResponse.Merge(m, src)
+}
+func (m *MsgRotateProposerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateProposerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateProposerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateProposerResponse proto.InternalMessageInfo
+
+func (m *MsgRotateProposerResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// MsgLeaveProposer defines a SDK message for the proposer of a rollapp to hand over
+// its role to the next inactive sequencer of the rollapp.
+type MsgLeaveProposer struct {
+	// creator is the bech32-encoded address of the current proposer.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// rollappId defines the rollapp the proposer leaves.
+	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
+}
+
+func (m *MsgLeaveProposer) Reset()         { *m = MsgLeaveProposer{} }
+func (m *MsgLeaveProposer) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveProposer) ProtoMessage()    {}
+func (*MsgLeaveProposer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{6}
+}
+func (m *MsgLeaveProposer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveProposer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveProposer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveProposer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveProposer.Merge(m, src)
+}
+func (m *MsgLeaveProposer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveProposer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveProposer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveProposer proto.InternalMessageInfo
+
+func (m *MsgLeaveProposer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgLeaveProposer) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+// MsgLeaveProposerResponse defines the Msg/LeaveProposer response type.
+type MsgLeaveProposerResponse struct {
+	// proposer is the bech32-encoded address of the new proposer.
+	Proposer string `protobuf:"bytes,1,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgLeaveProposerResponse) Reset()         { *m = MsgLeaveProposerResponse{} }
+func (m *MsgLeaveProposerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveProposerResponse) ProtoMessage()    {}
+func (*MsgLeaveProposerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_26d679aa996065f1, []int{7}
+}
+func (m *MsgLeaveProposerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveProposerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveProposerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveProposerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveProposerResponse.Merge(m, src)
+}
+func (m *MsgLeaveProposerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveProposerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveProposerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveProposerResponse proto.InternalMessageInfo
+
+func (m *MsgLeaveProposerResponse) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgCreateSequencer)(nil), "dymensionxyz.dymension.sequencer.MsgCreateSequencer")
 	proto.RegisterType((*MsgCreateSequencerResponse)(nil), "dymensionxyz.dymension.sequencer.MsgCreateSequencerResponse")
+	proto.RegisterType((*MsgUnbond)(nil), "dymensionxyz.dymension.sequencer.MsgUnbond")
+	proto.RegisterType((*MsgUnbondResponse)(nil), "dymensionxyz.dymension.sequencer.MsgUnbondResponse")
+	proto.RegisterType((*MsgRotateProposer)(nil), "dymensionxyz.dymension.sequencer.MsgRotateProposer")
+	proto.RegisterType((*MsgRotateProposerResponse)(nil), "dymensionxyz.dymension.sequencer.MsgRotateProposerResponse")
+	proto.RegisterType((*MsgLeaveProposer)(nil), "dymensionxyz.dymension.sequencer.MsgLeaveProposer")
+	proto.RegisterType((*MsgLeaveProposerResponse)(nil), "dymensionxyz.dymension.sequencer.MsgLeaveProposerResponse")
 }
 
 func init() { proto.RegisterFile("dymension/sequencer/tx.proto", fileDescriptor_26d679aa996065f1) }
 
 var fileDescriptor_26d679aa996065f1 = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x3f, 0x4b, 0xc3, 0x40,
-	0x14, 0xcf, 0xd9, 0xa2, 0xf4, 0x2a, 0x08, 0xa1, 0x43, 0x0c, 0x25, 0x96, 0x82, 0xd0, 0xa5, 0x17,
-	0x68, 0x1d, 0x5d, 0xac, 0x2e, 0x22, 0x05, 0x89, 0xb8, 0xb8, 0x48, 0x93, 0x9c, 0x31, 0xd0, 0xe4,
-	0x9d, 0x77, 0x17, 0xe8, 0xb9, 0x3b, 0x0a, 0x7e, 0x18, 0x3f, 0x44, 0x71, 0xea, 0xe8, 0x24, 0xd2,
-	0x7e, 0x08, 0x57, 0x69, 0xd2, 0xb4, 0xd1, 0x0a, 0x05, 0xb7, 0xfc, 0x7e, 0xef, 0xfd, 0xfe, 0xe4,
-	0x25, 0xb8, 0xee, 0xab, 0x88, 0xc6, 0x22, 0x84, 0xd8, 0x16, 0xf4, 0x21, 0xa1, 0xb1, 0x47, 0xb9,
-	0x2d, 0x47, 0x84, 0x71, 0x90, 0xa0, 0x37, 0x96, 0xd3, 0x91, 0x7a, 0x24, 0x4b, 0x40, 0x96, 0xab,
-	0xe6, 0xe1, 0x5f, 0x7a, 0x9f, 0x0a, 0x8f, 0x87, 0x4c, 0xce, 0x57, 0x53, 0x23, 0x73, 0x3f, 0x00,
-	0x08, 0x86, 0xd4, 0x4e, 0x91, 0x9b, 0xdc, 0xd9, 0x83, 0x58, 0xe5, 0x23, 0x0f, 0x44, 0x04, 0xe2,
-	0x36, 0x45, 0x76, 0x06, 0x16, 0xa3, 0x5a, 0x00, 0x01, 0x64, 0xfc, 0xfc, 0x29, 0x63, 0x9b, 0x5f,
-	0x08, 0xeb, 0x7d, 0x11, 0x9c, 0x72, 0x3a, 0x90, 0xf4, 0x2a, 0x0f, 0xd5, 0x0d, 0xbc, 0xe3, 0xcd,
-	0x29, 0xe0, 0x06, 0x6a, 0xa0, 0x56, 0xc5, 0xc9, 0xa1, 0xee, 0xe0, 0x5d, 0x5f, 0x45, 0x61, 0x2c,
-	0x2f, 0x13, 0xf7, 0x82, 0x2a, 0x63, 0xab, 0x81, 0x5a, 0xd5, 0x4e, 0x8d, 0x64, 0x9d, 0x48, 0xde,
-	0x89, 0x9c, 0xc4, 0xaa, 0x67, 0xbc, 0xbd, 0xb6, 0x6b, 0x8b, 0x12, 0x1e, 0x57, 0x4c, 0x02, 0xc9,
-	0x54, 0xce, 0x0f, 0x0f, 0xbd, 0x8e, 0x2b, 0x1c, 0x86, 0xc3, 0x01, 0x63, 0xe7, 0xbe, 0x51, 0x4a,
-	0xf3, 0x56, 0x84, 0x7e, 0x8d, 0xab, 0x85, 0x1b, 0x18, 0xe5, 0x34, 0xb0, 0x4d, 0x36, 0x5d, 0x93,
-	0x9c, 0xad, 0x44, 0xbd, 0xf2, 0xf8, 0xe3, 0x40, 0x73, 0x8a, 0x3e, 0xcd, 0x3a, 0x36, 0xd7, 0x5f,
-	0xdc, 0xa1, 0x82, 0x41, 0x2c, 0x68, 0xe7, 0x19, 0xe1, 0x52, 0x5f, 0x04, 0xfa, 0x13, 0xc2, 0x7b,
-	0xbf, 0x8f, 0x73, 0xb4, 0x39, 0x7b, 0xdd, 0xd9, 0x3c, 0xfe, 0x8f, 0x2a, 0xef, 0xd3, 0xeb, 0x8f,
-	0xa7, 0x16, 0x9a, 0x4c, 0x2d, 0xf4, 0x39, 0xb5, 0xd0, 0xcb, 0xcc, 0xd2, 0x26, 0x33, 0x4b, 0x7b,
-	0x9f, 0x59, 0xda, 0x4d, 0x37, 0x08, 0xe5, 0x7d, 0xe2, 0x12, 0x0f, 0x22, 0xbb, 0x98, 0xb0, 0x02,
-	0xf6, 0xa8, 0xf8, 0x3b, 0x2a, 0x46, 0x85, 0xbb, 0x9d, 0x7e, 0xa7, 0xee, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x69, 0x74, 0xc3, 0x3a, 0xb2, 0x02, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0x50, 0x9a, 0x0d, 0x14, 0xb0, 0x72, 0x70, 0xac, 0xc8, 0x89, 0x2c, 0x55, 0xaa,
+	0x84, 0xba, 0x56, 0x13, 0x04, 0x12, 0x70, 0x21, 0xe5, 0x02, 0x6d, 0xa4, 0xca, 0xb4, 0x17, 0x2e,
+	0xc8, 0x76, 0x16, 0x63, 0x14, 0x7b, 0x16, 0xef, 0xa6, 0x8a, 0xb9, 0x20, 0x21, 0x71, 0xef, 0x87,
+	0x70, 0xe4, 0x23, 0x2a, 0x4e, 0x3d, 0x72, 0x02, 0x94, 0x7c, 0x08, 0xc8, 0xf6, 0xda, 0x49, 0x1c,
+	0xa0, 0x69, 0xb9, 0xe5, 0xcd, 0xbe, 0xf7, 0xe6, 0x65, 0x66, 0xa2, 0xa0, 0xe6, 0x20, 0xf2, 0x49,
+	0xc0, 0x3c, 0x08, 0x0c, 0x46, 0xde, 0x8d, 0x48, 0xe0, 0x90, 0xd0, 0xe0, 0x63, 0x4c, 0x43, 0xe0,
+	0x20, 0xb7, 0xf3, 0xd7, 0x71, 0xf4, 0x1e, 0xe7, 0x00, 0xe7, 0x54, 0x75, 0xeb, 0x4f, 0xfa, 0x01,
+	0x61, 0x4e, 0xe8, 0x51, 0x1e, 0x53, 0x13, 0x23, 0xb5, 0xe1, 0x02, 0xb8, 0x43, 0x62, 0x24, 0xc8,
+	0x1e, 0xbd, 0x36, 0xac, 0x20, 0xca, 0x9e, 0x1c, 0x60, 0x3e, 0xb0, 0x57, 0x09, 0x32, 0x52, 0x20,
+	0x9e, 0xea, 0x2e, 0xb8, 0x90, 0xd6, 0xe3, 0x4f, 0xa2, 0xda, 0x2a, 0x7a, 0x71, 0xcf, 0x27, 0x8c,
+	0x5b, 0x3e, 0x15, 0x04, 0x2d, 0x35, 0x31, 0x6c, 0x8b, 0x11, 0xe3, 0x64, 0xd7, 0x26, 0xdc, 0xda,
+	0x35, 0x1c, 0xf0, 0x44, 0x18, 0xfd, 0xf3, 0x1a, 0x92, 0xfb, 0xcc, 0xdd, 0x0b, 0x89, 0xc5, 0xc9,
+	0x8b, 0x2c, 0xb5, 0xac, 0xa0, 0xeb, 0x4e, 0x5c, 0x82, 0x50, 0x91, 0xda, 0xd2, 0x76, 0xd5, 0xcc,
+	0xa0, 0x6c, 0xa2, 0x1b, 0x83, 0xc8, 0xf7, 0x02, 0x7e, 0x38, 0xb2, 0xf7, 0x49, 0xa4, 0xac, 0xb5,
+	0xa5, 0xed, 0x5a, 0xa7, 0x8e, 0xd3, 0x20, 0x38, 0x0b, 0x82, 0x9f, 0x04, 0x51, 0x4f, 0xf9, 0xfa,
+	0x65, 0xa7, 0x2e, 0xbe, 0x85, 0x13, 0x46, 0x94, 0x03, 0x4e, 0x55, 0xe6, 0x82, 0x87, 0xdc, 0x44,
+	0xd5, 0x10, 0x86, 0x43, 0x8b, 0xd2, 0x67, 0x03, 0xa5, 0x9c, 0xf4, 0x9b, 0x15, 0xe4, 0x63, 0x54,
+	0x9b, 0x1b, 0xa2, 0x52, 0x49, 0x1a, 0xee, 0xe0, 0x8b, 0xd6, 0x81, 0x9f, 0xce, 0x44, 0xbd, 0xca,
+	0xd9, 0xf7, 0x56, 0xc9, 0x9c, 0xf7, 0x91, 0xbb, 0xa8, 0x62, 0x43, 0x30, 0x50, 0xae, 0x25, 0x7e,
+	0x0d, 0x2c, 0x72, 0xc6, 0x83, 0xc2, 0x62, 0x50, 0x78, 0x0f, 0xbc, 0x4c, 0x9b, 0x90, 0xf5, 0x26,
+	0x52, 0x97, 0xa7, 0x65, 0x12, 0x46, 0x21, 0x60, 0x44, 0xdf, 0x42, 0xd5, 0x3e, 0x73, 0x8f, 0x83,
+	0x98, 0xfa, 0xf7, 0x11, 0xea, 0x16, 0xba, 0x93, 0xd3, 0x32, 0xad, 0x7c, 0x80, 0x36, 0x1d, 0xf0,
+	0xe9, 0x90, 0xc4, 0xe1, 0x8e, 0x3c, 0x9f, 0x24, 0xaa, 0x5a, 0x47, 0x5d, 0x9a, 0xec, 0x51, 0xb6,
+	0xe2, 0xde, 0x46, 0x9c, 0xec, 0xf4, 0x47, 0x4b, 0x32, 0x0b, 0x5a, 0x7d, 0x3f, 0x69, 0x61, 0x02,
+	0xb7, 0x38, 0x39, 0x0c, 0x81, 0x02, 0xfb, 0xe7, 0x52, 0x17, 0x16, 0xb0, 0x56, 0x58, 0x80, 0xfe,
+	0x00, 0x35, 0x96, 0xcc, 0xf2, 0xdc, 0x2a, 0xda, 0xa0, 0xa2, 0x26, 0x5c, 0x73, 0xac, 0x3f, 0x47,
+	0xb7, 0xfb, 0xcc, 0x3d, 0x20, 0xd6, 0xc9, 0xff, 0x87, 0xb8, 0x8f, 0x94, 0xa2, 0xd7, 0x2a, 0x19,
+	0x3a, 0xbf, 0xca, 0xa8, 0xdc, 0x67, 0xae, 0xfc, 0x49, 0x42, 0xb7, 0x8a, 0x57, 0x7e, 0xef, 0xe2,
+	0x23, 0x5a, 0xde, 0xb6, 0xfa, 0xf8, 0x2a, 0xaa, 0x3c, 0xeb, 0x5b, 0xb4, 0x2e, 0x0e, 0xe4, 0xee,
+	0x4a, 0x3e, 0x29, 0x59, 0xed, 0x5e, 0x82, 0x9c, 0xf7, 0xfa, 0x28, 0xa1, 0xcd, 0xc2, 0x0d, 0xac,
+	0xe6, 0xb3, 0x28, 0x52, 0x1f, 0x5d, 0x41, 0x94, 0x87, 0xf8, 0x80, 0x6e, 0x2e, 0x5e, 0x40, 0x67,
+	0x25, 0xb7, 0x05, 0x8d, 0xfa, 0xf0, 0xf2, 0x9a, 0x2c, 0x40, 0xaf, 0x7f, 0x36, 0xd1, 0xa4, 0xf3,
+	0x89, 0x26, 0xfd, 0x9c, 0x68, 0xd2, 0xe9, 0x54, 0x2b, 0x9d, 0x4f, 0xb5, 0xd2, 0xb7, 0xa9, 0x56,
+	0x7a, 0xd9, 0x75, 0x3d, 0xfe, 0x66, 0x64, 0x63, 0x07, 0x7c, 0x63, 0xde, 0x7f, 0x06, 0x8c, 0xf1,
+	0xfc, 0x5f, 0x41, 0x44, 0x09, 0xb3, 0xd7, 0x93, 0x1f, 0x62, 0xf7, 0xf7, 0x00, 0x1d, 0x31, 0xd6,
+	0x77, 0x2e, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -186,6 +516,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateSequencer(ctx context.Context, in *MsgCreateSequencer, opts ...grpc.CallOption) (*MsgCreateSequencerResponse, error)
+	Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error)
+	RotateProposer(ctx context.Context, in *MsgRotateProposer, opts ...grpc.CallOption) (*MsgRotateProposerResponse, error)
+	LeaveProposer(ctx context.Context, in *MsgLeaveProposer, opts ...grpc.CallOption) (*MsgLeaveProposerResponse, error)
 }
 
 type msgClient struct {
@@ -205,9 +538,39 @@ func (c *msgClient) CreateSequencer(ctx context.Context, in *MsgCreateSequencer,
 	return out, nil
 }
 
+func (c *msgClient) Unbond(ctx context.Context, in *MsgUnbond, opts ...grpc.CallOption) (*MsgUnbondResponse, error) {
+	out := new(MsgUnbondResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.sequencer.Msg/Unbond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateProposer(ctx context.Context, in *MsgRotateProposer, opts ...grpc.CallOption) (*MsgRotateProposerResponse, error) {
+	out := new(MsgRotateProposerResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.sequencer.Msg/RotateProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LeaveProposer(ctx context.Context, in *MsgLeaveProposer, opts ...grpc.CallOption) (*MsgLeaveProposerResponse, error) {
+	out := new(MsgLeaveProposerResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.sequencer.Msg/LeaveProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateSequencer(context.Context, *MsgCreateSequencer) (*MsgCreateSequencerResponse, error)
+	Unbond(context.Context, *MsgUnbond) (*MsgUnbondResponse, error)
+	RotateProposer(context.Context, *MsgRotateProposer) (*MsgRotateProposerResponse, error)
+	LeaveProposer(context.Context, *MsgLeaveProposer) (*MsgLeaveProposerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -217,6 +580,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateSequencer(ctx context.Context, req *MsgCreateSequencer) (*MsgCreateSequencerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSequencer not implemented")
 }
+func (*UnimplementedMsgServer) Unbond(ctx context.Context, req *MsgUnbond) (*MsgUnbondResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unbond not implemented")
+}
+func (*UnimplementedMsgServer) RotateProposer(ctx context.Context, req *MsgRotateProposer) (*MsgRotateProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateProposer not implemented")
+}
+func (*UnimplementedMsgServer) LeaveProposer(ctx context.Context, req *MsgLeaveProposer) (*MsgLeaveProposerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveProposer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -240,6 +612,60 @@ func _Msg_CreateSequencer_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Unbond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbond)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unbond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.sequencer.Msg/Unbond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unbond(ctx, req.(*MsgUnbond))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateProposer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.sequencer.Msg/RotateProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateProposer(ctx, req.(*MsgRotateProposer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LeaveProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveProposer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.sequencer.Msg/LeaveProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveProposer(ctx, req.(*MsgLeaveProposer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.sequencer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -248,6 +674,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSequencer",
 			Handler:    _Msg_CreateSequencer_Handler,
 		},
+		{
+			MethodName: "Unbond",
+			Handler:    _Msg_Unbond_Handler,
+		},
+		{
+			MethodName: "RotateProposer",
+			Handler:    _Msg_RotateProposer_Handler,
+		},
+		{
+			MethodName: "LeaveProposer",
+			Handler:    _Msg_LeaveProposer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymension/sequencer/tx.proto",
@@ -273,6 +711,16 @@ func (m *MsgCreateSequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -335,10 +783,205 @@ func (m *MsgCreateSequencerResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
+func (m *MsgUnbond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateProposer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateProposer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveProposer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveProposer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveProposerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveProposerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveProposerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
@@ -366,6 +1009,8 @@ func (m *MsgCreateSequencer) Size() (n int) {
 	}
 	l = m.Description.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.Bond.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -378,6 +1023,90 @@ func (m *MsgCreateSequencerResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUnbond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateProposer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveProposer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLeaveProposerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -546,17 +1275,50 @@ func (m *MsgCreateSequencer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -617,6 +1379,563 @@ func (m *MsgCreateSequencerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUnbond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateProposer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateProposer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateProposerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateProposerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveProposer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveProposer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveProposerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveProposerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveProposerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
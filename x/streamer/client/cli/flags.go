@@ -9,6 +9,13 @@ const (
 	FlagStartTime       = "start-time"
 	FlagEpochIdentifier = "epoch-identifier"
 	FlagEpochs          = "epochs"
+	FlagAddEpochs       = "add-epochs"
+	FlagSponsored       = "sponsored"
+	FlagEmissionCurve   = "emission-curve"
+	FlagCliffEpochs     = "cliff-epochs"
+	FlagDecayFactor     = "decay-factor"
+	FlagDecayPeriod     = "decay-period"
+	FlagEpochWeights    = "epoch-weights"
 )
 
 // FlagSetCreateStream returns flags for creating gauges.
@@ -18,5 +25,11 @@ func FlagSetCreateStream() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.String(FlagEpochIdentifier, "day", "Epoch identifier to begin distribution (e.g. 'day', 'week')")
 	fs.Uint64(FlagEpochs, 365, "Total epochs to distribute tokens")
+	fs.Bool(FlagSponsored, false, "Distribute according to the gauge votes of the delegators")
+	fs.String(FlagEmissionCurve, "linear", "Emission curve of the stream (linear, cliff, exponential or table)")
+	fs.Uint64(FlagCliffEpochs, 0, "Epochs without emission of a cliff curve")
+	fs.String(FlagDecayFactor, "0.5", "Emission multiplier of each decay period of an exponential curve")
+	fs.Uint64(FlagDecayPeriod, 1, "Epochs between two decays of an exponential curve")
+	fs.String(FlagEpochWeights, "", "Comma separated weight of each epoch of a table curve")
 	return fs
 }
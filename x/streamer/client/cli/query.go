@@ -17,6 +17,10 @@ func GetQueryCmd() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdStreamByID)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdActiveStreams)
 	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdUpcomingStreams)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdVote)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdSponsoredDistribution)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdRollappGauge)
+	osmocli.AddQueryCmd(cmd, qcGetter, GetCmdEmissionSchedule)
 	return cmd
 }
 
@@ -62,3 +66,37 @@ func GetCmdUpcomingStreams() (*osmocli.QueryDescriptor, *types.UpcomingStreamsRe
 		Short: "Query upcoming streams",
 		Long:  `{{.Short}}`}, &types.UpcomingStreamsRequest{}
 }
+
+// GetCmdVote returns the gauge vote of a voter.
+func GetCmdVote() (*osmocli.QueryDescriptor, *types.VoteRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "vote [voter]",
+		Short: "Query the gauge vote of a voter",
+		Long:  `{{.Short}}`}, &types.VoteRequest{}
+}
+
+// GetCmdSponsoredDistribution returns the vote tally of the sponsored streams.
+func GetCmdSponsoredDistribution() (*osmocli.QueryDescriptor, *types.SponsoredDistributionRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "sponsored-distribution",
+		Short: "Query the gauge vote tally the sponsored streams distribute by",
+		Long:  `{{.Short}}`}, &types.SponsoredDistributionRequest{}
+}
+
+// GetCmdRollappGauge returns the gauge of a rollapp.
+func GetCmdRollappGauge() (*osmocli.QueryDescriptor, *types.RollappGaugeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "rollapp-gauge [rollapp-id]",
+		Short: "Query the gauge of a rollapp",
+		Long:  `{{.Short}}`}, &types.RollappGaugeRequest{}
+}
+
+// GetCmdEmissionSchedule returns the projected emission of a stream.
+func GetCmdEmissionSchedule() (*osmocli.QueryDescriptor, *types.EmissionScheduleRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "emission-schedule [id]",
+		Short: "Query the projected emission of the remaining epochs of a stream",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} emission-schedule 1
+`}, &types.EmissionScheduleRequest{}
+}
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		NewCreateStreamCmd(),
+		NewTerminateStreamCmd(),
+		NewAddToStreamCmd(),
+		NewVoteCmd(),
+		NewRevokeVoteCmd(),
+		NewSetRollappGaugeRecipientCmd(),
+	)
+
+	return cmd
+}
+
+// NewCreateStreamCmd broadcasts a CreateStream message.
+func NewCreateStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-stream gaugeIds weights reward [flags]",
+		Short: "create a stream of incentives rewards over a period of time, funded by the sender",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			records, err := parseRecords(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseStartTime(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochIdentifier, err := cmd.Flags().GetString(FlagEpochIdentifier)
+			if err != nil {
+				return err
+			}
+
+			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			sponsored, err := cmd.Flags().GetBool(FlagSponsored)
+			if err != nil {
+				return err
+			}
+
+			emissionCurve, err := parseEmissionCurve(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateStream(clientCtx.GetFromAddress(), coins, records, startTime, epochIdentifier, epochs, sponsored, emissionCurve)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetCreateStream())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTerminateStreamCmd broadcasts a TerminateStream message.
+func NewTerminateStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminate-stream streamID [flags]",
+		Short: "terminate a stream owned by the sender and refund its undistributed coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTerminateStream(clientCtx.GetFromAddress(), streamID)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAddToStreamCmd broadcasts an AddToStream message.
+func NewAddToStreamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-stream streamID reward [flags]",
+		Short: "add coins to a stream owned by the sender and optionally extend its distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			epochs, err := cmd.Flags().GetUint64(FlagAddEpochs)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddToStream(clientCtx.GetFromAddress(), streamID, coins, epochs)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagAddEpochs, 0, "Number of epochs to extend the distribution by")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewVoteCmd broadcasts a Vote message.
+func NewVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote gaugeIds weights [flags]",
+		Short: "split the voting power of the sender's delegations over gauges for the sponsored streams",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			records, err := parseRecords(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVote(clientCtx.GetFromAddress(), records)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeVoteCmd broadcasts a RevokeVote message.
+func NewRevokeVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-vote [flags]",
+		Short: "revoke the gauge vote of the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeVote(clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetRollappGaugeRecipientCmd broadcasts a SetRollappGaugeRecipient message.
+func NewSetRollappGaugeRecipientCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rollapp-gauge-recipient rollappID [recipient] [flags]",
+		Short: "designate the recipient of the rollapp gauge rewards, the rollapp proposer is rewarded without a recipient",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var recipient string
+			if len(args) == 2 {
+				recipient = args[1]
+			}
+
+			msg := types.NewMsgSetRollappGaugeRecipient(clientCtx.GetFromAddress(), args[0], recipient)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
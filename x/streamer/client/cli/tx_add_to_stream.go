@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// NewCmdSubmitAddToStreamProposal broadcasts an AddToStream proposal.
+func NewCmdSubmitAddToStreamProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-stream-proposal streamID reward [flags]",
+		Short: "proposal to add coins to an existing stream and optionally extend its distribution",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			streamID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			proposal, deposit, err := parseProposal(cmd)
+			if err != nil {
+				return err
+			}
+
+			epochs, err := cmd.Flags().GetUint64(FlagAddEpochs)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAddToStreamProposal(proposal.Title, proposal.Description, streamID, coins, epochs)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The proposal title")
+	cmd.Flags().String(govcli.FlagDescription, "", "The proposal description")
+	cmd.Flags().String(govcli.FlagDeposit, "", "The proposal deposit")
+	cmd.Flags().Uint64(FlagAddEpochs, 0, "Number of epochs to extend the distribution by")
+
+	return cmd
+}
@@ -1,10 +1,6 @@
 package cli
 
 import (
-	"errors"
-	"strconv"
-	"time"
-
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/spf13/cobra"
@@ -43,20 +39,10 @@ func NewCmdSubmitCreateStreamProposal() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
-			timeStr, err := cmd.Flags().GetString(FlagStartTime)
+			startTime, err := parseStartTime(cmd)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
-				return errors.New("invalid start time format")
-			}
 
 			epochIdentifier, err := cmd.Flags().GetString(FlagEpochIdentifier)
 			if err != nil {
@@ -68,7 +54,17 @@ func NewCmdSubmitCreateStreamProposal() *cobra.Command {
 				return err
 			}
 
-			content := types.NewCreateStreamProposal(proposal.Title, proposal.Description, coins, records, startTime, epochIdentifier, epochs)
+			sponsored, err := cmd.Flags().GetBool(FlagSponsored)
+			if err != nil {
+				return err
+			}
+
+			emissionCurve, err := parseEmissionCurve(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewCreateStreamProposal(proposal.Title, proposal.Description, coins, records, startTime, epochIdentifier, epochs, sponsored, emissionCurve)
 			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
 			if err != nil {
 				return err
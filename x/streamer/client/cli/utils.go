@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/x/streamer/types"
@@ -51,3 +54,65 @@ func parseProposal(cmd *cobra.Command) (osmoutils.Proposal, sdk.Coins, error) {
 	}
 	return *proposal, deposit, nil
 }
+
+// parseStartTime parses the start time flag, given either as a unix timestamp or as an RFC3339 time
+func parseStartTime(cmd *cobra.Command) (time.Time, error) {
+	timeStr, err := cmd.Flags().GetString(FlagStartTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if timeStr == "" { // empty start time
+		return time.Unix(0, 0), nil
+	} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
+		return time.Unix(timeUnix, 0), nil
+	} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
+		return timeRFC, nil
+	}
+	// invalid input
+	return time.Time{}, errors.New("invalid start time format")
+}
+
+// parseEmissionCurve parses the emission curve flags, streams distribute linearly without a curve
+func parseEmissionCurve(cmd *cobra.Command) (*types.EmissionCurve, error) {
+	curveType, err := cmd.Flags().GetString(FlagEmissionCurve)
+	if err != nil {
+		return nil, err
+	}
+
+	switch curveType {
+	case "", "linear":
+		return nil, nil
+	case "cliff":
+		cliffEpochs, err := cmd.Flags().GetUint64(FlagCliffEpochs)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewCliffEmissionCurve(cliffEpochs), nil
+	case "exponential":
+		decayFactorStr, err := cmd.Flags().GetString(FlagDecayFactor)
+		if err != nil {
+			return nil, err
+		}
+		decayFactor, err := sdk.NewDecFromStr(decayFactorStr)
+		if err != nil {
+			return nil, err
+		}
+		decayPeriod, err := cmd.Flags().GetUint64(FlagDecayPeriod)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewExponentialEmissionCurve(decayFactor, decayPeriod), nil
+	case "table":
+		weightsStr, err := cmd.Flags().GetString(FlagEpochWeights)
+		if err != nil {
+			return nil, err
+		}
+		weights, err := osmoutils.ParseSdkIntFromString(weightsStr, ",")
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTableEmissionCurve(weights), nil
+	}
+	// invalid input
+	return nil, fmt.Errorf("invalid emission curve: %s", curveType)
+}
@@ -44,7 +44,11 @@ func (k Keeper) DistributeByWeights(ctx sdk.Context, coins sdk.Coins, distrInfo
 			}
 
 			allocatedCoin := sdk.Coin{Denom: coin.Denom, Amount: allocatingAmount}
-			err = k.ik.AddToGaugeRewards(ctx, k.ak.GetModuleAddress(types.ModuleName), sdk.NewCoins(allocatedCoin), record.GaugeId)
+			if rollappGauge, found := k.GetRollappGauge(ctx, record.GaugeId); found {
+				err = k.distributeToRollapp(ctx, rollappGauge, sdk.NewCoins(allocatedCoin))
+			} else {
+				err = k.ik.AddToGaugeRewards(ctx, k.ak.GetModuleAddress(types.ModuleName), sdk.NewCoins(allocatedCoin), record.GaugeId)
+			}
 			if err != nil {
 				logger.Error("failed to add to gauge rewards", "error", err.Error())
 				continue
@@ -73,23 +77,19 @@ func (k Keeper) Distribute(ctx sdk.Context, streams []types.Stream) (sdk.Coins,
 // distributeStream runs the distribution logic for a stream, and adds the sends to
 // the distrInfo struct. It also updates the stream for the distribution.
 func (k Keeper) distributeStream(ctx sdk.Context, stream types.Stream) (sdk.Coins, error) {
-	totalDistrCoins := sdk.NewCoins()
 	remainCoins := stream.Coins.Sub(stream.DistributedCoins...)
-	remainEpochs := uint64(stream.NumEpochsPaidOver - stream.FilledEpochs)
+	totalDistrCoins := stream.NextEpochEmission(remainCoins)
 
-	for _, coin := range remainCoins {
-		epochAmt := coin.Amount.Quo(sdk.NewInt(int64(remainEpochs)))
-		if epochAmt.IsPositive() {
-			totalDistrCoins = totalDistrCoins.Add(sdk.Coin{Denom: coin.Denom, Amount: epochAmt})
+	// epochs without emission, like the cliff of a stream, only count as filled
+	if !totalDistrCoins.Empty() {
+		var err error
+		totalDistrCoins, err = k.DistributeByWeights(ctx, totalDistrCoins, stream.DistributeTo)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	totalDistrCoins, err := k.DistributeByWeights(ctx, totalDistrCoins, stream.DistributeTo)
-	if err != nil {
-		return nil, err
-	}
-
-	err = k.updateStreamPostDistribute(ctx, stream, totalDistrCoins)
+	err := k.updateStreamPostDistribute(ctx, stream, totalDistrCoins)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +106,7 @@ func (k Keeper) distributeStream(ctx sdk.Context, stream types.Stream) (sdk.Coin
 
 // updateStreamPostDistribute increments the stream's filled epochs field.
 // Also adds the coins that were just distributed to the stream's distributed coins field.
+// A finished stream funded by an owner refunds its undistributed coins.
 func (k Keeper) updateStreamPostDistribute(ctx sdk.Context, stream types.Stream, newlyDistributedCoins sdk.Coins) error {
 	stream.FilledEpochs += 1
 	stream.DistributedCoins = stream.DistributedCoins.Add(newlyDistributedCoins...)
@@ -113,11 +114,15 @@ func (k Keeper) updateStreamPostDistribute(ctx sdk.Context, stream types.Stream,
 		return err
 	}
 
-	// Check if stream has completed its distribution
+	// Check if stream has completed its distribution. The coins left undistributed, like the shares of
+	// missing gauges, are refunded to the owner
 	if stream.FilledEpochs >= stream.NumEpochsPaidOver {
 		if err := k.moveActiveStreamToFinishedStream(ctx, stream); err != nil {
 			return err
 		}
+		if err := k.refundStreamOwner(ctx, stream); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+func (suite *KeeperTestSuite) TestEmissionCurves() {
+	testCases := []struct {
+		name     string
+		curve    *types.EmissionCurve
+		coins    int64
+		epochs   uint64
+		schedule []int64
+	}{
+		{
+			name:     "linear",
+			coins:    3000,
+			epochs:   3,
+			schedule: []int64{1000, 1000, 1000},
+		},
+		{
+			name:     "cliff followed by linear",
+			curve:    types.NewCliffEmissionCurve(1),
+			coins:    3000,
+			epochs:   4,
+			schedule: []int64{0, 1000, 1000, 1000},
+		},
+		{
+			name:     "halving",
+			curve:    types.NewExponentialEmissionCurve(sdk.NewDecWithPrec(5, 1), 1),
+			coins:    1500,
+			epochs:   4,
+			schedule: []int64{800, 400, 200, 100},
+		},
+		{
+			name:     "halving every two epochs",
+			curve:    types.NewExponentialEmissionCurve(sdk.NewDecWithPrec(5, 1), 2),
+			coins:    1400,
+			epochs:   6,
+			schedule: []int64{400, 400, 200, 200, 100, 100},
+		},
+		{
+			name:     "epoch table",
+			curve:    types.NewTableEmissionCurve([]sdk.Int{sdk.NewInt(1), sdk.ZeroInt(), sdk.NewInt(3)}),
+			coins:    1000,
+			epochs:   3,
+			schedule: []int64{250, 0, 750},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.CreateGauge())
+			suite.Require().NoError(suite.CreateGauge())
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin("stake", tc.coins))
+			streamID, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Time{}, "day", tc.epochs, false, tc.curve)
+			suite.Require().NoError(err)
+
+			// the projection covers all the epochs of the stream
+			res, err := suite.querier.EmissionSchedule(sdk.WrapSDKContext(suite.Ctx), &types.EmissionScheduleRequest{Id: streamID})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Schedule, len(tc.schedule))
+			for i, emission := range res.Schedule {
+				suite.Require().Equal(uint64(i+1), emission.Epoch)
+				suite.Require().Equal(sdk.NewInt(tc.schedule[i]), emission.Coins.AmountOf("stake"), "epoch %d", i+1)
+			}
+
+			// the distribution follows the projection
+			stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.App.StreamerKeeper.MoveUpcomingStreamToActiveStream(suite.Ctx, *stream))
+			for i, amount := range tc.schedule {
+				stream, err = suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+				suite.Require().NoError(err)
+				distributed, err := suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewInt(amount), distributed.AmountOf("stake"), "epoch %d", i+1)
+			}
+			stream, err = suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(coins, stream.DistributedCoins)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEmissionCurveValidation() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+
+	invalidCurves := []*types.EmissionCurve{
+		types.NewCliffEmissionCurve(3),
+		types.NewExponentialEmissionCurve(sdk.ZeroDec(), 1),
+		types.NewExponentialEmissionCurve(sdk.NewDec(2), 1),
+		types.NewExponentialEmissionCurve(sdk.NewDecWithPrec(5, 1), 0),
+		types.NewTableEmissionCurve([]sdk.Int{sdk.NewInt(1), sdk.NewInt(1)}),
+		types.NewTableEmissionCurve([]sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}),
+	}
+	for _, curve := range invalidCurves {
+		_, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Time{}, "day", 3, false, curve)
+		suite.Require().ErrorIs(err, types.ErrInvalidEmissionCurve, "curve %v", curve)
+	}
+
+	// the epochs of a table curve can't be extended, but coins can be added to them
+	curve := types.NewTableEmissionCurve([]sdk.Int{sdk.NewInt(1), sdk.NewInt(2), sdk.NewInt(3)})
+	streamID, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Time{}, "day", 3, false, curve)
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, coins, 1)
+	suite.Require().ErrorIs(err, types.ErrInvalidEmissionCurve)
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, coins, 0)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestEmissionCurveLongStreams() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000))
+
+	// a table curve is bounded
+	weights := make([]sdk.Int, types.MaxEpochWeights+1)
+	for i := range weights {
+		weights[i] = sdk.OneInt()
+	}
+	_, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Time{}, "day", uint64(len(weights)), false, types.NewTableEmissionCurve(weights))
+	suite.Require().ErrorIs(err, types.ErrInvalidEmissionCurve)
+
+	// the emission of a stream paid over many epochs doesn't depend on its remaining epochs, and its
+	// projection is capped
+	curve := types.NewExponentialEmissionCurve(sdk.NewDecWithPrec(5, 1), 1000)
+	streamID, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Time{}, "day", 1<<62, false, curve)
+	suite.Require().NoError(err)
+	res, err := suite.querier.EmissionSchedule(sdk.WrapSDKContext(suite.Ctx), &types.EmissionScheduleRequest{Id: streamID})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Schedule, types.MaxEmissionScheduleEpochs)
+	// the first period weighs as much as all the following ones, each epoch of it distributes a 2000th
+	suite.Require().Equal(sdk.NewInt(500), res.Schedule[0].Coins.AmountOf("stake"))
+}
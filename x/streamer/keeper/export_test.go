@@ -30,3 +30,8 @@ func (k Keeper) MoveUpcomingStreamToActiveStream(ctx sdk.Context, stream types.S
 func (k Keeper) MoveActiveStreamToFinishedStream(ctx sdk.Context, stream types.Stream) error {
 	return k.moveActiveStreamToFinishedStream(ctx, stream)
 }
+
+// SetStream sets the stream inside the store.
+func (k Keeper) SetStream(ctx sdk.Context, stream *types.Stream) error {
+	return k.setStream(ctx, stream)
+}
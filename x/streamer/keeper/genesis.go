@@ -24,13 +24,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 	k.SetLastStreamID(ctx, genState.LastStreamId)
+
+	tally := k.GetSponsoredDistribution(ctx)
+	for _, vote := range genState.Votes {
+		if err := k.setVote(ctx, vote); err != nil {
+			panic(err)
+		}
+		tally = tally.AddVote(vote, true)
+	}
+	if err := k.setSponsoredDistribution(ctx, tally); err != nil {
+		panic(err)
+	}
+
+	for _, rollappGauge := range genState.RollappGauges {
+		if err := k.setRollappGauge(ctx, rollappGauge); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the x/streamer module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:       k.GetParams(ctx),
-		Streams:      k.GetNotFinishedStreams(ctx),
-		LastStreamId: k.GetLastStreamID(ctx),
+		Params:        k.GetParams(ctx),
+		Streams:       k.GetNotFinishedStreams(ctx),
+		LastStreamId:  k.GetLastStreamID(ctx),
+		Votes:         k.GetVotes(ctx),
+		RollappGauges: k.GetRollappGauges(ctx),
 	}
 }
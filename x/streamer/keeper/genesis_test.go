@@ -43,7 +43,7 @@ func TestStreamerExportGenesis(t *testing.T) {
 			},
 		},
 	}
-	streamID, err := app.StreamerKeeper.CreateStream(ctx, coins, &distr, startTime, "day", 30)
+	streamID, err := app.StreamerKeeper.CreateStream(ctx, coins, &distr, startTime, "day", 30, false, nil)
 	require.NoError(t, err)
 
 	// export genesis using default configurations
@@ -103,7 +103,7 @@ func TestStreamerInitGenesis(t *testing.T) {
 
 	// initialize genesis with specified parameter, the stream created earlier, and lockable durations
 	app.StreamerKeeper.InitGenesis(ctx, types.GenesisState{
-		Params:       types.Params{},
+		Params:       types.DefaultParams(),
 		Streams:      []types.Stream{stream},
 		LastStreamId: 1,
 	})
@@ -95,6 +95,62 @@ func (q Querier) UpcomingStreams(goCtx context.Context, req *types.UpcomingStrea
 	return &types.UpcomingStreamsResponse{Data: streams, Pagination: pageRes}, nil
 }
 
+// Vote returns the gauge vote of the voter.
+func (q Querier) Vote(goCtx context.Context, req *types.VoteRequest) (*types.VoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	voter, err := sdk.AccAddressFromBech32(req.Voter)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	vote, found := q.Keeper.GetVote(ctx, voter)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrVoteNotFound.Error())
+	}
+
+	return &types.VoteResponse{Vote: vote}, nil
+}
+
+// SponsoredDistribution returns the tally of the gauge votes the sponsored streams distribute by.
+func (q Querier) SponsoredDistribution(goCtx context.Context, _ *types.SponsoredDistributionRequest) (*types.SponsoredDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.SponsoredDistributionResponse{Distribution: q.Keeper.GetSponsoredDistribution(ctx)}, nil
+}
+
+// RollappGauge returns the gauge of the rollapp.
+func (q Querier) RollappGauge(goCtx context.Context, req *types.RollappGaugeRequest) (*types.RollappGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rollappGauge, found := q.Keeper.GetRollappGaugeByRollapp(ctx, req.RollappId)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrRollappGaugeNotFound.Error())
+	}
+
+	return &types.RollappGaugeResponse{RollappGauge: rollappGauge}, nil
+}
+
+// EmissionSchedule returns the projected emission of the remaining epochs of the stream.
+func (q Querier) EmissionSchedule(goCtx context.Context, req *types.EmissionScheduleRequest) (*types.EmissionScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	stream, err := q.Keeper.GetStreamByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmissionScheduleResponse{Schedule: stream.EmissionSchedule()}, nil
+}
+
 // getStreamFromIDJsonBytes returns streams from the json bytes of streamIDs.
 func (q Querier) getStreamFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Stream, error) {
 	streams := []types.Stream{}
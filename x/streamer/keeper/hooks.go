@@ -5,7 +5,10 @@ import (
 	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Hooks is the wrapper struct for the streamer keeper.
@@ -21,6 +24,32 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// RollappHooks is the wrapper struct creating the gauges of the registered rollapps.
+type RollappHooks struct {
+	rollapptypes.BaseRollappHook
+	k Keeper
+}
+
+var _ rollapptypes.RollappHooks = RollappHooks{}
+
+// RollappHooks returns the rollapp hook wrapper struct.
+func (k Keeper) RollappHooks() RollappHooks {
+	return RollappHooks{k: k}
+}
+
+// StakingHooks is the wrapper struct keeping the voting power of the gauge votes in sync with the delegations.
+// It references the keeper, as the staking hooks are registered before the streamer keeper is created.
+type StakingHooks struct {
+	k *Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hook wrapper struct.
+func (k *Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                 epoch hooks                                */
 /* -------------------------------------------------------------------------- */
@@ -44,12 +73,29 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 
 	// distribute due to epoch event
 	streams = k.GetActiveStreams(ctx)
+	var sponsoredDistr *types.DistrInfo
 	distrStreams := []types.Stream{}
 	for _, stream := range streams {
 		// begin distribution if it's correct epoch
 		if epochIdentifier != stream.DistrEpochIdentifier {
 			continue
 		}
+		// sponsored streams follow the gauge votes, and keep their records until there are any.
+		// The tally is recomputed from the current voting power of the votes before the first of them distributes
+		if stream.Sponsored && sponsoredDistr == nil {
+			distr, err := k.UpdateSponsoredDistribution(ctx)
+			if err != nil {
+				return err
+			}
+			sponsoredDistr = &distr
+		}
+		if stream.Sponsored && sponsoredDistr.TotalWeight.IsPositive() {
+			distr := *sponsoredDistr
+			stream.DistributeTo = &distr
+			if err := k.setStream(ctx, &stream); err != nil {
+				return err
+			}
+		}
 		distrStreams = append(distrStreams, stream)
 	}
 
@@ -99,3 +145,77 @@ func (h Hooks) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint
 // AfterSwap hook is a noop.
 func (h Hooks) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                rollapp hooks                               */
+/* -------------------------------------------------------------------------- */
+
+// AfterRollappRegistered creates the gauge of the rollapp.
+func (h RollappHooks) AfterRollappRegistered(ctx sdk.Context, rollappID string) error {
+	_, err := h.k.CreateRollappGauge(ctx, rollappID)
+	return err
+}
+
+// AfterRollappDeregistered drops the recipient designated by the creator of the rollapp.
+// The gauge is kept, as streams may still distribute to it.
+func (h RollappHooks) AfterRollappDeregistered(ctx sdk.Context, rollappID string) error {
+	if _, found := h.k.GetRollappGaugeByRollapp(ctx, rollappID); !found {
+		return nil
+	}
+	return h.k.SetRollappGaugeRecipient(ctx, rollappID, "")
+}
+
+/* -------------------------------------------------------------------------- */
+/*                                staking hooks                               */
+/* -------------------------------------------------------------------------- */
+
+// AfterDelegationModified updates the voting power of the delegator's vote.
+func (h StakingHooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.UpdateVotingPower(ctx, delAddr, nil)
+}
+
+// BeforeDelegationRemoved updates the voting power of the delegator's vote without the removed delegation.
+func (h StakingHooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.UpdateVotingPower(ctx, delAddr, valAddr)
+}
+
+// AfterValidatorCreated hook is a noop.
+func (h StakingHooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorModified hook is a noop.
+func (h StakingHooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorRemoved hook is a noop.
+func (h StakingHooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBonded hook is a noop.
+func (h StakingHooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// AfterValidatorBeginUnbonding hook is a noop.
+func (h StakingHooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationCreated hook is a noop.
+func (h StakingHooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeDelegationSharesModified hook is a noop.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return nil
+}
+
+// BeforeValidatorSlashed hook is a noop. The voting power of the slashed delegators is recomputed before the
+// sponsored streams distribute.
+func (h StakingHooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	return nil
+}
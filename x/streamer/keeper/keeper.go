@@ -12,6 +12,7 @@ import (
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -21,13 +22,17 @@ type Keeper struct {
 	storeKey   storetypes.StoreKey
 	paramSpace paramtypes.Subspace
 	bk         types.BankKeeper
+	dk         types.DistrKeeper
 	ek         types.EpochKeeper
 	ak         types.AccountKeeper
 	ik         types.IncentivesKeeper
+	sk         types.StakingKeeper
+	rk         types.RollappKeeper
+	seqk       types.SequencerKeeper
 }
 
 // NewKeeper returns a new instance of the incentive module keeper struct.
-func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, ek types.EpochKeeper, ak types.AccountKeeper, ik types.IncentivesKeeper) *Keeper {
+func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, bk types.BankKeeper, dk types.DistrKeeper, ek types.EpochKeeper, ak types.AccountKeeper, ik types.IncentivesKeeper, sk types.StakingKeeper, rk types.RollappKeeper, seqk types.SequencerKeeper) *Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
@@ -36,9 +41,13 @@ func NewKeeper(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace, bk
 		storeKey:   storeKey,
 		paramSpace: paramSpace,
 		bk:         bk,
+		dk:         dk,
 		ek:         ek,
 		ak:         ak,
 		ik:         ik,
+		sk:         sk,
+		rk:         rk,
+		seqk:       seqk,
 	}
 }
 
@@ -48,7 +57,24 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 }
 
 // CreateStream creates a stream and sends coins to the stream.
-func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.DistrInfo, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64) (uint64, error) {
+// The coins must already sit unallocated in the module account.
+// A sponsored stream distributes according to the gauge votes of the delegators once there are any.
+// A stream without emission curve distributes linearly.
+func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.DistrInfo, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, sponsored bool, emissionCurve *types.EmissionCurve) (uint64, error) {
+	return k.createStream(ctx, nil, coins, distrInfo, startTime, epochIdentifier, numEpochsPaidOver, sponsored, emissionCurve)
+}
+
+// CreateOwnedStream creates a stream funded by the owner. The coins are escrowed into the module account,
+// and the undistributed remainder is refunded to the owner once the stream is terminated.
+// The owner pays the stream creation fee on top of the escrowed coins.
+func (k Keeper) CreateOwnedStream(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, distrInfo *types.DistrInfo, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, sponsored bool, emissionCurve *types.EmissionCurve) (uint64, error) {
+	if err := k.chargeCreateStreamFee(ctx, owner); err != nil {
+		return 0, err
+	}
+	return k.createStream(ctx, owner, coins, distrInfo, startTime, epochIdentifier, numEpochsPaidOver, sponsored, emissionCurve)
+}
+
+func (k Keeper) createStream(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, distrInfo *types.DistrInfo, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, sponsored bool, emissionCurve *types.EmissionCurve) (uint64, error) {
 	if !coins.IsAllPositive() {
 		return 0, fmt.Errorf("all coins %s must be positive", coins)
 	}
@@ -57,14 +83,6 @@ func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.
 		return 0, err
 	}
 
-	//TODO: it's better to check only the denoms of the requested coins. No need to itereate entire balance.
-	moduleBalance := k.bk.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
-	alreadyAllocatedCoins := k.GetModuleToDistributeCoins(ctx)
-
-	if !coins.IsAllLTE(moduleBalance.Sub(alreadyAllocatedCoins...)) {
-		return 0, fmt.Errorf("insufficient module balance to distribute coins")
-	}
-
 	if (k.ek.GetEpochInfo(ctx, epochIdentifier) == epochstypes.EpochInfo{}) {
 		return 0, fmt.Errorf("epoch identifier does not exist: %s", epochIdentifier)
 	}
@@ -73,6 +91,18 @@ func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.
 		return 0, fmt.Errorf("numEpochsPaidOver must be greater than 0")
 	}
 
+	if err := types.ValidateEmissionCurve(emissionCurve, numEpochsPaidOver); err != nil {
+		return 0, err
+	}
+
+	if err := k.fundStream(ctx, owner, coins); err != nil {
+		return 0, err
+	}
+
+	var ownerAddr string
+	if !owner.Empty() {
+		ownerAddr = owner.String()
+	}
 	stream := types.NewStream(
 		k.GetLastStreamID(ctx)+1,
 		distrInfo,
@@ -80,6 +110,9 @@ func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.
 		startTime,
 		epochIdentifier,
 		numEpochsPaidOver,
+		ownerAddr,
+		sponsored,
+		emissionCurve,
 	)
 
 	err := k.setStream(ctx, &stream)
@@ -104,7 +137,99 @@ func (k Keeper) CreateStream(ctx sdk.Context, coins sdk.Coins, distrInfo *types.
 	return stream.Id, nil
 }
 
-// TerminateStream cancels a stream.
+// AddToStream adds coins to an active or upcoming stream and extends its distribution by numEpochsToAdd epochs.
+// The added coins are escrowed from the funder, or taken from the unallocated module balance if the funder is empty.
+// The remaining epochs of the stream distribute the new remainder along its emission curve. The epochs of a
+// table curve are fixed, so those streams can't be extended.
+func (k Keeper) AddToStream(ctx sdk.Context, streamID uint64, funder sdk.AccAddress, coins sdk.Coins, numEpochsToAdd uint64) error {
+	if err := types.ValidateAddToStream(coins, numEpochsToAdd); err != nil {
+		return err
+	}
+
+	stream, err := k.GetStreamByID(ctx, streamID)
+	if err != nil {
+		return err
+	}
+
+	if k.isFinishedStream(ctx, *stream) {
+		return sdkerrors.Wrapf(types.ErrInvalidStreamStatus, "stream %d is already finished", streamID)
+	}
+
+	if numEpochsToAdd > 0 && stream.EmissionCurve != nil && stream.EmissionCurve.Type == types.EmissionCurveTable {
+		return sdkerrors.Wrapf(types.ErrInvalidEmissionCurve, "stream %d has an epoch table and can't be extended", streamID)
+	}
+
+	if !coins.IsZero() {
+		if err := k.fundStream(ctx, funder, coins); err != nil {
+			return err
+		}
+	}
+
+	stream.Coins = stream.Coins.Add(coins...)
+	stream.NumEpochsPaidOver += numEpochsToAdd
+	if err := k.setStream(ctx, stream); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtAddToStream,
+			sdk.NewAttribute(types.AttributeStreamID, osmoutils.Uint64ToString(stream.Id)),
+			sdk.NewAttribute(types.AttributeAmount, coins.String()),
+		),
+	})
+
+	return nil
+}
+
+// fundStream escrows the funder's coins into the module account. Streams without a funder are funded by the
+// unallocated module balance.
+func (k Keeper) fundStream(ctx sdk.Context, funder sdk.AccAddress, coins sdk.Coins) error {
+	if !funder.Empty() {
+		return k.bk.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, coins)
+	}
+
+	//TODO: it's better to check only the denoms of the requested coins. No need to itereate entire balance.
+	moduleBalance := k.bk.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
+	alreadyAllocatedCoins := k.GetModuleToDistributeCoins(ctx)
+
+	if !coins.IsAllLTE(moduleBalance.Sub(alreadyAllocatedCoins...)) {
+		return fmt.Errorf("insufficient module balance to distribute coins")
+	}
+	return nil
+}
+
+// chargeCreateStreamFee sends the stream creation fee of the owner to the community pool.
+func (k Keeper) chargeCreateStreamFee(ctx sdk.Context, owner sdk.AccAddress) error {
+	fee := k.GetParams(ctx).CreateStreamFee
+	if fee.IsNil() || fee.IsZero() {
+		return nil
+	}
+
+	if err := k.dk.FundCommunityPool(ctx, sdk.NewCoins(fee), owner); err != nil {
+		return sdkerrors.Wrap(err, "create stream fee")
+	}
+	return nil
+}
+
+// refundStreamOwner refunds the undistributed coins of a finished stream to its owner. The coins of a stream
+// without an owner are left unallocated in the module account.
+func (k Keeper) refundStreamOwner(ctx sdk.Context, stream types.Stream) error {
+	if stream.Owner == "" {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(stream.Owner)
+	if err != nil {
+		return err
+	}
+	remaining := stream.Coins.Sub(stream.DistributedCoins...)
+	if remaining.IsZero() {
+		return nil
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, remaining)
+}
+
+// TerminateStream cancels a stream. The undistributed coins of a stream funded by an owner are refunded to it.
 func (k Keeper) TerminateStream(ctx sdk.Context, streamID uint64) error {
 	stream, err := k.GetStreamByID(ctx, streamID)
 	if err != nil {
@@ -112,10 +237,27 @@ func (k Keeper) TerminateStream(ctx sdk.Context, streamID uint64) error {
 	}
 
 	if stream.IsActiveStream(ctx.BlockTime()) {
-		return k.moveActiveStreamToFinishedStream(ctx, *stream)
+		err = k.moveActiveStreamToFinishedStream(ctx, *stream)
 	} else if stream.IsUpcomingStream(ctx.BlockTime()) {
-		return k.moveUpcomingStreamToFinishedStream(ctx, *stream)
+		err = k.moveUpcomingStreamToFinishedStream(ctx, *stream)
 	} else {
 		return fmt.Errorf("stream %d is not active or upcoming", streamID)
 	}
+	if err != nil {
+		return err
+	}
+
+	if err := k.refundStreamOwner(ctx, *stream); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtTerminateStream,
+			sdk.NewAttribute(types.AttributeStreamID, osmoutils.Uint64ToString(stream.Id)),
+			sdk.NewAttribute(types.AttributeOwner, stream.Owner),
+		),
+	})
+
+	return nil
 }
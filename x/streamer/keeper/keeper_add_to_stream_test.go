@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/streamer"
+	"github.com/dymensionxyz/dymension/x/streamer/keeper"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var _ = suite.TestingSuite(nil)
+
+func (suite *KeeperTestSuite) TestAddToStream() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))
+	streamID, stream := suite.CreateStream(defaultDistrInfo, coins, time.Time{}, "day", 3)
+	err := suite.App.StreamerKeeper.MoveUpcomingStreamToActiveStream(suite.Ctx, *stream)
+	suite.Require().NoError(err)
+	distributed, err := suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1000), distributed.AmountOf("stake"))
+
+	// the unallocated module balance must cover the added coins
+	tooMuch := sdk.NewCoins(sdk.NewInt64Coin("stake", 2500000))
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, tooMuch, 0)
+	suite.Require().Error(err)
+
+	// the remaining epochs distribute the new remainder
+	added := sdk.NewCoins(sdk.NewInt64Coin("stake", 4000))
+	toDistBefore := suite.App.StreamerKeeper.GetModuleToDistributeCoins(suite.Ctx)
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, added, 1)
+	suite.Require().NoError(err)
+	stream, err = suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Add(added...), stream.Coins)
+	suite.Require().Equal(uint64(4), stream.NumEpochsPaidOver)
+	suite.Require().Equal(toDistBefore.Add(added...), suite.App.StreamerKeeper.GetModuleToDistributeCoins(suite.Ctx))
+
+	distributed, err = suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2000), distributed.AmountOf("stake"))
+	_, broken := keeper.AllInvariants(suite.App.StreamerKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+
+	// finished streams can't be topped up
+	err = suite.App.StreamerKeeper.TerminateStream(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, added, 0)
+	suite.Require().ErrorIs(err, types.ErrInvalidStreamStatus)
+
+	// nothing to add
+	err = suite.App.StreamerKeeper.AddToStream(suite.Ctx, streamID, nil, sdk.Coins{}, 0)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgAddToStream() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	owner := suite.TestAccs[0]
+	msgServer := keeper.NewMsgServerImpl(&suite.App.StreamerKeeper)
+	goCtx := sdk.WrapSDKContext(suite.Ctx)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	streamID := suite.createOwnedStream(owner, coins, time.Now().Add(10*time.Minute))
+	govStreamID, _ := suite.CreateDefaultStream(coins)
+
+	// only the owner can top up its stream
+	suite.FundAcc(suite.TestAccs[1], coins)
+	_, err := msgServer.AddToStream(goCtx, types.NewMsgAddToStream(suite.TestAccs[1], streamID, coins, 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.AddToStream(goCtx, types.NewMsgAddToStream(suite.TestAccs[1], govStreamID, coins, 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the owner's coins are escrowed into the module
+	suite.FundAcc(owner, coins)
+	_, err = msgServer.AddToStream(goCtx, types.NewMsgAddToStream(owner, streamID, coins, 10))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner).IsZero())
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Add(coins...), stream.Coins)
+	suite.Require().Equal(uint64(40), stream.NumEpochsPaidOver)
+
+	// the added coins are refunded on termination
+	_, err = msgServer.TerminateStream(goCtx, types.NewMsgTerminateStream(owner, streamID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Add(coins...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestAddToStreamProposal() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	streamID := suite.createOwnedStream(suite.TestAccs[0], coins, time.Now().Add(10*time.Minute))
+	govStreamID, _ := suite.CreateDefaultStream(coins)
+	handler := streamer.NewStreamerProposalHandler(suite.App.StreamerKeeper)
+
+	// governance can't fund a stream refunded to its owner
+	err := handler(suite.Ctx, types.NewAddToStreamProposal("title", "description", streamID, coins, 0))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	err = handler(suite.Ctx, types.NewAddToStreamProposal("title", "description", govStreamID, coins, 5))
+	suite.Require().NoError(err)
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, govStreamID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Add(coins...), stream.Coins)
+	suite.Require().Equal(uint64(35), stream.NumEpochsPaidOver)
+}
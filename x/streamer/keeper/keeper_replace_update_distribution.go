@@ -4,17 +4,23 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/dymensionxyz/dymension/x/streamer/types"
 )
 
 // This is checked for no err when a proposal is made, and executed when a proposal passes.
+// The distribution of a stream funded by an owner is not governed and can't be replaced.
 func (k Keeper) ReplaceDistrRecords(ctx sdk.Context, streamId uint64, records []types.DistrRecord) error {
 	stream, err := k.GetStreamByID(ctx, streamId)
 	if err != nil {
 		return err
 	}
 
+	if stream.Owner != "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "stream %d is owned by %s", streamId, stream.Owner)
+	}
+
 	distrInfo, err := k.NewDistrInfo(ctx, records)
 	if err != nil {
 		return err
@@ -31,6 +37,7 @@ func (k Keeper) ReplaceDistrRecords(ctx sdk.Context, streamId uint64, records []
 }
 
 // UpdateDistrRecords is checked for no err when a proposal is made, and executed when a proposal passes.
+// The distribution of a stream funded by an owner is not governed and can't be updated.
 func (k Keeper) UpdateDistrRecords(ctx sdk.Context, streamId uint64, records []types.DistrRecord) error {
 	recordsMap := make(map[uint64]types.DistrRecord)
 
@@ -39,6 +46,10 @@ func (k Keeper) UpdateDistrRecords(ctx sdk.Context, streamId uint64, records []t
 		return err
 	}
 
+	if stream.Owner != "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "stream %d is owned by %s", streamId, stream.Owner)
+	}
+
 	err = k.validateGauges(ctx, records)
 	if err != nil {
 		return err
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/dymensionxyz/dymension/x/streamer/types"
 )
 
@@ -263,3 +266,21 @@ func (suite *KeeperTestSuite) TestUpdateDistrRecords() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestReplaceUpdateDistrRecords_OwnedStream() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	streamID := suite.createOwnedStream(suite.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), time.Now().Add(10*time.Minute))
+	records := []types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(10)}}
+
+	// the distribution of an owned stream is not governed
+	err := suite.App.StreamerKeeper.ReplaceDistrRecords(suite.Ctx, streamID, records)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = suite.App.StreamerKeeper.UpdateDistrRecords(suite.Ctx, streamID, records)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultDistrInfo.Records, stream.DistributeTo.Records)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// msgServer provides a way to reference keeper pointer in the message server interface.
+type msgServer struct {
+	keeper *Keeper
+}
+
+// NewMsgServerImpl returns an instance of MsgServer for the provided keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// CreateStream escrows the owner's coins and creates a stream distributing them to the given gauges.
+func (server msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStream) (*types.MsgCreateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	distrInfo, err := server.keeper.NewDistrInfo(ctx, msg.DistributeToRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	streamID, err := server.keeper.CreateOwnedStream(ctx, owner, msg.Coins, distrInfo, msg.StartTime, msg.DistrEpochIdentifier, msg.NumEpochsPaidOver, msg.Sponsored, msg.EmissionCurve)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgCreateStreamResponse{StreamId: streamID}, nil
+}
+
+// TerminateStream terminates a stream owned by the sender and refunds its undistributed coins.
+func (server msgServer) TerminateStream(goCtx context.Context, msg *types.MsgTerminateStream) (*types.MsgTerminateStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stream, err := server.keeper.GetStreamByID(ctx, msg.StreamId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
+	}
+
+	if stream.Owner == "" || stream.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "stream %d is not owned by %s", msg.StreamId, msg.Owner)
+	}
+
+	if stream.IsFinishedStream(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidStreamStatus, "stream %d is already finished", msg.StreamId)
+	}
+
+	if err := server.keeper.TerminateStream(ctx, msg.StreamId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTerminateStreamResponse{}, nil
+}
+
+// AddToStream adds the owner's coins to its stream and extends the stream's distribution.
+func (server msgServer) AddToStream(goCtx context.Context, msg *types.MsgAddToStream) (*types.MsgAddToStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := server.keeper.GetStreamByID(ctx, msg.StreamId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrNotFound, err.Error())
+	}
+
+	if stream.Owner == "" || stream.Owner != msg.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "stream %d is not owned by %s", msg.StreamId, msg.Owner)
+	}
+
+	if err := server.keeper.AddToStream(ctx, msg.StreamId, owner, msg.Coins, msg.NumEpochsToAdd); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddToStreamResponse{}, nil
+}
+
+// Vote splits the voting power of the delegator over the given gauges.
+func (server msgServer) Vote(goCtx context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.Vote(ctx, voter, msg.Weights); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+// RevokeVote removes the vote of the delegator.
+func (server msgServer) RevokeVote(goCtx context.Context, msg *types.MsgRevokeVote) (*types.MsgRevokeVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	voter, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.RevokeVote(ctx, voter); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeVoteResponse{}, nil
+}
+
+// SetRollappGaugeRecipient designates the recipient of the rollapp gauge's rewards on behalf of the rollapp creator.
+func (server msgServer) SetRollappGaugeRecipient(goCtx context.Context, msg *types.MsgSetRollappGaugeRecipient) (*types.MsgSetRollappGaugeRecipientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, found := server.keeper.rk.GetRollapp(ctx, msg.RollappId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "rollapp %s", msg.RollappId)
+	}
+
+	if rollapp.Creator != msg.Creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "rollapp %s is not created by %s", msg.RollappId, msg.Creator)
+	}
+
+	if err := server.keeper.SetRollappGaugeRecipient(ctx, msg.RollappId, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRollappGaugeRecipientResponse{}, nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/dymensionxyz/dymension/x/streamer/keeper"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// createOwnedStream funds the owner and creates a stream with its coins through the msg server
+func (suite *KeeperTestSuite) createOwnedStream(owner sdk.AccAddress, coins sdk.Coins, startTime time.Time) uint64 {
+	suite.FundAcc(owner, coins)
+	suite.FundAcc(owner, sdk.NewCoins(suite.App.StreamerKeeper.GetParams(suite.Ctx).CreateStreamFee))
+	msgServer := keeper.NewMsgServerImpl(&suite.App.StreamerKeeper)
+	res, err := msgServer.CreateStream(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateStream(owner, coins, defaultDistrInfo.Records, startTime, "day", 30, false, nil))
+	suite.Require().NoError(err)
+	return res.StreamId
+}
+
+func (suite *KeeperTestSuite) TestMsgCreateStream() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	owner := suite.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	toDistBefore := suite.App.StreamerKeeper.GetModuleToDistributeCoins(suite.Ctx)
+	communityPoolBefore := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	fee := suite.App.StreamerKeeper.GetParams(suite.Ctx).CreateStreamFee
+
+	streamID := suite.createOwnedStream(owner, coins, time.Now().Add(10*time.Minute))
+
+	// the coins are escrowed into the module and allocated to the stream
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(owner.String(), stream.Owner)
+	suite.Require().Equal(coins, stream.Coins)
+	suite.Require().True(suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner).IsZero())
+	suite.Require().Equal(toDistBefore.Add(coins...), suite.App.StreamerKeeper.GetModuleToDistributeCoins(suite.Ctx))
+
+	// the creation fee is sent to the community pool
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	suite.Require().Equal(communityPoolBefore.Add(sdk.NewDecCoinFromCoin(fee)), communityPool)
+
+	// the owner must hold the coins
+	msgServer := keeper.NewMsgServerImpl(&suite.App.StreamerKeeper)
+	owner = suite.TestAccs[1]
+	suite.FundAcc(owner, sdk.NewCoins(fee))
+	_, err = msgServer.CreateStream(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateStream(owner, coins, defaultDistrInfo.Records, time.Now(), "day", 30, false, nil))
+	suite.Require().Error(err)
+
+	// the owner must pay the creation fee on top of the coins
+	owner = suite.TestAccs[2]
+	suite.FundAcc(owner, coins)
+	_, err = msgServer.CreateStream(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateStream(owner, coins, defaultDistrInfo.Records, time.Now(), "day", 30, false, nil))
+	suite.Require().ErrorContains(err, "create stream fee")
+
+	// the gauges must exist
+	suite.FundAcc(owner, sdk.NewCoins(fee))
+	records := []types.DistrRecord{{GaugeId: 100, Weight: sdk.NewInt(1)}}
+	_, err = msgServer.CreateStream(sdk.WrapSDKContext(suite.Ctx), types.NewMsgCreateStream(owner, coins, records, time.Now(), "day", 30, false, nil))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgTerminateStream() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	owner := suite.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))
+	msgServer := keeper.NewMsgServerImpl(&suite.App.StreamerKeeper)
+	goCtx := sdk.WrapSDKContext(suite.Ctx)
+
+	streamID := suite.createOwnedStream(owner, coins, time.Time{})
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.MoveUpcomingStreamToActiveStream(suite.Ctx, *stream)
+	suite.Require().NoError(err)
+	distributed, err := suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+	suite.Require().NoError(err)
+	suite.Require().True(distributed.IsAllPositive())
+
+	// only the owner can terminate its stream
+	_, err = msgServer.TerminateStream(goCtx, types.NewMsgTerminateStream(suite.TestAccs[1], streamID))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// streams created by governance can't be terminated by a message
+	govStreamID, _ := suite.CreateDefaultStream(sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	_, err = msgServer.TerminateStream(goCtx, types.NewMsgTerminateStream(owner, govStreamID))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the undistributed remainder is refunded to the owner
+	_, err = msgServer.TerminateStream(goCtx, types.NewMsgTerminateStream(owner, streamID))
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins.Sub(distributed...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner))
+	finished := suite.App.StreamerKeeper.GetFinishedStreams(suite.Ctx)
+	suite.Require().Len(finished, 1)
+	suite.Require().Equal(streamID, finished[0].Id)
+
+	// can't terminate twice
+	_, err = msgServer.TerminateStream(goCtx, types.NewMsgTerminateStream(owner, streamID))
+	suite.Require().Error(err)
+	suite.Require().Equal(coins.Sub(distributed...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner))
+}
+
+func (suite *KeeperTestSuite) TestOwnedStreamFinishRefund() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	owner := suite.TestAccs[0]
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))
+
+	streamID := suite.createOwnedStream(owner, coins, time.Time{})
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, streamID)
+	suite.Require().NoError(err)
+
+	// the stream is paid over a single epoch and half of it goes to a gauge which no longer exists
+	distrInfo, err := types.NewDistrInfo(append(defaultDistrInfo.Records, types.DistrRecord{GaugeId: 100, Weight: sdk.NewInt(100)}))
+	suite.Require().NoError(err)
+	stream.DistributeTo = distrInfo
+	stream.NumEpochsPaidOver = 1
+	suite.Require().NoError(suite.App.StreamerKeeper.SetStream(suite.Ctx, stream))
+	err = suite.App.StreamerKeeper.MoveUpcomingStreamToActiveStream(suite.Ctx, *stream)
+	suite.Require().NoError(err)
+
+	distributed, err := suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)), distributed)
+
+	// the stream finishes and the share of the missing gauge is refunded to the owner
+	finished := suite.App.StreamerKeeper.GetFinishedStreams(suite.Ctx)
+	suite.Require().Len(finished, 1)
+	suite.Require().Equal(streamID, finished[0].Id)
+	suite.Require().Equal(coins.Sub(distributed...), suite.App.BankKeeper.GetAllBalances(suite.Ctx, owner))
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+	lockuptypes "github.com/osmosis-labs/osmosis/v15/x/lockup/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// CreateRollappGauge creates the gauge of a registered rollapp. The incentives gauge only reserves the gauge ID
+// the streams distribute to, the rewards of a rollapp gauge are sent to the rollapp and never reach lockup holders.
+// A rollapp registered again keeps its gauge, without the recipient designated before.
+func (k Keeper) CreateRollappGauge(ctx sdk.Context, rollappID string) (uint64, error) {
+	if rollappGauge, found := k.GetRollappGaugeByRollapp(ctx, rollappID); found {
+		rollappGauge.Recipient = ""
+		return rollappGauge.GaugeId, k.setRollappGauge(ctx, rollappGauge)
+	}
+
+	gaugeID, err := k.ik.CreateGauge(
+		ctx,
+		true,
+		k.ak.GetModuleAddress(types.ModuleName),
+		sdk.Coins{},
+		lockuptypes.QueryCondition{
+			LockQueryType: lockuptypes.ByTime,
+			Denom:         k.sk.BondDenom(ctx),
+			Timestamp:     time.Time{},
+		},
+		ctx.BlockTime(),
+		1,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	rollappGauge := types.RollappGauge{GaugeId: gaugeID, RollappId: rollappID}
+	if err := k.setRollappGauge(ctx, rollappGauge); err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCreateRollappGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, osmoutils.Uint64ToString(gaugeID)),
+			sdk.NewAttribute(types.AttributeRollappID, rollappID),
+		),
+	})
+	return gaugeID, nil
+}
+
+// SetRollappGaugeRecipient designates the address receiving the rewards of the rollapp gauge.
+// An empty recipient rewards the active proposer of the rollapp.
+func (k Keeper) SetRollappGaugeRecipient(ctx sdk.Context, rollappID string, recipient string) error {
+	rollappGauge, found := k.GetRollappGaugeByRollapp(ctx, rollappID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrRollappGaugeNotFound, "rollapp %s", rollappID)
+	}
+
+	rollappGauge.Recipient = recipient
+	if err := rollappGauge.Validate(); err != nil {
+		return err
+	}
+	if err := k.setRollappGauge(ctx, rollappGauge); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtSetRollappGaugeRecipient,
+			sdk.NewAttribute(types.AttributeRollappID, rollappID),
+			sdk.NewAttribute(types.AttributeReceiver, recipient),
+		),
+	})
+	return nil
+}
+
+// distributeToRollapp sends the coins allocated to a rollapp gauge to the rollapp's recipient.
+// Frozen or deregistered rollapps, and rollapps without a recipient, are not rewarded.
+func (k Keeper) distributeToRollapp(ctx sdk.Context, rollappGauge types.RollappGauge, coins sdk.Coins) error {
+	rollapp, found := k.rk.GetRollapp(ctx, rollappGauge.RollappId)
+	if !found || rollapp.Frozen {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "rollapp %s is not active", rollappGauge.RollappId)
+	}
+
+	recipient := rollappGauge.Recipient
+	if recipient == "" {
+		proposer, found := k.seqk.GetRollappProposer(ctx, rollappGauge.RollappId)
+		if !found {
+			return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "rollapp %s has no proposer", rollappGauge.RollappId)
+		}
+		recipient = proposer
+	}
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRollappGaugeDistribution,
+			sdk.NewAttribute(types.AttributeGaugeID, osmoutils.Uint64ToString(rollappGauge.GaugeId)),
+			sdk.NewAttribute(types.AttributeRollappID, rollappGauge.RollappId),
+			sdk.NewAttribute(types.AttributeReceiver, recipient),
+			sdk.NewAttribute(types.AttributeAmount, coins.String()),
+		),
+	})
+	return nil
+}
+
+// GetRollappGauge returns the rollapp gauge with the given gauge ID.
+func (k Keeper) GetRollappGauge(ctx sdk.Context, gaugeID uint64) (types.RollappGauge, bool) {
+	var rollappGauge types.RollappGauge
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRollappGauges).Get(sdk.Uint64ToBigEndian(gaugeID))
+	if bz == nil {
+		return rollappGauge, false
+	}
+	if err := proto.Unmarshal(bz, &rollappGauge); err != nil {
+		panic(err)
+	}
+	return rollappGauge, true
+}
+
+// GetRollappGaugeByRollapp returns the gauge of the rollapp.
+func (k Keeper) GetRollappGaugeByRollapp(ctx sdk.Context, rollappID string) (types.RollappGauge, bool) {
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRollappGaugeIDs).Get([]byte(rollappID))
+	if bz == nil {
+		return types.RollappGauge{}, false
+	}
+	return k.GetRollappGauge(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetRollappGauges returns the gauges of all the rollapps.
+func (k Keeper) GetRollappGauges(ctx sdk.Context) []types.RollappGauge {
+	rollappGauges := []types.RollappGauge{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRollappGauges).Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+	for ; iterator.Valid(); iterator.Next() {
+		var rollappGauge types.RollappGauge
+		if err := proto.Unmarshal(iterator.Value(), &rollappGauge); err != nil {
+			panic(err)
+		}
+		rollappGauges = append(rollappGauges, rollappGauge)
+	}
+	return rollappGauges
+}
+
+func (k Keeper) setRollappGauge(ctx sdk.Context, rollappGauge types.RollappGauge) error {
+	bz, err := proto.Marshal(&rollappGauge)
+	if err != nil {
+		return err
+	}
+	gaugeIDBz := sdk.Uint64ToBigEndian(rollappGauge.GaugeId)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRollappGauges).Set(gaugeIDBz, bz)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRollappGaugeIDs).Set([]byte(rollappGauge.RollappId), gaugeIDBz)
+	return nil
+}
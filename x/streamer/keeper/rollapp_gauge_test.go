@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	rollappkeeper "github.com/dymensionxyz/dymension/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/x/sequencer/types"
+	"github.com/dymensionxyz/dymension/x/streamer/keeper"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var _ = suite.TestingSuite(nil)
+
+func (suite *KeeperTestSuite) TestRollappGauge() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	creator, proposer, recipient := suite.TestAccs[0], suite.TestAccs[1], suite.TestAccs[2]
+	rollappID := "rollapp_1234-1"
+
+	// registering a rollapp creates its gauge
+	_, err := rollappkeeper.NewMsgServerImpl(suite.App.RollappKeeper).CreateRollapp(sdk.WrapSDKContext(suite.Ctx), &rollapptypes.MsgCreateRollapp{
+		Creator:       creator.String(),
+		RollappId:     rollappID,
+		MaxSequencers: 1,
+	})
+	suite.Require().NoError(err)
+	rollappGauge, found := suite.App.StreamerKeeper.GetRollappGaugeByRollapp(suite.Ctx, rollappID)
+	suite.Require().True(found)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, rollappGauge.GaugeId)
+	suite.Require().NoError(err)
+	suite.Require().True(gauge.IsPerpetual)
+
+	distrInfo := &types.DistrInfo{
+		TotalWeight: math.NewInt(100),
+		Records: []types.DistrRecord{
+			{GaugeId: 1, Weight: math.NewInt(50)},
+			{GaugeId: rollappGauge.GaugeId, Weight: math.NewInt(50)},
+		},
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 4000))
+	_, stream := suite.CreateStream(distrInfo, coins, time.Time{}, "day", 4)
+	suite.Require().NoError(suite.App.StreamerKeeper.MoveUpcomingStreamToActiveStream(suite.Ctx, *stream))
+	distribute := func() sdk.Coins {
+		stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, stream.Id)
+		suite.Require().NoError(err)
+		distributed, err := suite.App.StreamerKeeper.Distribute(suite.Ctx, []types.Stream{*stream})
+		suite.Require().NoError(err)
+		return distributed
+	}
+
+	// a rollapp without proposer is not rewarded, its share stays in the stream
+	suite.Require().Equal(sdk.NewInt(500), distribute().AmountOf("stake"))
+
+	// the rewards go to the active proposer, the remaining epochs distribute the undistributed share
+	suite.App.SequencerKeeper.SetSequencersByRollapp(suite.Ctx, sequencertypes.SequencersByRollapp{
+		RollappId: rollappID,
+		Proposer:  proposer.String(),
+	})
+	suite.Require().Equal(sdk.NewInt(1166), distribute().AmountOf("stake"))
+	suite.Require().Equal(sdk.NewInt(583), suite.App.BankKeeper.GetBalance(suite.Ctx, proposer, "stake").Amount)
+
+	// only the rollapp creator designates the recipient
+	msgServer := keeper.NewMsgServerImpl(&suite.App.StreamerKeeper)
+	_, err = msgServer.SetRollappGaugeRecipient(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetRollappGaugeRecipient(proposer, rollappID, proposer.String()))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.SetRollappGaugeRecipient(sdk.WrapSDKContext(suite.Ctx), types.NewMsgSetRollappGaugeRecipient(creator, rollappID, recipient.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1166), distribute().AmountOf("stake"))
+	suite.Require().Equal(sdk.NewInt(583), suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, "stake").Amount)
+
+	// a frozen rollapp is not rewarded
+	rollapp, _ := suite.App.RollappKeeper.GetRollapp(suite.Ctx, rollappID)
+	rollapp.Frozen = true
+	suite.App.RollappKeeper.SetRollapp(suite.Ctx, rollapp)
+	suite.Require().Equal(sdk.NewInt(584), distribute().AmountOf("stake"))
+
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, rollappGauge.GaugeId)
+	suite.Require().NoError(err)
+	suite.Require().True(gauge.Coins.IsZero())
+	_, broken := keeper.AllInvariants(suite.App.StreamerKeeper)(suite.Ctx)
+	suite.Require().False(broken)
+}
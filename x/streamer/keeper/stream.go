@@ -63,6 +63,12 @@ func (k Keeper) GetFinishedStreams(ctx sdk.Context) []types.Stream {
 	return k.getStreamsFromIterator(ctx, k.FinishedStreamsIterator(ctx))
 }
 
+// isFinishedStream returns true if the stream was moved to the finished streams.
+func (k Keeper) isFinishedStream(ctx sdk.Context, stream types.Stream) bool {
+	streamIDs := k.getStreamRefs(ctx, combineKeys(types.KeyPrefixFinishedStreams, getTimeKey(stream.StartTime)))
+	return findIndex(streamIDs, stream.Id) >= 0
+}
+
 // moveUpcomingStreamToActiveStream moves a stream that has reached it's start time from an upcoming to an active status.
 func (k Keeper) moveUpcomingStreamToActiveStream(ctx sdk.Context, stream types.Stream) error {
 	// validation for current time and distribution start time
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// Vote splits the staked voting power of the voter over the given gauges, replacing its previous vote.
+// The weights are relative, and the voting power follows the delegations of the voter.
+func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, weights []types.DistrRecord) error {
+	if err := types.ValidateVoteWeights(weights); err != nil {
+		return err
+	}
+	if err := k.validateGauges(ctx, weights); err != nil {
+		return err
+	}
+
+	power := k.VotingPower(ctx, voter, nil)
+	if !power.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrNoVotingPower, "%s has no delegations", voter)
+	}
+
+	tally := k.GetSponsoredDistribution(ctx)
+	if oldVote, found := k.GetVote(ctx, voter); found {
+		tally = tally.AddVote(oldVote, false)
+	}
+	vote := types.Vote{
+		Voter:       voter.String(),
+		VotingPower: power,
+		Weights:     weights,
+	}
+	if err := k.setVote(ctx, vote); err != nil {
+		return err
+	}
+	if err := k.setSponsoredDistribution(ctx, tally.AddVote(vote, true)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtVote,
+			sdk.NewAttribute(types.AttributeVoter, vote.Voter),
+			sdk.NewAttribute(types.AttributeAmount, power.String()),
+		),
+	})
+	return nil
+}
+
+// RevokeVote removes the vote of the voter and its voting power from the tally.
+func (k Keeper) RevokeVote(ctx sdk.Context, voter sdk.AccAddress) error {
+	vote, found := k.GetVote(ctx, voter)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrVoteNotFound, "voter %s", voter)
+	}
+
+	k.deleteVote(ctx, voter)
+	if err := k.setSponsoredDistribution(ctx, k.GetSponsoredDistribution(ctx).AddVote(vote, false)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtRevokeVote,
+			sdk.NewAttribute(types.AttributeVoter, vote.Voter),
+		),
+	})
+	return nil
+}
+
+// UpdateVotingPower recomputes the voting power of the voter's vote after its delegations changed, ignoring
+// the delegation to the excluded validator if given. A vote left without voting power is removed.
+func (k Keeper) UpdateVotingPower(ctx sdk.Context, voter sdk.AccAddress, excluded sdk.ValAddress) error {
+	vote, found := k.GetVote(ctx, voter)
+	if !found {
+		return nil
+	}
+
+	tally := k.GetSponsoredDistribution(ctx).AddVote(vote, false)
+	vote.VotingPower = k.VotingPower(ctx, voter, excluded)
+	if vote.VotingPower.IsPositive() {
+		if err := k.setVote(ctx, vote); err != nil {
+			return err
+		}
+		tally = tally.AddVote(vote, true)
+	} else {
+		k.deleteVote(ctx, voter)
+	}
+	return k.setSponsoredDistribution(ctx, tally)
+}
+
+// UpdateSponsoredDistribution recomputes the voting power of all the votes and rebuilds the tally from them.
+// The delegated tokens change without a delegation hook when a validator is slashed, so the voting power of the
+// votes is refreshed before the sponsored streams distribute. A vote left without voting power is removed.
+func (k Keeper) UpdateSponsoredDistribution(ctx sdk.Context) (types.DistrInfo, error) {
+	tally := types.DistrInfo{TotalWeight: sdk.ZeroInt(), Records: []types.DistrRecord{}}
+	for _, vote := range k.GetVotes(ctx) {
+		voter, err := sdk.AccAddressFromBech32(vote.Voter)
+		if err != nil {
+			return types.DistrInfo{}, err
+		}
+		vote.VotingPower = k.VotingPower(ctx, voter, nil)
+		if !vote.VotingPower.IsPositive() {
+			k.deleteVote(ctx, voter)
+			continue
+		}
+		if err := k.setVote(ctx, vote); err != nil {
+			return types.DistrInfo{}, err
+		}
+		tally = tally.AddVote(vote, true)
+	}
+	if err := k.setSponsoredDistribution(ctx, tally); err != nil {
+		return types.DistrInfo{}, err
+	}
+	return tally, nil
+}
+
+// VotingPower returns the tokens the voter delegated, ignoring the delegation to the excluded validator if given.
+func (k Keeper) VotingPower(ctx sdk.Context, voter sdk.AccAddress, excluded sdk.ValAddress) sdk.Int {
+	power := sdk.ZeroInt()
+	k.sk.IterateDelegations(ctx, voter, func(_ int64, delegation stakingtypes.DelegationI) bool {
+		valAddr := delegation.GetValidatorAddr()
+		if excluded != nil && valAddr.Equals(excluded) {
+			return false
+		}
+		validator, found := k.sk.GetValidator(ctx, valAddr)
+		if !found {
+			return false
+		}
+		power = power.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		return false
+	})
+	return power
+}
+
+// GetSponsoredDistribution returns the tally of the gauge votes, weighted by the voting power of the voters.
+func (k Keeper) GetSponsoredDistribution(ctx sdk.Context) types.DistrInfo {
+	distr := types.DistrInfo{TotalWeight: sdk.ZeroInt(), Records: []types.DistrRecord{}}
+	bz := ctx.KVStore(k.storeKey).Get(types.KeySponsoredDistr)
+	if bz == nil {
+		return distr
+	}
+	if err := proto.Unmarshal(bz, &distr); err != nil {
+		panic(err)
+	}
+	return distr
+}
+
+func (k Keeper) setSponsoredDistribution(ctx sdk.Context, distr types.DistrInfo) error {
+	bz, err := proto.Marshal(&distr)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.KeySponsoredDistr, bz)
+	return nil
+}
+
+// GetVote returns the gauge vote of the voter.
+func (k Keeper) GetVote(ctx sdk.Context, voter sdk.AccAddress) (types.Vote, bool) {
+	var vote types.Vote
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotes).Get(voter)
+	if bz == nil {
+		return vote, false
+	}
+	if err := proto.Unmarshal(bz, &vote); err != nil {
+		panic(err)
+	}
+	return vote, true
+}
+
+// GetVotes returns the gauge votes of all the voters.
+func (k Keeper) GetVotes(ctx sdk.Context) []types.Vote {
+	votes := []types.Vote{}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotes).Iterator(nil, nil)
+	defer iterator.Close() // nolint: errcheck
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := proto.Unmarshal(iterator.Value(), &vote); err != nil {
+			panic(err)
+		}
+		votes = append(votes, vote)
+	}
+	return votes
+}
+
+func (k Keeper) setVote(ctx sdk.Context, vote types.Vote) error {
+	voter, err := sdk.AccAddressFromBech32(vote.Voter)
+	if err != nil {
+		return err
+	}
+	bz, err := proto.Marshal(&vote)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotes).Set(voter, bz)
+	return nil
+}
+
+func (k Keeper) deleteVote(ctx sdk.Context, voter sdk.AccAddress) {
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixVotes).Delete(voter)
+}
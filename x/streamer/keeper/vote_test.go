@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+	"github.com/stretchr/testify/suite"
+)
+
+var _ = suite.TestingSuite(nil)
+
+// delegate delegates the amount of stake from the delegator to the validator
+func (suite *KeeperTestSuite) delegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount int64) {
+	suite.FundAcc(delAddr, sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	_, err := suite.App.StakingKeeper.Delegate(suite.Ctx, delAddr, sdk.NewInt(amount), stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+}
+
+// requireTally checks the gauge vote tally has the given voting power per gauge
+func (suite *KeeperTestSuite) requireTally(powers map[uint64]int64) {
+	tally := suite.App.StreamerKeeper.GetSponsoredDistribution(suite.Ctx)
+	suite.Require().Len(tally.Records, len(powers))
+	total := sdk.ZeroInt()
+	for _, record := range tally.Records {
+		suite.Require().Equal(sdk.NewInt(powers[record.GaugeId]), record.Weight, "gauge %d", record.GaugeId)
+		total = total.Add(record.Weight)
+	}
+	suite.Require().Equal(total, tally.TotalWeight)
+}
+
+func (suite *KeeperTestSuite) TestVote() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	valAddr := suite.SetupValidator(stakingtypes.Bonded)
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	weights := []types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(1)}, {GaugeId: 2, Weight: sdk.NewInt(3)}}
+
+	// voting requires delegations
+	err := suite.App.StreamerKeeper.Vote(suite.Ctx, voter1, weights)
+	suite.Require().ErrorIs(err, types.ErrNoVotingPower)
+
+	// votes can only go to existing gauges
+	suite.delegate(voter1, valAddr, 1000)
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, voter1, []types.DistrRecord{{GaugeId: 3, Weight: sdk.NewInt(1)}})
+	suite.Require().Error(err)
+
+	// the voting power is split by the relative weights
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, voter1, weights)
+	suite.Require().NoError(err)
+	vote, found := suite.App.StreamerKeeper.GetVote(suite.Ctx, voter1)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(1000), vote.VotingPower)
+	suite.requireTally(map[uint64]int64{1: 250, 2: 750})
+
+	suite.delegate(voter2, valAddr, 500)
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, voter2, []types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(1)}})
+	suite.Require().NoError(err)
+	suite.requireTally(map[uint64]int64{1: 750, 2: 750})
+
+	// a new vote replaces the previous one
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, voter2, []types.DistrRecord{{GaugeId: 2, Weight: sdk.NewInt(1)}})
+	suite.Require().NoError(err)
+	suite.requireTally(map[uint64]int64{1: 250, 2: 1250})
+
+	// the voting power follows the delegations
+	suite.delegate(voter1, valAddr, 1000)
+	suite.requireTally(map[uint64]int64{1: 500, 2: 2000})
+
+	_, err = suite.App.StakingKeeper.Undelegate(suite.Ctx, voter2, valAddr, sdk.NewDec(500))
+	suite.Require().NoError(err)
+	_, found = suite.App.StreamerKeeper.GetVote(suite.Ctx, voter2)
+	suite.Require().False(found)
+	suite.requireTally(map[uint64]int64{1: 500, 2: 1500})
+
+	// revoking removes the vote from the tally
+	err = suite.App.StreamerKeeper.RevokeVote(suite.Ctx, voter1)
+	suite.Require().NoError(err)
+	suite.requireTally(map[uint64]int64{})
+	err = suite.App.StreamerKeeper.RevokeVote(suite.Ctx, voter1)
+	suite.Require().ErrorIs(err, types.ErrVoteNotFound)
+}
+
+func (suite *KeeperTestSuite) TestSponsoredStreamDistribution() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	valAddr := suite.SetupValidator(stakingtypes.Bonded)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))
+	sponsoredID, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Now(), "day", 3, true, nil)
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockTime(time.Now())
+
+	// without votes, the sponsored stream keeps its own records
+	err = suite.App.StreamerKeeper.Hooks().AfterEpochEnd(ctx, "day", 0)
+	suite.Require().NoError(err)
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 500)).String(), gauge.Coins.String())
+
+	// with votes, the sponsored stream distributes by the tally
+	suite.delegate(suite.TestAccs[0], valAddr, 1000)
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, suite.TestAccs[0], []types.DistrRecord{{GaugeId: 2, Weight: sdk.NewInt(1)}})
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.Hooks().AfterEpochEnd(ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 500)).String(), gauge.Coins.String())
+	gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 1500)).String(), gauge.Coins.String())
+
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, sponsoredID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.StreamerKeeper.GetSponsoredDistribution(suite.Ctx), *stream.DistributeTo)
+}
+
+func (suite *KeeperTestSuite) TestSponsoredStreamDistributionAfterSlash() {
+	suite.SetupTest()
+	suite.Require().NoError(suite.CreateGauge())
+	suite.Require().NoError(suite.CreateGauge())
+	slashedVal, otherVal := suite.SetupValidator(stakingtypes.Bonded), suite.SetupValidator(stakingtypes.Bonded)
+	voter1, voter2 := suite.TestAccs[0], suite.TestAccs[1]
+	// enough tokens to get a consensus power to slash
+	amount := sdk.DefaultPowerReduction.MulRaw(2).Int64()
+
+	suite.delegate(voter1, slashedVal, amount)
+	suite.delegate(voter2, otherVal, amount)
+	err := suite.App.StreamerKeeper.Vote(suite.Ctx, voter1, []types.DistrRecord{{GaugeId: 1, Weight: sdk.NewInt(1)}})
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.Vote(suite.Ctx, voter2, []types.DistrRecord{{GaugeId: 2, Weight: sdk.NewInt(1)}})
+	suite.Require().NoError(err)
+	suite.requireTally(map[uint64]int64{1: amount, 2: amount})
+
+	// slashing doesn't go through the delegation hooks
+	validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, slashedVal)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	power := validator.GetConsensusPower(suite.App.StakingKeeper.PowerReduction(suite.Ctx))
+	suite.App.StakingKeeper.Slash(suite.Ctx, consAddr, suite.Ctx.BlockHeight(), power, sdk.NewDecWithPrec(5, 1))
+	slashedPower := suite.App.StreamerKeeper.VotingPower(suite.Ctx, voter1, nil)
+	suite.Require().True(slashedPower.LT(sdk.NewInt(amount)))
+	suite.requireTally(map[uint64]int64{1: amount, 2: amount})
+
+	// the voting power of the votes is recomputed before the sponsored stream distributes
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 3000))
+	sponsoredID, err := suite.App.StreamerKeeper.CreateStream(suite.Ctx, coins, defaultDistrInfo, time.Now(), "day", 3, true, nil)
+	suite.Require().NoError(err)
+	err = suite.App.StreamerKeeper.Hooks().AfterEpochEnd(suite.Ctx.WithBlockTime(time.Now()), "day", 0)
+	suite.Require().NoError(err)
+
+	vote, found := suite.App.StreamerKeeper.GetVote(suite.Ctx, voter1)
+	suite.Require().True(found)
+	suite.Require().Equal(slashedPower, vote.VotingPower)
+	suite.requireTally(map[uint64]int64{1: slashedPower.Int64(), 2: amount})
+	stream, err := suite.App.StreamerKeeper.GetStreamByID(suite.Ctx, sponsoredID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.StreamerKeeper.GetSponsoredDistribution(suite.Ctx), *stream.DistributeTo)
+}
@@ -0,0 +1,30 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// RollappGaugeKeeper creates the gauges of the rollapps
+type RollappGaugeKeeper interface {
+	GetRollappGaugeByRollapp(ctx sdk.Context, rollappID string) (types.RollappGauge, bool)
+	CreateRollappGauge(ctx sdk.Context, rollappID string) (uint64, error)
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// The stream creation fee added since v1 is set to its default, and the rollapps registered before
+// v2 are given their gauge, as it is only created when a rollapp is registered.
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace, rk types.RollappKeeper, gk RollappGaugeKeeper) error {
+	paramstore.Set(ctx, types.KeyCreateStreamFee, types.DefaultCreateStreamFee)
+
+	for _, rollapp := range rk.GetAllRollapp(ctx) {
+		if _, found := gk.GetRollappGaugeByRollapp(ctx, rollapp.RollappId); found {
+			continue
+		}
+		if _, err := gk.CreateRollappGauge(ctx, rollapp.RollappId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
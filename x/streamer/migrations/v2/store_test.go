@@ -0,0 +1,78 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+
+	rollapptypes "github.com/dymensionxyz/dymension/x/rollapp/types"
+	v2 "github.com/dymensionxyz/dymension/x/streamer/migrations/v2"
+	"github.com/dymensionxyz/dymension/x/streamer/types"
+)
+
+// rollappKeeper provides the registered rollapps
+type rollappKeeper struct {
+	rollapps []rollapptypes.Rollapp
+}
+
+func (k rollappKeeper) GetRollapp(ctx sdk.Context, rollappId string) (rollapptypes.Rollapp, bool) {
+	for _, rollapp := range k.rollapps {
+		if rollapp.RollappId == rollappId {
+			return rollapp, true
+		}
+	}
+	return rollapptypes.Rollapp{}, false
+}
+
+func (k rollappKeeper) GetAllRollapp(ctx sdk.Context) []rollapptypes.Rollapp {
+	return k.rollapps
+}
+
+// rollappGaugeKeeper records the gauges created for the rollapps
+type rollappGaugeKeeper struct {
+	gauges map[string]uint64
+}
+
+func (k *rollappGaugeKeeper) GetRollappGaugeByRollapp(ctx sdk.Context, rollappID string) (types.RollappGauge, bool) {
+	gaugeID, found := k.gauges[rollappID]
+	return types.RollappGauge{GaugeId: gaugeID, RollappId: rollappID}, found
+}
+
+func (k *rollappGaugeKeeper) CreateRollappGauge(ctx sdk.Context, rollappID string) (uint64, error) {
+	gaugeID := uint64(len(k.gauges) + 1)
+	k.gauges[rollappID] = gaugeID
+	return gaugeID, nil
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramstore := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+	require.False(t, paramstore.Has(ctx, types.KeyCreateStreamFee))
+
+	// rollapp2 already has a gauge
+	rk := rollappKeeper{rollapps: []rollapptypes.Rollapp{{RollappId: "rollapp1"}, {RollappId: "rollapp2"}, {RollappId: "rollapp3"}}}
+	gk := &rollappGaugeKeeper{gauges: map[string]uint64{"rollapp2": 1}}
+
+	err := v2.MigrateStore(ctx, paramstore, rk, gk)
+	require.NoError(t, err)
+
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// each rollapp has a gauge, the existing one is kept
+	require.Len(t, gk.gauges, 3)
+	require.Equal(t, uint64(1), gk.gauges["rollapp2"])
+	require.NotZero(t, gk.gauges["rollapp1"])
+	require.NotZero(t, gk.gauges["rollapp3"])
+	require.NotEqual(t, gk.gauges["rollapp1"], gk.gauges["rollapp3"])
+}
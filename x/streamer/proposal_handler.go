@@ -19,6 +19,8 @@ func NewStreamerProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return HandleReplaceStreamDistributionProposal(ctx, k, c)
 		case *types.UpdateStreamDistributionProposal:
 			return HandleUpdateStreamDistributionProposal(ctx, k, c)
+		case *types.AddToStreamProposal:
+			return HandleAddToStreamProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized streamer proposal content type: %T", c)
 		}
@@ -32,7 +34,7 @@ func HandleCreateStreamProposal(ctx sdk.Context, k keeper.Keeper, p *types.Creat
 		return err
 	}
 
-	_, err = k.CreateStream(ctx, p.Coins, distrInfo, p.StartTime, p.DistrEpochIdentifier, p.NumEpochsPaidOver)
+	_, err = k.CreateStream(ctx, p.Coins, distrInfo, p.StartTime, p.DistrEpochIdentifier, p.NumEpochsPaidOver, p.Sponsored, p.EmissionCurve)
 	if err != nil {
 		return err
 	}
@@ -80,3 +82,18 @@ func HandleUpdateStreamDistributionProposal(ctx sdk.Context, k keeper.Keeper, p
 
 	return k.UpdateDistrRecords(ctx, p.StreamId, p.Records)
 }
+
+// HandleAddToStreamProposal is a handler for executing a passed add to stream proposal
+func HandleAddToStreamProposal(ctx sdk.Context, k keeper.Keeper, p *types.AddToStreamProposal) error {
+	stream, err := k.GetStreamByID(ctx, p.StreamId)
+	if err != nil {
+		return err
+	}
+
+	// the undistributed coins of an owned stream are refunded to its owner, the module can't fund it
+	if stream.Owner != "" {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "stream %d is owned by %s", p.StreamId, stream.Owner)
+	}
+
+	return k.AddToStream(ctx, p.StreamId, nil, p.Coins, p.NumEpochsToAdd)
+}
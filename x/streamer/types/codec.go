@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -16,6 +17,12 @@ var (
 // RegisterCodec registers the necessary x/streamer interfaces and concrete types on the provided
 // LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateStream{}, "streamer/CreateStream", nil)
+	cdc.RegisterConcrete(&MsgTerminateStream{}, "streamer/TerminateStream", nil)
+	cdc.RegisterConcrete(&MsgAddToStream{}, "streamer/AddToStream", nil)
+	cdc.RegisterConcrete(&MsgVote{}, "streamer/Vote", nil)
+	cdc.RegisterConcrete(&MsgRevokeVote{}, "streamer/RevokeVote", nil)
+	cdc.RegisterConcrete(&MsgSetRollappGaugeRecipient{}, "streamer/SetRollappGaugeRecipient", nil)
 }
 
 // RegisterInterfaces registers interfaces and implementations of the streamer module.
@@ -26,7 +33,20 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&TerminateStreamProposal{},
 		&UpdateStreamDistributionProposal{},
 		&ReplaceStreamDistributionProposal{},
+		&AddToStreamProposal{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateStream{},
+		&MsgTerminateStream{},
+		&MsgAddToStream{},
+		&MsgVote{},
+		&MsgRevokeVote{},
+		&MsgSetRollappGaugeRecipient{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 func init() {
@@ -0,0 +1,210 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// MaxEpochWeights is the highest number of epoch weights of a table curve
+	MaxEpochWeights = 1000
+	// MaxEmissionScheduleEpochs is the highest number of epochs projected by an emission schedule
+	MaxEmissionScheduleEpochs = 1000
+)
+
+// NewCliffEmissionCurve returns a curve distributing nothing during the cliff epochs, then linearly.
+func NewCliffEmissionCurve(cliffEpochs uint64) *EmissionCurve {
+	return &EmissionCurve{Type: EmissionCurveCliff, CliffEpochs: cliffEpochs, DecayFactor: sdk.ZeroDec()}
+}
+
+// NewExponentialEmissionCurve returns a curve multiplying the emission by the decay factor every decay period.
+func NewExponentialEmissionCurve(decayFactor sdk.Dec, decayPeriodEpochs uint64) *EmissionCurve {
+	return &EmissionCurve{Type: EmissionCurveExponential, DecayFactor: decayFactor, DecayPeriodEpochs: decayPeriodEpochs}
+}
+
+// NewTableEmissionCurve returns a curve distributing according to the weight of each epoch.
+func NewTableEmissionCurve(epochWeights []sdk.Int) *EmissionCurve {
+	return &EmissionCurve{Type: EmissionCurveTable, DecayFactor: sdk.ZeroDec(), EpochWeights: epochWeights}
+}
+
+// ValidateEmissionCurve checks the curve fits a stream paid over numEpochsPaidOver epochs.
+// A nil curve distributes linearly.
+func ValidateEmissionCurve(curve *EmissionCurve, numEpochsPaidOver uint64) error {
+	if curve == nil {
+		return nil
+	}
+
+	switch curve.Type {
+	case EmissionCurveLinear:
+	case EmissionCurveCliff:
+		if curve.CliffEpochs >= numEpochsPaidOver {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "cliff of %d epochs leaves no epoch to distribute", curve.CliffEpochs)
+		}
+	case EmissionCurveExponential:
+		if curve.DecayFactor.IsNil() || !curve.DecayFactor.IsPositive() || curve.DecayFactor.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "decay factor must be within (0, 1]")
+		}
+		if curve.DecayPeriodEpochs == 0 {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "decay period must be positive")
+		}
+	case EmissionCurveTable:
+		if len(curve.EpochWeights) > MaxEpochWeights {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "at most %d epoch weights, got %d", MaxEpochWeights, len(curve.EpochWeights))
+		}
+		if uint64(len(curve.EpochWeights)) != numEpochsPaidOver {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "expected %d epoch weights, got %d", numEpochsPaidOver, len(curve.EpochWeights))
+		}
+		totalWeight := sdk.ZeroInt()
+		for _, weight := range curve.EpochWeights {
+			if weight.IsNil() || weight.IsNegative() {
+				return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "epoch weights must not be negative")
+			}
+			totalWeight = totalWeight.Add(weight)
+		}
+		if !totalWeight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "epoch weights must not all be zero")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidEmissionCurve, "unknown curve type %d", curve.Type)
+	}
+	return nil
+}
+
+// decayPeriod returns the number of epochs between two decays of an exponential curve.
+// Streams created before the decay period was required decay every epoch.
+func (c *EmissionCurve) decayPeriod() uint64 {
+	if c.DecayPeriodEpochs == 0 {
+		return 1
+	}
+	return c.DecayPeriodEpochs
+}
+
+// epochWeight returns the relative weight of the stream's epoch, counted from zero.
+func (c *EmissionCurve) epochWeight(epoch uint64) sdk.Dec {
+	if c == nil {
+		return sdk.OneDec()
+	}
+
+	switch c.Type {
+	case EmissionCurveCliff:
+		if epoch < c.CliffEpochs {
+			return sdk.ZeroDec()
+		}
+	case EmissionCurveExponential:
+		return c.DecayFactor.Power(epoch / c.decayPeriod())
+	case EmissionCurveTable:
+		if epoch >= uint64(len(c.EpochWeights)) {
+			return sdk.ZeroDec()
+		}
+		return sdk.NewDecFromInt(c.EpochWeights[epoch])
+	}
+	return sdk.OneDec()
+}
+
+// totalWeight returns the sum of the weights of the epochs from the first epoch up to the last one, excluded.
+// It is computed in closed form, except for a table curve which is bounded by MaxEpochWeights.
+func (c *EmissionCurve) totalWeight(first, last uint64) sdk.Dec {
+	if first >= last {
+		return sdk.ZeroDec()
+	}
+
+	switch c.Type {
+	case EmissionCurveCliff:
+		if first < c.CliffEpochs {
+			first = c.CliffEpochs
+		}
+		if first >= last {
+			return sdk.ZeroDec()
+		}
+	case EmissionCurveExponential:
+		return c.exponentialWeight(first, last)
+	case EmissionCurveTable:
+		totalWeight := sdk.ZeroDec()
+		for epoch := first; epoch < last && epoch < uint64(len(c.EpochWeights)); epoch++ {
+			totalWeight = totalWeight.Add(sdk.NewDecFromInt(c.EpochWeights[epoch]))
+		}
+		return totalWeight
+	}
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(last - first))
+}
+
+// exponentialWeight returns the sum of the weights of the epochs from the first epoch up to the last one,
+// excluded, of an exponential curve. The epochs of a decay period share the same weight, so the sum is the
+// geometric sum of the weights of the periods, with the first and last periods possibly partial.
+func (c *EmissionCurve) exponentialWeight(first, last uint64) sdk.Dec {
+	period := c.decayPeriod()
+	firstPeriod, lastPeriod := first/period, (last-1)/period
+	if firstPeriod == lastPeriod {
+		return c.DecayFactor.Power(firstPeriod).MulInt(sdk.NewIntFromUint64(last - first))
+	}
+
+	firstPeriodEpochs := period - first%period
+	lastPeriodEpochs := (last-1)%period + 1
+	totalWeight := c.DecayFactor.Power(firstPeriod).MulInt(sdk.NewIntFromUint64(firstPeriodEpochs))
+	totalWeight = totalWeight.Add(c.DecayFactor.Power(lastPeriod).MulInt(sdk.NewIntFromUint64(lastPeriodEpochs)))
+
+	// the full periods in between
+	numPeriods := lastPeriod - firstPeriod - 1
+	if numPeriods == 0 {
+		return totalWeight
+	}
+	var periodsWeight sdk.Dec
+	if c.DecayFactor.Equal(sdk.OneDec()) {
+		periodsWeight = sdk.NewDecFromInt(sdk.NewIntFromUint64(numPeriods))
+	} else {
+		// sum of factor^k for k in [firstPeriod+1, lastPeriod)
+		oneMinusFactor := sdk.OneDec().Sub(c.DecayFactor)
+		periodsWeight = c.DecayFactor.Power(firstPeriod + 1).
+			Mul(sdk.OneDec().Sub(c.DecayFactor.Power(numPeriods))).
+			Quo(oneMinusFactor)
+	}
+	return totalWeight.Add(periodsWeight.MulInt(sdk.NewIntFromUint64(period)))
+}
+
+// NextEpochEmission returns the coins the stream distributes on its next epoch out of the remaining coins.
+// The epoch distributes the share of its weight over the weights of the remaining epochs, and the last epoch
+// distributes everything left. Remaining epochs without weight distribute linearly.
+func (stream Stream) NextEpochEmission(remainCoins sdk.Coins) sdk.Coins {
+	emission := sdk.NewCoins()
+	if stream.FilledEpochs >= stream.NumEpochsPaidOver {
+		return emission
+	}
+	remainEpochs := stream.NumEpochsPaidOver - stream.FilledEpochs
+
+	weight := stream.EmissionCurve.epochWeight(stream.FilledEpochs)
+	totalWeight := sdk.ZeroDec()
+	if stream.EmissionCurve != nil {
+		totalWeight = stream.EmissionCurve.totalWeight(stream.FilledEpochs, stream.NumEpochsPaidOver)
+	}
+
+	for _, coin := range remainCoins {
+		var epochAmt sdk.Int
+		switch {
+		case remainEpochs == 1:
+			epochAmt = coin.Amount
+		case stream.EmissionCurve == nil || totalWeight.IsZero():
+			epochAmt = coin.Amount.Quo(sdk.NewIntFromUint64(remainEpochs))
+		default:
+			// the closed form total weight may round below the epoch weight
+			epochAmt = sdk.MinInt(sdk.NewDecFromInt(coin.Amount).Mul(weight).Quo(totalWeight).TruncateInt(), coin.Amount)
+		}
+		if epochAmt.IsPositive() {
+			emission = emission.Add(sdk.Coin{Denom: coin.Denom, Amount: epochAmt})
+		}
+	}
+	return emission
+}
+
+// EmissionSchedule projects the coins the stream distributes on each of its remaining epochs, assuming each
+// epoch distributes its whole emission. The projection is capped to the next MaxEmissionScheduleEpochs epochs.
+func (stream Stream) EmissionSchedule() []EpochEmission {
+	schedule := []EpochEmission{}
+	remainCoins := stream.Coins.Sub(stream.DistributedCoins...)
+	for stream.FilledEpochs < stream.NumEpochsPaidOver && len(schedule) < MaxEmissionScheduleEpochs {
+		emission := stream.NextEpochEmission(remainCoins)
+		stream.FilledEpochs++
+		schedule = append(schedule, EpochEmission{Epoch: stream.FilledEpochs, Coins: emission})
+		remainCoins = remainCoins.Sub(emission...)
+	}
+	return schedule
+}
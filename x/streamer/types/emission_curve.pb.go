@@ -0,0 +1,814 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymension/streamer/emission_curve.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EmissionCurveType defines how a stream spreads its coins over its epochs
+type EmissionCurveType int32
+
+const (
+	// EMISSION_CURVE_TYPE_LINEAR distributes the same amount on each epoch
+	EmissionCurveLinear EmissionCurveType = 0
+	// EMISSION_CURVE_TYPE_CLIFF distributes nothing during the cliff epochs, then
+	// the same amount on each epoch
+	EmissionCurveCliff EmissionCurveType = 1
+	// EMISSION_CURVE_TYPE_EXPONENTIAL multiplies the distributed amount by the
+	// decay factor every decay period, a factor of 0.5 halves the emission
+	EmissionCurveExponential EmissionCurveType = 2
+	// EMISSION_CURVE_TYPE_TABLE distributes according to an explicit weight per
+	// epoch
+	EmissionCurveTable EmissionCurveType = 3
+)
+
+var EmissionCurveType_name = map[int32]string{
+	0: "EMISSION_CURVE_TYPE_LINEAR",
+	1: "EMISSION_CURVE_TYPE_CLIFF",
+	2: "EMISSION_CURVE_TYPE_EXPONENTIAL",
+	3: "EMISSION_CURVE_TYPE_TABLE",
+}
+
+var EmissionCurveType_value = map[string]int32{
+	"EMISSION_CURVE_TYPE_LINEAR":      0,
+	"EMISSION_CURVE_TYPE_CLIFF":       1,
+	"EMISSION_CURVE_TYPE_EXPONENTIAL": 2,
+	"EMISSION_CURVE_TYPE_TABLE":       3,
+}
+
+func (x EmissionCurveType) String() string {
+	return proto.EnumName(EmissionCurveType_name, int32(x))
+}
+
+func (EmissionCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0f8f0328eb68459b, []int{0}
+}
+
+// EmissionCurve gives each epoch of a stream a relative weight. Each epoch
+// distributes the share of its weight over the weights of the remaining
+// epochs, so the coins added to a stream follow the same curve
+type EmissionCurve struct {
+	Type EmissionCurveType `protobuf:"varint,1,opt,name=type,proto3,enum=dymensionxyz.dymension.streamer.EmissionCurveType" json:"type,omitempty" yaml:"type"`
+	// cliff_epochs is the number of epochs without emission of a cliff curve
+	CliffEpochs uint64 `protobuf:"varint,2,opt,name=cliff_epochs,json=cliffEpochs,proto3" json:"cliff_epochs,omitempty" yaml:"cliff_epochs"`
+	// decay_factor is the multiplier of the emission of an exponential curve
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// decay_period_epochs is the number of epochs between two decays of an
+	// exponential curve, it must be positive
+	DecayPeriodEpochs uint64 `protobuf:"varint,4,opt,name=decay_period_epochs,json=decayPeriodEpochs,proto3" json:"decay_period_epochs,omitempty" yaml:"decay_period_epochs"`
+	// epoch_weights are the weights of the epochs of a table curve, one per
+	// epoch the stream is paid over, at most 1000
+	EpochWeights []github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,rep,name=epoch_weights,json=epochWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"epoch_weights" yaml:"epoch_weights"`
+}
+
+func (m *EmissionCurve) Reset()         { *m = EmissionCurve{} }
+func (m *EmissionCurve) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurve) ProtoMessage()    {}
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f8f0328eb68459b, []int{0}
+}
+func (m *EmissionCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurve.Merge(m, src)
+}
+func (m *EmissionCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurve proto.InternalMessageInfo
+
+func (m *EmissionCurve) GetType() EmissionCurveType {
+	if m != nil {
+		return m.Type
+	}
+	return EmissionCurveLinear
+}
+
+func (m *EmissionCurve) GetCliffEpochs() uint64 {
+	if m != nil {
+		return m.CliffEpochs
+	}
+	return 0
+}
+
+func (m *EmissionCurve) GetDecayPeriodEpochs() uint64 {
+	if m != nil {
+		return m.DecayPeriodEpochs
+	}
+	return 0
+}
+
+// EpochEmission is the amount a stream distributes on one of its epochs
+type EpochEmission struct {
+	// epoch is the number of the stream's epoch, starting from one
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// coins are the coins distributed on the epoch
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EpochEmission) Reset()         { *m = EpochEmission{} }
+func (m *EpochEmission) String() string { return proto.CompactTextString(m) }
+func (*EpochEmission) ProtoMessage()    {}
+func (*EpochEmission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f8f0328eb68459b, []int{1}
+}
+func (m *EpochEmission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochEmission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEmission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochEmission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEmission.Merge(m, src)
+}
+func (m *EpochEmission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochEmission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEmission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochEmission proto.InternalMessageInfo
+
+func (m *EpochEmission) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochEmission) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.streamer.EmissionCurveType", EmissionCurveType_name, EmissionCurveType_value)
+	proto.RegisterType((*EmissionCurve)(nil), "dymensionxyz.dymension.streamer.EmissionCurve")
+	proto.RegisterType((*EpochEmission)(nil), "dymensionxyz.dymension.streamer.EpochEmission")
+}
+
+func init() {
+	proto.RegisterFile("dymension/streamer/emission_curve.proto", fileDescriptor_0f8f0328eb68459b)
+}
+
+var fileDescriptor_0f8f0328eb68459b = []byte{
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4f, 0x6f, 0x93, 0x6e,
+	0x1c, 0x87, 0x95, 0xfd, 0x92, 0x3d, 0xdd, 0x7e, 0x76, 0x6c, 0x71, 0x1d, 0x31, 0x40, 0x38, 0x28,
+	0x31, 0x11, 0x5c, 0x8d, 0x31, 0xd9, 0xad, 0x54, 0x9a, 0x90, 0x60, 0xb7, 0xb0, 0xea, 0xd4, 0x0b,
+	0xa1, 0xf4, 0x69, 0x4b, 0x56, 0x78, 0x08, 0xb0, 0x59, 0xbc, 0x7a, 0x59, 0x7a, 0xf2, 0x0d, 0x34,
+	0x31, 0xf1, 0xe6, 0x2b, 0xd9, 0x71, 0xde, 0x8c, 0x07, 0x34, 0xed, 0xc5, 0x73, 0x5f, 0x81, 0xe1,
+	0xa1, 0x5d, 0x4b, 0xac, 0x51, 0x4f, 0xf0, 0x79, 0xbe, 0xdf, 0xcf, 0x1f, 0x9e, 0xe7, 0xfb, 0x00,
+	0xee, 0xb5, 0x63, 0x17, 0x7a, 0xa1, 0x83, 0x3c, 0x39, 0x8c, 0x02, 0x68, 0xb9, 0x30, 0x90, 0xa1,
+	0xeb, 0x84, 0xe9, 0x8a, 0x69, 0x9f, 0x07, 0x17, 0x50, 0xf2, 0x03, 0x14, 0x21, 0x9a, 0xbb, 0x69,
+	0x1c, 0xc4, 0x6f, 0xa5, 0x1b, 0x20, 0xcd, 0x59, 0xcc, 0x6e, 0x17, 0x75, 0x11, 0xee, 0x95, 0xd3,
+	0xb7, 0x8c, 0xc6, 0xb0, 0x36, 0x0a, 0x5d, 0x14, 0xca, 0x2d, 0x2b, 0x84, 0xf2, 0xc5, 0x41, 0x0b,
+	0x46, 0xd6, 0x81, 0x6c, 0x23, 0xc7, 0xcb, 0xea, 0xc2, 0xe7, 0x02, 0xd8, 0x52, 0x67, 0x7e, 0xb5,
+	0xd4, 0x8e, 0x3e, 0x05, 0x54, 0x14, 0xfb, 0xb0, 0x4c, 0xf2, 0xa4, 0xf8, 0x7f, 0xa5, 0x22, 0xfd,
+	0xc1, 0x57, 0xca, 0xb1, 0x9b, 0xb1, 0x0f, 0x95, 0x5b, 0xd3, 0x84, 0x2b, 0xc6, 0x96, 0xdb, 0x3f,
+	0x14, 0x52, 0x25, 0xc1, 0xc0, 0x82, 0xf4, 0x21, 0xd8, 0xb4, 0xfb, 0x4e, 0xa7, 0x63, 0x42, 0x1f,
+	0xd9, 0xbd, 0xb0, 0xbc, 0xc6, 0x93, 0x22, 0xa5, 0xec, 0x4d, 0x13, 0x6e, 0x27, 0x6b, 0x5e, 0xae,
+	0x0a, 0x46, 0x11, 0x43, 0x15, 0x23, 0xba, 0x07, 0x36, 0xdb, 0xd0, 0xb6, 0x62, 0xb3, 0x63, 0xd9,
+	0x11, 0x0a, 0xca, 0x05, 0x9e, 0x14, 0x37, 0x14, 0xf5, 0x2a, 0xe1, 0x88, 0xaf, 0x09, 0x77, 0xb7,
+	0xeb, 0x44, 0xbd, 0xf3, 0x96, 0x64, 0x23, 0x57, 0x9e, 0x7d, 0x6f, 0xf6, 0x78, 0x10, 0xb6, 0xcf,
+	0xe4, 0xd4, 0x3c, 0x94, 0x9e, 0x42, 0x7b, 0xe1, 0xb4, 0xac, 0x25, 0x18, 0x45, 0x0c, 0xeb, 0x18,
+	0xd1, 0x0d, 0xb0, 0x93, 0x55, 0x7d, 0x18, 0x38, 0xa8, 0x3d, 0x0f, 0x4b, 0xe1, 0xb0, 0xec, 0x34,
+	0xe1, 0x98, 0x65, 0x89, 0x5c, 0x93, 0x60, 0x6c, 0xe3, 0xd5, 0x63, 0xbc, 0x38, 0x4b, 0x7e, 0x06,
+	0xb6, 0x70, 0xd5, 0x7c, 0x03, 0x9d, 0x6e, 0x2f, 0x0a, 0xcb, 0xeb, 0x7c, 0x41, 0xdc, 0x50, 0xea,
+	0xff, 0x10, 0x5d, 0xf3, 0xa2, 0x69, 0xc2, 0xed, 0x66, 0xbe, 0x39, 0x31, 0xc1, 0xd8, 0xc4, 0xf8,
+	0x34, 0x83, 0x87, 0xd4, 0x8f, 0x0f, 0x1c, 0x29, 0x5c, 0x92, 0x60, 0x0b, 0xbb, 0xcf, 0x8f, 0x86,
+	0xde, 0x05, 0xeb, 0xb8, 0x0f, 0x1f, 0x2a, 0x65, 0x64, 0x80, 0xb6, 0xc0, 0x7a, 0x3a, 0x09, 0xe9,
+	0x49, 0x14, 0xc4, 0x62, 0x65, 0x5f, 0xca, 0x9c, 0xa5, 0x74, 0x56, 0xa4, 0xd9, 0xac, 0x48, 0x35,
+	0xe4, 0x78, 0xca, 0xc3, 0x34, 0xed, 0xa7, 0x6f, 0x9c, 0xf8, 0x17, 0x69, 0x53, 0x42, 0x68, 0x64,
+	0xca, 0xf7, 0xdf, 0xad, 0x81, 0xed, 0x5f, 0x06, 0x84, 0x7e, 0x02, 0x18, 0xf5, 0x99, 0x76, 0x72,
+	0xa2, 0x1d, 0x35, 0xcc, 0xda, 0x73, 0xe3, 0x85, 0x6a, 0x36, 0x5f, 0x1d, 0xab, 0xa6, 0xae, 0x35,
+	0xd4, 0xaa, 0x51, 0x22, 0x98, 0xbd, 0xe1, 0x88, 0xdf, 0xc9, 0xd1, 0x74, 0xc7, 0x83, 0x56, 0x40,
+	0x3f, 0x06, 0xfb, 0xab, 0x88, 0x35, 0x5d, 0xab, 0xd7, 0x4b, 0x24, 0x73, 0x7b, 0x38, 0xe2, 0xe9,
+	0x1c, 0xaf, 0x96, 0xce, 0x10, 0x5d, 0x05, 0xdc, 0x2a, 0x9a, 0xfa, 0xf2, 0xf8, 0xa8, 0xa1, 0x36,
+	0x9a, 0x5a, 0x55, 0x2f, 0xad, 0x31, 0x77, 0x86, 0x23, 0xbe, 0x9c, 0x23, 0xab, 0x03, 0x1f, 0x79,
+	0xd0, 0x8b, 0x1c, 0xab, 0xff, 0x3b, 0xe7, 0x66, 0x55, 0xd1, 0xd5, 0x52, 0x61, 0x85, 0x73, 0xd3,
+	0x6a, 0xf5, 0x21, 0x43, 0x5d, 0x7e, 0x64, 0x09, 0x45, 0xbf, 0x1a, 0xb3, 0xe4, 0xf5, 0x98, 0x25,
+	0xbf, 0x8f, 0x59, 0xf2, 0xfd, 0x84, 0x25, 0xae, 0x27, 0x2c, 0xf1, 0x65, 0xc2, 0x12, 0xaf, 0x2b,
+	0x4b, 0x1b, 0xba, 0x7c, 0xd1, 0x16, 0x40, 0x1e, 0x2c, 0x7e, 0x0c, 0x78, 0x83, 0x5b, 0xff, 0xe1,
+	0x9b, 0xfb, 0xe8, 0xe7, 0x00, 0xcb, 0x76, 0x23, 0xde, 0x3b, 0x04, 0x00, 0x00,
+}
+
+func (this *EmissionCurve) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionCurve)
+	if !ok {
+		that2, ok := that.(EmissionCurve)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.CliffEpochs != that1.CliffEpochs {
+		return false
+	}
+	if !this.DecayFactor.Equal(that1.DecayFactor) {
+		return false
+	}
+	if this.DecayPeriodEpochs != that1.DecayPeriodEpochs {
+		return false
+	}
+	if len(this.EpochWeights) != len(that1.EpochWeights) {
+		return false
+	}
+	for i := range this.EpochWeights {
+		if !this.EpochWeights[i].Equal(that1.EpochWeights[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *EmissionCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochWeights) > 0 {
+		for iNdEx := len(m.EpochWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpochWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.EpochWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintEmissionCurve(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DecayPeriodEpochs != 0 {
+		i = encodeVarintEmissionCurve(dAtA, i, uint64(m.DecayPeriodEpochs))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEmissionCurve(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CliffEpochs != 0 {
+		i = encodeVarintEmissionCurve(dAtA, i, uint64(m.CliffEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintEmissionCurve(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEmissionCurve(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintEmissionCurve(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEmissionCurve(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEmissionCurve(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EmissionCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovEmissionCurve(uint64(m.Type))
+	}
+	if m.CliffEpochs != 0 {
+		n += 1 + sovEmissionCurve(uint64(m.CliffEpochs))
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovEmissionCurve(uint64(l))
+	if m.DecayPeriodEpochs != 0 {
+		n += 1 + sovEmissionCurve(uint64(m.DecayPeriodEpochs))
+	}
+	if len(m.EpochWeights) > 0 {
+		for _, e := range m.EpochWeights {
+			l = e.Size()
+			n += 1 + l + sovEmissionCurve(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovEmissionCurve(uint64(m.Epoch))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovEmissionCurve(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEmissionCurve(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEmissionCurve(x uint64) (n int) {
+	return sovEmissionCurve(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EmissionCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmissionCurve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EmissionCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffEpochs", wireType)
+			}
+			m.CliffEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriodEpochs", wireType)
+			}
+			m.DecayPeriodEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriodEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.EpochWeights = append(m.EpochWeights, v)
+			if err := m.EpochWeights[len(m.EpochWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmissionCurve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEmissionCurve
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEmissionCurve(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEmissionCurve
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEmissionCurve(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEmissionCurve
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEmissionCurve
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEmissionCurve
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEmissionCurve
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEmissionCurve
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEmissionCurve        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEmissionCurve          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEmissionCurve = fmt.Errorf("proto: unexpected end of group")
+)
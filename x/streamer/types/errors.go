@@ -17,4 +17,11 @@ var (
 	ErrDistrInfoTotalWeightNotEqual = sdkerrors.Register(ModuleName, 12, "total weight is not equal to sum of weights in records")
 
 	ErrInvalidStreamStatus = sdkerrors.Register(ModuleName, 20, "invalid stream status")
+
+	ErrVoteNotFound  = sdkerrors.Register(ModuleName, 30, "vote not found")
+	ErrNoVotingPower = sdkerrors.Register(ModuleName, 31, "voter has no voting power")
+
+	ErrRollappGaugeNotFound = sdkerrors.Register(ModuleName, 40, "rollapp gauge not found")
+
+	ErrInvalidEmissionCurve = sdkerrors.Register(ModuleName, 50, "invalid emission curve")
 )
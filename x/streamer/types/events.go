@@ -2,10 +2,22 @@ package types
 
 // Incentive module event types.
 const (
-	TypeEvtCreateStream = "create_stream"
-	TypeEvtDistribution = "distribution"
+	TypeEvtCreateStream    = "create_stream"
+	TypeEvtTerminateStream = "terminate_stream"
+	TypeEvtAddToStream     = "add_to_stream"
+	TypeEvtVote            = "vote"
+	TypeEvtRevokeVote      = "revoke_vote"
+	TypeEvtDistribution    = "distribution"
 
-	AttributeStreamID = "stream_id"
-	AttributeReceiver = "receiver"
-	AttributeAmount   = "amount"
+	TypeEvtCreateRollappGauge       = "create_rollapp_gauge"
+	TypeEvtSetRollappGaugeRecipient = "set_rollapp_gauge_recipient"
+	TypeEvtRollappGaugeDistribution = "rollapp_gauge_distribution"
+
+	AttributeStreamID  = "stream_id"
+	AttributeReceiver  = "receiver"
+	AttributeAmount    = "amount"
+	AttributeOwner     = "owner"
+	AttributeVoter     = "voter"
+	AttributeGaugeID   = "gauge_id"
+	AttributeRollappID = "rollapp_id"
 )
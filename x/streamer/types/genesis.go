@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,14 +10,39 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		Streams:      []Stream{},
-		LastStreamId: 0,
+		Params:        DefaultParams(),
+		Streams:       []Stream{},
+		LastStreamId:  0,
+		Votes:         []Vote{},
+		RollappGauges: []RollappGauge{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	voters := make(map[string]bool)
+	for _, vote := range gs.Votes {
+		if err := vote.Validate(); err != nil {
+			return err
+		}
+		if voters[vote.Voter] {
+			return fmt.Errorf("duplicated vote of %s", vote.Voter)
+		}
+		voters[vote.Voter] = true
+	}
+
+	gaugeIDs := make(map[uint64]bool)
+	rollappIDs := make(map[string]bool)
+	for _, rollappGauge := range gs.RollappGauges {
+		if err := rollappGauge.Validate(); err != nil {
+			return err
+		}
+		if gaugeIDs[rollappGauge.GaugeId] || rollappIDs[rollappGauge.RollappId] {
+			return fmt.Errorf("duplicated rollapp gauge %d of %s", rollappGauge.GaugeId, rollappGauge.RollappId)
+		}
+		gaugeIDs[rollappGauge.GaugeId] = true
+		rollappIDs[rollappGauge.RollappId] = true
+	}
 	return gs.Params.Validate()
 }
@@ -34,6 +34,11 @@ type GenesisState struct {
 	// last_stream_id is what the stream number will increment from when creating
 	// the next stream after genesis
 	LastStreamId uint64 `protobuf:"varint,3,opt,name=last_stream_id,json=lastStreamId,proto3" json:"last_stream_id,omitempty"`
+	// votes are the gauge votes of the delegators, the vote tally is rebuilt
+	// from them
+	Votes []Vote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes"`
+	// rollapp_gauges are the gauges of the registered rollapps
+	RollappGauges []RollappGauge `protobuf:"bytes,5,rep,name=rollapp_gauges,json=rollappGauges,proto3" json:"rollapp_gauges"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +95,20 @@ func (m *GenesisState) GetLastStreamId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetVotes() []Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRollappGauges() []RollappGauge {
+	if m != nil {
+		return m.RollappGauges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.streamer.GenesisState")
 }
@@ -97,25 +116,29 @@ func init() {
 func init() { proto.RegisterFile("dymension/streamer/genesis.proto", fileDescriptor_4bce5e482260879a) }
 
 var fileDescriptor_4bce5e482260879a = []byte{
-	// 274 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x4d, 0x2d, 0xd2, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0xab,
-	0xa8, 0xa8, 0xac, 0xd2, 0x83, 0x73, 0xf4, 0x60, 0xca, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1,
-	0x6a, 0xf5, 0x41, 0x2c, 0x88, 0x36, 0x29, 0xb9, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0x7d, 0x30,
-	0x2f, 0xa9, 0x34, 0x4d, 0x3f, 0xa5, 0xb4, 0x28, 0xb1, 0x04, 0xa4, 0x11, 0x22, 0x2f, 0x8f, 0xc5,
-	0xe2, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x3c, 0x0a, 0x20, 0x0c, 0x88, 0x02, 0xa5, 0x23, 0x8c,
-	0x5c, 0x3c, 0xee, 0x10, 0xa7, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0xb9, 0x72, 0xb1, 0x41, 0x4c,
-	0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd7, 0x23, 0xe0, 0x74, 0xbd, 0x00, 0xb0, 0x72,
-	0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x9a, 0x85, 0xdc, 0xb9, 0xd8, 0x21, 0x0a, 0x8a,
-	0x25, 0x98, 0x14, 0x98, 0x89, 0x32, 0x27, 0x18, 0xcc, 0x80, 0x9a, 0x03, 0xd3, 0x2d, 0xa4, 0xc2,
-	0xc5, 0x97, 0x93, 0x58, 0x5c, 0x12, 0x0f, 0xe1, 0xc7, 0x67, 0xa6, 0x48, 0x30, 0x2b, 0x30, 0x6a,
-	0xb0, 0x04, 0xf1, 0x80, 0x44, 0x21, 0x5a, 0x3c, 0x53, 0x9c, 0x7c, 0x4e, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x57, 0x1f, 0xd9, 0x05, 0x08, 0x8e, 0x7e, 0x05, 0x22, 0x6c, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93,
-	0xd8, 0xc0, 0x61, 0x63, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xd2, 0xa3, 0x84, 0x2b, 0xd8, 0x01,
-	0x00, 0x00,
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcb, 0x4a, 0x3b, 0x31,
+	0x14, 0xc6, 0x67, 0x7a, 0xfb, 0x43, 0xfe, 0xb5, 0x8b, 0xc1, 0xc5, 0x50, 0x30, 0x1d, 0xc4, 0x4b,
+	0x37, 0x26, 0x50, 0x9f, 0xc0, 0x82, 0x14, 0xc1, 0x85, 0xb4, 0xe0, 0xa2, 0x9b, 0x92, 0x3a, 0x31,
+	0x0e, 0xcc, 0x34, 0x43, 0x92, 0x91, 0xd6, 0xa7, 0xf0, 0xb1, 0xba, 0xec, 0xd2, 0x95, 0x48, 0xe7,
+	0x45, 0x64, 0x92, 0xf4, 0x22, 0x14, 0xc7, 0xdd, 0x39, 0x39, 0xbf, 0xef, 0xfb, 0x92, 0x1c, 0x10,
+	0x84, 0x8b, 0x84, 0xce, 0x64, 0xc4, 0x67, 0x58, 0x2a, 0x41, 0x49, 0x42, 0x05, 0x66, 0x74, 0x46,
+	0x65, 0x24, 0x51, 0x2a, 0xb8, 0xe2, 0x5e, 0x67, 0x4b, 0xcc, 0x17, 0x6f, 0x68, 0xdb, 0xa0, 0x0d,
+	0xde, 0x3e, 0x66, 0x9c, 0x71, 0xcd, 0xe2, 0xa2, 0x32, 0xb2, 0x36, 0x64, 0x9c, 0xb3, 0x98, 0x62,
+	0xdd, 0x4d, 0xb3, 0x67, 0x1c, 0x66, 0x82, 0xa8, 0x42, 0x68, 0xe6, 0x9d, 0x03, 0xc1, 0x29, 0x11,
+	0x24, 0x91, 0xbf, 0x00, 0xa6, 0xb0, 0xc0, 0xc9, 0x01, 0xe0, 0x95, 0x2b, 0x6a, 0xc7, 0x17, 0x07,
+	0xc6, 0x82, 0xc7, 0x31, 0x49, 0xd3, 0x09, 0x23, 0x19, 0xb3, 0xdc, 0x69, 0x5e, 0x01, 0xcd, 0x81,
+	0x79, 0xf1, 0x48, 0x11, 0x45, 0xbd, 0x5b, 0xd0, 0x30, 0x17, 0xf1, 0xdd, 0xc0, 0xed, 0xfe, 0xef,
+	0x5d, 0xa2, 0x92, 0x1f, 0x40, 0x0f, 0x1a, 0xef, 0xd7, 0x96, 0x9f, 0x1d, 0x67, 0x68, 0xc5, 0xde,
+	0x00, 0xfc, 0x33, 0x80, 0xf4, 0x2b, 0x41, 0xf5, 0x4f, 0x3e, 0x23, 0x5d, 0x58, 0x9f, 0x8d, 0xda,
+	0x3b, 0x03, 0xad, 0x98, 0x48, 0x35, 0x31, 0xfd, 0x24, 0x0a, 0xfd, 0x6a, 0xe0, 0x76, 0x6b, 0xc3,
+	0x66, 0x71, 0x6a, 0x24, 0x77, 0xa1, 0x77, 0x03, 0xea, 0xc5, 0xe3, 0xa5, 0x5f, 0xd3, 0x61, 0xe7,
+	0xa5, 0x61, 0x8f, 0x5c, 0x51, 0x1b, 0x65, 0x94, 0xde, 0x18, 0xb4, 0x7e, 0x7c, 0x90, 0xf4, 0xeb,
+	0xda, 0xeb, 0xaa, 0xd4, 0x6b, 0x68, 0x64, 0x83, 0x42, 0x65, 0x3d, 0x8f, 0xc4, 0xde, 0x99, 0xec,
+	0xdf, 0x2f, 0xd7, 0xd0, 0x5d, 0xad, 0xa1, 0xfb, 0xb5, 0x86, 0xee, 0x7b, 0x0e, 0x9d, 0x55, 0x0e,
+	0x9d, 0x8f, 0x1c, 0x3a, 0xe3, 0x1e, 0x8b, 0xd4, 0x4b, 0x36, 0x45, 0x4f, 0x3c, 0xc1, 0xfb, 0x39,
+	0xbb, 0x06, 0xcf, 0x77, 0x1b, 0x54, 0x8b, 0x94, 0xca, 0x69, 0x43, 0xaf, 0xee, 0xfa, 0x7b, 0x00,
+	0x26, 0xcd, 0x65, 0x02, 0xbe, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +161,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RollappGauges) > 0 {
+		for iNdEx := len(m.RollappGauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RollappGauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.LastStreamId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LastStreamId))
 		i--
@@ -198,6 +249,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.LastStreamId != 0 {
 		n += 1 + sovGenesis(uint64(m.LastStreamId))
 	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RollappGauges) > 0 {
+		for _, e := range m.RollappGauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -322,6 +385,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappGauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappGauges = append(m.RollappGauges, RollappGauge{})
+			if err := m.RollappGauges[len(m.RollappGauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -41,6 +41,11 @@ type CreateStreamProposal struct {
 	// num_epochs_paid_over is the number of epochs distribution will be completed
 	// over
 	NumEpochsPaidOver uint64 `protobuf:"varint,7,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// sponsored streams distribute according to the gauge votes of the stakers
+	Sponsored bool `protobuf:"varint,8,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	// emission_curve is how the stream spreads its coins over its epochs, the
+	// stream distributes linearly without a curve
+	EmissionCurve *EmissionCurve `protobuf:"bytes,9,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
 }
 
 func (m *CreateStreamProposal) Reset()      { *m = CreateStreamProposal{} }
@@ -113,9 +118,56 @@ func (m *TerminateStreamProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TerminateStreamProposal proto.InternalMessageInfo
 
+// AddToStreamProposal is a gov Content type for topping up a stream funded
+// by the module. If an AddToStreamProposal passes, the proposal's coins,
+// which must sit unallocated in the module account, are added to the stream
+// and its distribution is extended by num_epochs_to_add epochs.
+type AddToStreamProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	StreamId    uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// coins are the coin(s) to add to the stream
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// num_epochs_to_add is the number of epochs the distribution is extended by
+	NumEpochsToAdd uint64 `protobuf:"varint,5,opt,name=num_epochs_to_add,json=numEpochsToAdd,proto3" json:"num_epochs_to_add,omitempty"`
+}
+
+func (m *AddToStreamProposal) Reset()      { *m = AddToStreamProposal{} }
+func (*AddToStreamProposal) ProtoMessage() {}
+func (*AddToStreamProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_262baf3a8fd3b272, []int{2}
+}
+func (m *AddToStreamProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddToStreamProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddToStreamProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddToStreamProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddToStreamProposal.Merge(m, src)
+}
+func (m *AddToStreamProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddToStreamProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddToStreamProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddToStreamProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*CreateStreamProposal)(nil), "dymensionxyz.dymension.streamer.CreateStreamProposal")
 	proto.RegisterType((*TerminateStreamProposal)(nil), "dymensionxyz.dymension.streamer.TerminateStreamProposal")
+	proto.RegisterType((*AddToStreamProposal)(nil), "dymensionxyz.dymension.streamer.AddToStreamProposal")
 }
 
 func init() {
@@ -123,41 +175,47 @@ func init() {
 }
 
 var fileDescriptor_262baf3a8fd3b272 = []byte{
-	// 540 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xb6, 0x69, 0x52, 0xc8, 0x85, 0x01, 0x4c, 0x00, 0x13, 0xc0, 0x0e, 0x61, 0xc9, 0x00, 0x77,
-	0x34, 0x6c, 0x1d, 0x53, 0x18, 0x2a, 0x21, 0x51, 0x99, 0x48, 0x95, 0x58, 0xac, 0xb3, 0x7d, 0x71,
-	0x4f, 0xc4, 0x7e, 0xd6, 0xdd, 0x25, 0x6a, 0x10, 0x7f, 0x00, 0x62, 0xea, 0xc8, 0x98, 0x99, 0xbf,
-	0xa4, 0x63, 0x47, 0xa6, 0x14, 0x25, 0x0b, 0x73, 0xff, 0x02, 0xe4, 0xbb, 0xfc, 0x42, 0xaa, 0xc4,
-	0xc2, 0x64, 0xbf, 0xf7, 0xbe, 0xef, 0x7b, 0xa7, 0xef, 0x7b, 0xe8, 0x79, 0x32, 0xc9, 0x58, 0x2e,
-	0x39, 0xe4, 0x44, 0x2a, 0xc1, 0x68, 0xc6, 0x04, 0x49, 0x61, 0x1c, 0x9a, 0x02, 0x17, 0x02, 0x14,
-	0x38, 0xfe, 0x1a, 0x74, 0x3a, 0xf9, 0x8c, 0xd7, 0x05, 0x5e, 0x31, 0x9a, 0x8d, 0x14, 0x52, 0xd0,
-	0x58, 0x52, 0xfe, 0x19, 0x5a, 0xd3, 0x8b, 0x41, 0x66, 0x20, 0x49, 0x44, 0x25, 0x23, 0xe3, 0xbd,
-	0x88, 0x29, 0xba, 0x47, 0x62, 0xe0, 0xf9, 0x72, 0xee, 0xa7, 0x00, 0xe9, 0x90, 0x11, 0x5d, 0x45,
-	0xa3, 0x01, 0x51, 0x3c, 0x63, 0x52, 0xd1, 0xac, 0x58, 0x02, 0xae, 0x7b, 0x5c, 0xc2, 0xa5, 0x12,
-	0x21, 0xcf, 0x07, 0xcb, 0x2d, 0xed, 0x6f, 0x15, 0xd4, 0x38, 0x10, 0x8c, 0x2a, 0xf6, 0x41, 0x63,
-	0x8e, 0x04, 0x14, 0x20, 0xe9, 0xd0, 0x69, 0xa0, 0xaa, 0xe2, 0x6a, 0xc8, 0x5c, 0xbb, 0x65, 0x77,
-	0x6a, 0x81, 0x29, 0x9c, 0x16, 0xaa, 0x27, 0x4c, 0xc6, 0x82, 0x17, 0x8a, 0x43, 0xee, 0xde, 0xd0,
-	0xb3, 0xed, 0x96, 0x33, 0x40, 0xf7, 0xf5, 0x12, 0x1e, 0x8d, 0x14, 0x0b, 0x15, 0x84, 0x82, 0xc5,
-	0x20, 0x12, 0xe9, 0xee, 0xb4, 0x76, 0x3a, 0xf5, 0xee, 0x0b, 0xfc, 0x0f, 0x37, 0xf0, 0x9b, 0x92,
-	0x1d, 0x68, 0x52, 0xaf, 0x72, 0x3e, 0xf3, 0xad, 0xe0, 0xde, 0x46, 0xb0, 0x0f, 0x66, 0x22, 0x1d,
-	0x8a, 0xaa, 0xa5, 0x19, 0xd2, 0xad, 0x68, 0xdd, 0x47, 0xd8, 0xd8, 0x85, 0x4b, 0xbb, 0xf0, 0xd2,
-	0x2e, 0x7c, 0x00, 0x3c, 0xef, 0xbd, 0x2a, 0x45, 0x7e, 0x5c, 0xfa, 0x9d, 0x94, 0xab, 0x93, 0x51,
-	0x84, 0x63, 0xc8, 0xc8, 0xd2, 0x5b, 0xf3, 0x79, 0x29, 0x93, 0x4f, 0x44, 0x4d, 0x0a, 0x26, 0x35,
-	0x41, 0x06, 0x46, 0xd9, 0x39, 0x46, 0x48, 0x2a, 0x2a, 0x54, 0x58, 0x3a, 0xeb, 0x56, 0x5b, 0x76,
-	0xa7, 0xde, 0x6d, 0x62, 0x63, 0x3b, 0x5e, 0xd9, 0x8e, 0xfb, 0x2b, 0xdb, 0x7b, 0x4f, 0xca, 0x45,
-	0x57, 0x33, 0xff, 0xce, 0x84, 0x66, 0xc3, 0xfd, 0xf6, 0x3a, 0x8f, 0xf6, 0xd9, 0xa5, 0x6f, 0x07,
-	0x35, 0xad, 0x55, 0xa2, 0x9d, 0x63, 0xf4, 0xc0, 0x04, 0xc1, 0x0a, 0x88, 0x4f, 0x42, 0x9e, 0xb0,
-	0x5c, 0xf1, 0x01, 0x67, 0xc2, 0xdd, 0x2d, 0x0d, 0xed, 0x3d, 0xbb, 0x9a, 0xf9, 0x4f, 0x8d, 0xc8,
-	0xf5, 0xb8, 0x76, 0xd0, 0xd0, 0x83, 0xb7, 0x65, 0xff, 0x70, 0xdd, 0x76, 0x08, 0x6a, 0xe4, 0xa3,
-	0xcc, 0xc0, 0x65, 0x58, 0x50, 0x9e, 0x84, 0x30, 0x66, 0xc2, 0xbd, 0xd9, 0xb2, 0x3b, 0x95, 0xe0,
-	0x6e, 0x3e, 0xca, 0x34, 0x43, 0x1e, 0x51, 0x9e, 0xbc, 0x1f, 0x33, 0xb1, 0x7f, 0xfb, 0xeb, 0xd4,
-	0xb7, 0xbe, 0x4f, 0x7d, 0xeb, 0xf7, 0xd4, 0xb7, 0xdb, 0x5f, 0xd0, 0xc3, 0x3e, 0x13, 0x19, 0xcf,
-	0xff, 0xdf, 0x39, 0x3c, 0x46, 0x35, 0x93, 0x6c, 0xc8, 0x13, 0xb7, 0xa2, 0x9f, 0x71, 0xcb, 0x34,
-	0x0e, 0x93, 0xbf, 0xb7, 0xf7, 0xde, 0x9d, 0xcf, 0x3d, 0xfb, 0x62, 0xee, 0xd9, 0xbf, 0xe6, 0x9e,
-	0x7d, 0xb6, 0xf0, 0xac, 0x8b, 0x85, 0x67, 0xfd, 0x5c, 0x78, 0xd6, 0xc7, 0xee, 0x56, 0x72, 0xdb,
-	0xe7, 0xb3, 0x29, 0xc8, 0xe9, 0xe6, 0xc6, 0x75, 0x92, 0xd1, 0xae, 0x0e, 0xe8, 0xf5, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x68, 0xa4, 0x8d, 0xeb, 0xa3, 0x03, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x4f, 0x4f, 0x13, 0x5f,
+	0x14, 0xed, 0x40, 0xe1, 0x47, 0x1f, 0x3f, 0x89, 0x0c, 0x55, 0x47, 0xc4, 0x99, 0x5a, 0x17, 0xd6,
+	0x44, 0xdf, 0x08, 0xee, 0xd8, 0x51, 0x64, 0x41, 0x62, 0x22, 0x19, 0x6b, 0x48, 0xdc, 0x4c, 0xa6,
+	0xf3, 0x6e, 0xcb, 0x8b, 0x9d, 0xb9, 0x93, 0xf7, 0x5e, 0x1b, 0x6a, 0x5c, 0x1b, 0x97, 0x2c, 0x5d,
+	0xb2, 0xf6, 0x93, 0xb0, 0x64, 0xe9, 0x0a, 0x0c, 0x6c, 0x5c, 0xf3, 0x05, 0x34, 0xf3, 0x5e, 0xff,
+	0x41, 0x48, 0xd8, 0xa8, 0xab, 0xf6, 0xde, 0x7b, 0xce, 0xbd, 0x77, 0xce, 0xb9, 0x33, 0xe4, 0x31,
+	0xeb, 0x27, 0x90, 0x4a, 0x8e, 0xa9, 0x2f, 0x95, 0x80, 0x28, 0x01, 0xe1, 0xb7, 0xb1, 0x17, 0x9a,
+	0x80, 0x66, 0x02, 0x15, 0xda, 0xde, 0x08, 0xb4, 0xdf, 0xff, 0x48, 0x47, 0x01, 0x1d, 0x32, 0x96,
+	0xcb, 0x6d, 0x6c, 0xa3, 0xc6, 0xfa, 0xf9, 0x3f, 0x43, 0x5b, 0x76, 0x63, 0x94, 0x09, 0x4a, 0xbf,
+	0x19, 0x49, 0xf0, 0x7b, 0xab, 0x4d, 0x50, 0xd1, 0xaa, 0x1f, 0x23, 0x4f, 0x07, 0x75, 0xaf, 0x8d,
+	0xd8, 0xee, 0x80, 0xaf, 0xa3, 0x66, 0xb7, 0xe5, 0x2b, 0x9e, 0x80, 0x54, 0x51, 0x92, 0x0d, 0x00,
+	0xd7, 0x2d, 0xc7, 0xb8, 0x54, 0x22, 0xe4, 0x69, 0x6b, 0x38, 0xe5, 0xc9, 0x35, 0x20, 0x48, 0xb8,
+	0xcc, 0x33, 0x61, 0xdc, 0x15, 0x3d, 0x30, 0xc0, 0xea, 0xaf, 0x22, 0x29, 0x6f, 0x0a, 0x88, 0x14,
+	0xbc, 0xd5, 0xb8, 0x1d, 0x81, 0x19, 0xca, 0xa8, 0x63, 0x97, 0xc9, 0x8c, 0xe2, 0xaa, 0x03, 0x8e,
+	0x55, 0xb1, 0x6a, 0xa5, 0xc0, 0x04, 0x76, 0x85, 0xcc, 0x33, 0x90, 0xb1, 0xe0, 0x99, 0xe2, 0x98,
+	0x3a, 0x53, 0xba, 0x36, 0x99, 0xb2, 0x5b, 0xe4, 0x8e, 0xde, 0x86, 0x37, 0xbb, 0x0a, 0x42, 0x85,
+	0xa1, 0x80, 0x18, 0x05, 0x93, 0xce, 0x74, 0x65, 0xba, 0x36, 0xbf, 0xf6, 0x8c, 0xde, 0x20, 0x1b,
+	0x7d, 0x95, 0xb3, 0x03, 0x4d, 0xaa, 0x17, 0x8f, 0x4e, 0xbc, 0x42, 0xb0, 0x34, 0x6e, 0xd8, 0x40,
+	0x53, 0x91, 0x76, 0x44, 0x66, 0x72, 0xd5, 0xa4, 0x53, 0xd4, 0x7d, 0xef, 0x53, 0xa3, 0x2b, 0xcd,
+	0x75, 0xa5, 0x03, 0x5d, 0xe9, 0x26, 0xf2, 0xb4, 0xfe, 0x22, 0x6f, 0xf2, 0xed, 0xd4, 0xab, 0xb5,
+	0xb9, 0xda, 0xeb, 0x36, 0x69, 0x8c, 0x89, 0x3f, 0x30, 0xc1, 0xfc, 0x3c, 0x97, 0xec, 0x83, 0xaf,
+	0xfa, 0x19, 0x48, 0x4d, 0x90, 0x81, 0xe9, 0x6c, 0xef, 0x12, 0x22, 0x55, 0x24, 0x54, 0x98, 0x5b,
+	0xe0, 0xcc, 0x54, 0xac, 0xda, 0xfc, 0xda, 0x32, 0x35, 0xfe, 0xd0, 0xa1, 0x3f, 0xb4, 0x31, 0xf4,
+	0xa7, 0xbe, 0x92, 0x0f, 0xba, 0x38, 0xf1, 0x6e, 0xf7, 0xa3, 0xa4, 0xb3, 0x5e, 0x1d, 0x19, 0x57,
+	0x3d, 0x38, 0xf5, 0xac, 0xa0, 0xa4, 0x7b, 0xe5, 0x68, 0x7b, 0x97, 0xdc, 0x35, 0x8e, 0x41, 0x86,
+	0xf1, 0x5e, 0xc8, 0x19, 0xa4, 0x8a, 0xb7, 0x38, 0x08, 0x67, 0x36, 0x17, 0xb4, 0xfe, 0xe8, 0xe2,
+	0xc4, 0x7b, 0x68, 0x9a, 0x5c, 0x8f, 0xab, 0x06, 0x65, 0x5d, 0xd8, 0xca, 0xf3, 0xdb, 0xa3, 0xb4,
+	0xed, 0x93, 0x72, 0xda, 0x4d, 0x0c, 0x5c, 0x86, 0x59, 0xc4, 0x59, 0x88, 0x3d, 0x10, 0xce, 0x7f,
+	0x15, 0xab, 0x56, 0x0c, 0x16, 0xd3, 0x6e, 0xa2, 0x19, 0x72, 0x27, 0xe2, 0xec, 0x4d, 0x0f, 0x84,
+	0xbd, 0x42, 0x4a, 0x32, 0xc3, 0x54, 0xa2, 0x00, 0xe6, 0xcc, 0x55, 0xac, 0xda, 0x5c, 0x30, 0x4e,
+	0xd8, 0xef, 0xc8, 0xc2, 0xe5, 0xa3, 0x71, 0x4a, 0x5a, 0x04, 0x7a, 0xa3, 0x89, 0x5b, 0x03, 0xda,
+	0x66, 0xce, 0x0a, 0x6e, 0xc1, 0x64, 0xb8, 0xfe, 0xff, 0x97, 0x43, 0xaf, 0xf0, 0xf5, 0xd0, 0x2b,
+	0xfc, 0x3c, 0xf4, 0xac, 0xea, 0x27, 0x72, 0xaf, 0x01, 0x22, 0xe1, 0xe9, 0x9f, 0xbb, 0xc1, 0x07,
+	0xa4, 0x64, 0x36, 0x09, 0x39, 0x73, 0x8a, 0xfa, 0xd9, 0xe7, 0x4c, 0x62, 0x9b, 0x5d, 0x99, 0xfe,
+	0x79, 0x8a, 0x2c, 0x6d, 0x30, 0xd6, 0xc0, 0xbf, 0x31, 0x7a, 0xfa, 0xf2, 0xe8, 0x7f, 0x71, 0xb3,
+	0x4f, 0xc9, 0xe2, 0xc4, 0x05, 0x28, 0x0c, 0x23, 0xc6, 0xf4, 0xe9, 0x16, 0x83, 0x85, 0x91, 0xfd,
+	0x0d, 0xdc, 0x60, 0x57, 0x84, 0xa8, 0xbf, 0x3e, 0x3a, 0x73, 0xad, 0xe3, 0x33, 0xd7, 0xfa, 0x71,
+	0xe6, 0x5a, 0x07, 0xe7, 0x6e, 0xe1, 0xf8, 0xdc, 0x2d, 0x7c, 0x3f, 0x77, 0x0b, 0xef, 0xd7, 0x26,
+	0x76, 0x98, 0xf4, 0x7d, 0x1c, 0xf8, 0xfb, 0xe3, 0xaf, 0x8c, 0xde, 0xa9, 0x39, 0xab, 0x5f, 0x8f,
+	0x97, 0xbf, 0x07, 0x00, 0x04, 0x79, 0xa2, 0x5e, 0x4a, 0x05, 0x00, 0x00,
 }
 
 func (this *CreateStreamProposal) Equal(that interface{}) bool {
@@ -210,6 +268,12 @@ func (this *CreateStreamProposal) Equal(that interface{}) bool {
 	if this.NumEpochsPaidOver != that1.NumEpochsPaidOver {
 		return false
 	}
+	if this.Sponsored != that1.Sponsored {
+		return false
+	}
+	if !this.EmissionCurve.Equal(that1.EmissionCurve) {
+		return false
+	}
 	return true
 }
 func (this *TerminateStreamProposal) Equal(that interface{}) bool {
@@ -242,6 +306,47 @@ func (this *TerminateStreamProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *AddToStreamProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddToStreamProposal)
+	if !ok {
+		that2, ok := that.(AddToStreamProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.StreamId != that1.StreamId {
+		return false
+	}
+	if len(this.Coins) != len(that1.Coins) {
+		return false
+	}
+	for i := range this.Coins {
+		if !this.Coins[i].Equal(&that1.Coins[i]) {
+			return false
+		}
+	}
+	if this.NumEpochsToAdd != that1.NumEpochsToAdd {
+		return false
+	}
+	return true
+}
 func (m *CreateStreamProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -262,6 +367,28 @@ func (m *CreateStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionCurve != nil {
+		{
+			size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGovStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Sponsored {
+		i--
+		if m.Sponsored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintGovStream(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
@@ -274,12 +401,12 @@ func (m *CreateStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGovStream(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGovStream(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -369,6 +496,67 @@ func (m *TerminateStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *AddToStreamProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddToStreamProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddToStreamProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsToAdd != 0 {
+		i = encodeVarintGovStream(dAtA, i, uint64(m.NumEpochsToAdd))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintGovStream(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGovStream(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGovStream(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGovStream(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGovStream(v)
 	base := offset
@@ -415,6 +603,13 @@ func (m *CreateStreamProposal) Size() (n int) {
 	if m.NumEpochsPaidOver != 0 {
 		n += 1 + sovGovStream(uint64(m.NumEpochsPaidOver))
 	}
+	if m.Sponsored {
+		n += 2
+	}
+	if m.EmissionCurve != nil {
+		l = m.EmissionCurve.Size()
+		n += 1 + l + sovGovStream(uint64(l))
+	}
 	return n
 }
 
@@ -438,6 +633,35 @@ func (m *TerminateStreamProposal) Size() (n int) {
 	return n
 }
 
+func (m *AddToStreamProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGovStream(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGovStream(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovGovStream(uint64(m.StreamId))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovGovStream(uint64(l))
+		}
+	}
+	if m.NumEpochsToAdd != 0 {
+		n += 1 + sovGovStream(uint64(m.NumEpochsToAdd))
+	}
+	return n
+}
+
 func sovGovStream(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -689,6 +913,62 @@ func (m *CreateStreamProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sponsored = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionCurve == nil {
+				m.EmissionCurve = &EmissionCurve{}
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGovStream(dAtA[iNdEx:])
@@ -843,6 +1123,192 @@ func (m *TerminateStreamProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AddToStreamProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddToStreamProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddToStreamProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsToAdd", wireType)
+			}
+			m.NumEpochsToAdd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochsToAdd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGovStream(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,8 +37,20 @@ var (
 	// KeyPrefixFinishedStreams defines prefix key for storing reference key for finished streams.
 	KeyPrefixFinishedStreams = []byte{0x04, 0x02}
 
+	// KeySponsoredDistr defines key for storing the vote tally of the sponsored streams.
+	KeySponsoredDistr = []byte{0x05}
+
+	// KeyPrefixVotes defines prefix key for storing the gauge votes of the delegators.
+	KeyPrefixVotes = []byte{0x06}
+
 	// KeyIndexSeparator defines key for merging bytes.
 	KeyIndexSeparator = []byte{0x07}
+
+	// KeyPrefixRollappGauges defines prefix key for storing the rollapp gauges by gauge ID.
+	KeyPrefixRollappGauges = []byte{0x08}
+
+	// KeyPrefixRollappGaugeIDs defines prefix key for storing the gauge ID of each rollapp.
+	KeyPrefixRollappGaugeIDs = []byte{0x09}
 )
 
 func KeyPrefix(p string) []byte {
@@ -0,0 +1,277 @@
+package types
+
+import (
+	"fmt"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateStream    = "create_stream"
+	TypeMsgTerminateStream = "terminate_stream"
+	TypeMsgAddToStream     = "add_to_stream"
+	TypeMsgVote            = "vote"
+	TypeMsgRevokeVote      = "revoke_vote"
+
+	TypeMsgSetRollappGaugeRecipient = "set_rollapp_gauge_recipient"
+)
+
+var _ sdk.Msg = &MsgCreateStream{}
+
+// NewMsgCreateStream creates a message to create a stream funded by its owner.
+func NewMsgCreateStream(owner sdk.AccAddress, coins sdk.Coins, distrToRecords []DistrRecord, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, sponsored bool, emissionCurve *EmissionCurve) *MsgCreateStream {
+	return &MsgCreateStream{
+		Owner:                owner.String(),
+		DistributeToRecords:  distrToRecords,
+		Coins:                coins,
+		StartTime:            startTime,
+		DistrEpochIdentifier: epochIdentifier,
+		NumEpochsPaidOver:    numEpochsPaidOver,
+		Sponsored:            sponsored,
+		EmissionCurve:        emissionCurve,
+	}
+}
+
+// Route takes a create stream message, then returns the RouterKey used for slashing.
+func (m MsgCreateStream) Route() string { return RouterKey }
+
+// Type takes a create stream message, then returns a create stream message type.
+func (m MsgCreateStream) Type() string { return TypeMsgCreateStream }
+
+// ValidateBasic checks that the create stream message is valid.
+func (m MsgCreateStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if len(m.DistributeToRecords) == 0 {
+		return ErrEmptyProposalRecords
+	}
+	for _, record := range m.DistributeToRecords {
+		if err := record.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if !m.Coins.IsValid() || m.Coins.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "all coins %s must be positive", m.Coins)
+	}
+
+	if m.DistrEpochIdentifier == "" {
+		return fmt.Errorf("epoch identifier should be set")
+	}
+
+	if m.NumEpochsPaidOver == 0 {
+		return fmt.Errorf("numEpochsPaidOver must be greater than 0")
+	}
+	return ValidateEmissionCurve(m.EmissionCurve, m.NumEpochsPaidOver)
+}
+
+// GetSignBytes takes a create stream message and turns it into a byte array.
+func (m MsgCreateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a create stream message and returns the owner in a byte array.
+func (m MsgCreateStream) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTerminateStream{}
+
+// NewMsgTerminateStream creates a message to terminate a stream owned by the sender.
+func NewMsgTerminateStream(owner sdk.AccAddress, streamID uint64) *MsgTerminateStream {
+	return &MsgTerminateStream{
+		Owner:    owner.String(),
+		StreamId: streamID,
+	}
+}
+
+// Route takes a terminate stream message, then returns the RouterKey used for slashing.
+func (m MsgTerminateStream) Route() string { return RouterKey }
+
+// Type takes a terminate stream message, then returns a terminate stream message type.
+func (m MsgTerminateStream) Type() string { return TypeMsgTerminateStream }
+
+// ValidateBasic checks that the terminate stream message is valid.
+func (m MsgTerminateStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes takes a terminate stream message and turns it into a byte array.
+func (m MsgTerminateStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a terminate stream message and returns the owner in a byte array.
+func (m MsgTerminateStream) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgAddToStream{}
+
+// NewMsgAddToStream creates a message to add coins to a stream owned by the sender and to extend its distribution.
+func NewMsgAddToStream(owner sdk.AccAddress, streamID uint64, coins sdk.Coins, numEpochsToAdd uint64) *MsgAddToStream {
+	return &MsgAddToStream{
+		Owner:          owner.String(),
+		StreamId:       streamID,
+		Coins:          coins,
+		NumEpochsToAdd: numEpochsToAdd,
+	}
+}
+
+// Route takes an add to stream message, then returns the RouterKey used for slashing.
+func (m MsgAddToStream) Route() string { return RouterKey }
+
+// Type takes an add to stream message, then returns an add to stream message type.
+func (m MsgAddToStream) Type() string { return TypeMsgAddToStream }
+
+// ValidateBasic checks that the add to stream message is valid.
+func (m MsgAddToStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return ValidateAddToStream(m.Coins, m.NumEpochsToAdd)
+}
+
+// GetSignBytes takes an add to stream message and turns it into a byte array.
+func (m MsgAddToStream) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes an add to stream message and returns the owner in a byte array.
+func (m MsgAddToStream) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// ValidateAddToStream checks that a top-up adds coins to a stream or extends its distribution.
+func ValidateAddToStream(coins sdk.Coins, numEpochsToAdd uint64) error {
+	if !coins.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "all coins %s must be positive", coins)
+	}
+	if coins.IsZero() && numEpochsToAdd == 0 {
+		return fmt.Errorf("either coins or epochs must be added to the stream")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgVote{}
+
+// NewMsgVote creates a message to split the voting power of a delegator over gauges.
+func NewMsgVote(voter sdk.AccAddress, weights []DistrRecord) *MsgVote {
+	return &MsgVote{
+		Voter:   voter.String(),
+		Weights: weights,
+	}
+}
+
+// Route takes a vote message, then returns the RouterKey used for slashing.
+func (m MsgVote) Route() string { return RouterKey }
+
+// Type takes a vote message, then returns a vote message type.
+func (m MsgVote) Type() string { return TypeMsgVote }
+
+// ValidateBasic checks that the vote message is valid.
+func (m MsgVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	return ValidateVoteWeights(m.Weights)
+}
+
+// GetSignBytes takes a vote message and turns it into a byte array.
+func (m MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a vote message and returns the voter in a byte array.
+func (m MsgVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(m.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+var _ sdk.Msg = &MsgRevokeVote{}
+
+// NewMsgRevokeVote creates a message to remove the vote of a delegator.
+func NewMsgRevokeVote(voter sdk.AccAddress) *MsgRevokeVote {
+	return &MsgRevokeVote{
+		Voter: voter.String(),
+	}
+}
+
+// Route takes a revoke vote message, then returns the RouterKey used for slashing.
+func (m MsgRevokeVote) Route() string { return RouterKey }
+
+// Type takes a revoke vote message, then returns a revoke vote message type.
+func (m MsgRevokeVote) Type() string { return TypeMsgRevokeVote }
+
+// ValidateBasic checks that the revoke vote message is valid.
+func (m MsgRevokeVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Voter); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid voter address (%s)", err)
+	}
+	return nil
+}
+
+// GetSignBytes takes a revoke vote message and turns it into a byte array.
+func (m MsgRevokeVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a revoke vote message and returns the voter in a byte array.
+func (m MsgRevokeVote) GetSigners() []sdk.AccAddress {
+	voter, _ := sdk.AccAddressFromBech32(m.Voter)
+	return []sdk.AccAddress{voter}
+}
+
+var _ sdk.Msg = &MsgSetRollappGaugeRecipient{}
+
+// NewMsgSetRollappGaugeRecipient creates a message to designate the recipient of a rollapp gauge's rewards.
+func NewMsgSetRollappGaugeRecipient(creator sdk.AccAddress, rollappID string, recipient string) *MsgSetRollappGaugeRecipient {
+	return &MsgSetRollappGaugeRecipient{
+		Creator:   creator.String(),
+		RollappId: rollappID,
+		Recipient: recipient,
+	}
+}
+
+// Route takes a set rollapp gauge recipient message, then returns the RouterKey used for slashing.
+func (m MsgSetRollappGaugeRecipient) Route() string { return RouterKey }
+
+// Type takes a set rollapp gauge recipient message, then returns a set rollapp gauge recipient message type.
+func (m MsgSetRollappGaugeRecipient) Type() string { return TypeMsgSetRollappGaugeRecipient }
+
+// ValidateBasic checks that the set rollapp gauge recipient message is valid.
+func (m MsgSetRollappGaugeRecipient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Creator); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if m.RollappId == "" {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "rollapp id is empty")
+	}
+	if m.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address (%s)", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes takes a set rollapp gauge recipient message and turns it into a byte array.
+func (m MsgSetRollappGaugeRecipient) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a set rollapp gauge recipient message and returns the rollapp creator in a byte array.
+func (m MsgSetRollappGaugeRecipient) GetSigners() []sdk.AccAddress {
+	creator, _ := sdk.AccAddressFromBech32(m.Creator)
+	return []sdk.AccAddress{creator}
+}
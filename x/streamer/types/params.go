@@ -1,32 +1,63 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	appparams "github.com/dymensionxyz/dymension/app/params"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	// KeyCreateStreamFee is store's key for CreateStreamFee Params
+	KeyCreateStreamFee = []byte("CreateStreamFee")
+	// DefaultCreateStreamFee is the default value of CreateStreamFee, 10 DYM.
+	// The fee bounds the number of streams processed at each epoch end.
+	DefaultCreateStreamFee = sdk.NewCoin(appparams.BaseDenom, math.NewIntWithDecimal(10, appparams.BaseDenomUnit))
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(createStreamFee sdk.Coin) Params {
+	return Params{
+		CreateStreamFee: createStreamFee,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultCreateStreamFee)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCreateStreamFee, &p.CreateStreamFee, validateCreateStreamFee),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	return validateCreateStreamFee(p.CreateStreamFee)
+}
+
+// validateCreateStreamFee validates the CreateStreamFee param
+func validateCreateStreamFee(v interface{}) error {
+	fee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid create stream fee: %w", err)
+	}
+
 	return nil
 }
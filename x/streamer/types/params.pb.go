@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -25,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params holds parameters for the streamer module
 type Params struct {
+	// create_stream_fee is the fee paid to the community pool by the owner
+	// of a stream. A zero amount means the creation is free
+	CreateStreamFee types.Coin `protobuf:"bytes,1,opt,name=create_stream_fee,json=createStreamFee,proto3" json:"create_stream_fee" yaml:"create_stream_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -60,6 +64,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetCreateStreamFee() types.Coin {
+	if m != nil {
+		return m.CreateStreamFee
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.streamer.Params")
 }
@@ -67,17 +78,23 @@ func init() {
 func init() { proto.RegisterFile("dymension/streamer/params.proto", fileDescriptor_d38f7dac47a04ceb) }
 
 var fileDescriptor_d38f7dac47a04ceb = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
+	// 242 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xd3, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0x4d, 0x2d, 0xd2, 0x2f,
 	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x42, 0x28, 0xa8, 0xa8,
 	0xac, 0xd2, 0x83, 0x73, 0xf4, 0x60, 0xaa, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x6a, 0xf5,
-	0x41, 0x2c, 0x88, 0x36, 0x25, 0x0e, 0x2e, 0xb6, 0x00, 0xb0, 0x31, 0x4e, 0x3e, 0x27, 0x1e, 0xc9,
-	0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e,
-	0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97,
-	0x9c, 0x9f, 0xab, 0x8f, 0x6c, 0x0b, 0x82, 0xa3, 0x5f, 0x81, 0x70, 0x55, 0x49, 0x65, 0x41, 0x6a,
-	0x71, 0x12, 0x1b, 0xd8, 0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0x7a, 0x96, 0x1b,
-	0xb8, 0x00, 0x00, 0x00,
+	0x41, 0x2c, 0x88, 0x36, 0x29, 0xb9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xa4, 0xc4, 0xe2,
+	0x54, 0xfd, 0x32, 0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0xfd, 0xe4, 0xfc, 0xcc, 0x3c, 0x88, 0xbc,
+	0x52, 0x21, 0x17, 0x5b, 0x00, 0xd8, 0x1a, 0xa1, 0x74, 0x2e, 0xc1, 0xe4, 0xa2, 0xd4, 0xc4, 0x92,
+	0xd4, 0x78, 0x88, 0x91, 0xf1, 0x69, 0xa9, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x92,
+	0x7a, 0x10, 0x53, 0xf4, 0x40, 0xa6, 0xe8, 0x41, 0x4d, 0xd1, 0x73, 0xce, 0xcf, 0xcc, 0x73, 0x52,
+	0x38, 0x71, 0x4f, 0x9e, 0xe1, 0xd3, 0x3d, 0x79, 0x89, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x0c,
+	0x13, 0x94, 0x82, 0xf8, 0x21, 0x62, 0xc1, 0x60, 0x21, 0xb7, 0xd4, 0x54, 0x27, 0x9f, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
+	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
+	0x4b, 0xce, 0xcf, 0xd5, 0x47, 0xf6, 0x2e, 0x82, 0xa3, 0x5f, 0x81, 0x08, 0x9e, 0x92, 0xca, 0x82,
+	0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3f, 0x8c, 0x01, 0x03, 0x00, 0x10, 0x70, 0xf4, 0xa9, 0x41, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -100,6 +117,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.CreateStreamFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
@@ -120,6 +147,8 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = m.CreateStreamFee.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -158,6 +187,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateStreamFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CreateStreamFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -15,22 +15,27 @@ const (
 
 	// ProposalTypeTerminateStream defines the type for a TerminateStreamProposal
 	ProposalTypeTerminateStream = "TerminateStream"
+
+	// ProposalTypeAddToStream defines the type for a AddToStreamProposal
+	ProposalTypeAddToStream = "AddToStream"
 )
 
 // Assert CreateStreamProposal implements govtypes.Content at compile-time
 var _ govtypes.Content = &CreateStreamProposal{}
 var _ govtypes.Content = &TerminateStreamProposal{}
+var _ govtypes.Content = &AddToStreamProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreateStream)
 	govtypes.RegisterProposalType(ProposalTypeTerminateStream)
+	govtypes.RegisterProposalType(ProposalTypeAddToStream)
 
 }
 
 // NewCreateStreamProposal creates a new create stream proposal.
 //
 //nolint:interfacer
-func NewCreateStreamProposal(title, description string, coins sdk.Coins, distrToRecords []DistrRecord, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64) *CreateStreamProposal {
+func NewCreateStreamProposal(title, description string, coins sdk.Coins, distrToRecords []DistrRecord, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, sponsored bool, emissionCurve *EmissionCurve) *CreateStreamProposal {
 	return &CreateStreamProposal{
 		Title:                title,
 		Description:          description,
@@ -39,6 +44,8 @@ func NewCreateStreamProposal(title, description string, coins sdk.Coins, distrTo
 		StartTime:            startTime,
 		DistrEpochIdentifier: epochIdentifier,
 		NumEpochsPaidOver:    numEpochsPaidOver,
+		Sponsored:            sponsored,
+		EmissionCurve:        emissionCurve,
 	}
 }
 
@@ -75,7 +82,7 @@ func (csp *CreateStreamProposal) ValidateBasic() error {
 	if csp.NumEpochsPaidOver <= 0 {
 		return fmt.Errorf("numEpochsPaidOver must be greater than 0")
 	}
-	return nil
+	return ValidateEmissionCurve(csp.EmissionCurve, csp.NumEpochsPaidOver)
 }
 
 // String implements the Stringer interface.
@@ -89,7 +96,9 @@ func (csp CreateStreamProposal) String() string {
 	  StartTime:   %s
 	  EpochIdentifier:   %s
 	  NumEpochsPaidOver:   %d
-`, csp.Title, csp.Description, &csp.DistributeToRecords, csp.Coins, csp.StartTime, csp.DistrEpochIdentifier, csp.NumEpochsPaidOver))
+	  Sponsored:   %t
+	  EmissionCurve: %v
+`, csp.Title, csp.Description, &csp.DistributeToRecords, csp.Coins, csp.StartTime, csp.DistrEpochIdentifier, csp.NumEpochsPaidOver, csp.Sponsored, csp.EmissionCurve))
 	return b.String()
 }
 
@@ -136,3 +145,51 @@ func (csp TerminateStreamProposal) String() string {
 `, csp.Title, csp.Description, &csp.StreamId))
 	return b.String()
 }
+
+// NewAddToStreamProposal creates a new add to stream proposal.
+//
+//nolint:interfacer
+func NewAddToStreamProposal(title, description string, streamId uint64, coins sdk.Coins, numEpochsToAdd uint64) *AddToStreamProposal {
+	return &AddToStreamProposal{
+		Title:          title,
+		Description:    description,
+		StreamId:       streamId,
+		Coins:          coins,
+		NumEpochsToAdd: numEpochsToAdd,
+	}
+}
+
+// GetTitle returns the title of an add to stream proposal.
+func (csp *AddToStreamProposal) GetTitle() string { return csp.Title }
+
+// GetDescription returns the description of an add to stream proposal.
+func (csp *AddToStreamProposal) GetDescription() string { return csp.Description }
+
+// ProposalRoute returns the routing key of an add to stream proposal.
+func (csp *AddToStreamProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an add to stream proposal.
+func (csp *AddToStreamProposal) ProposalType() string { return ProposalTypeAddToStream }
+
+// ValidateBasic runs basic stateless validity checks
+func (csp *AddToStreamProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(csp)
+	if err != nil {
+		return err
+	}
+
+	return ValidateAddToStream(csp.Coins, csp.NumEpochsToAdd)
+}
+
+// String implements the Stringer interface.
+func (csp AddToStreamProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add to stream Proposal:
+	  Title:       %s
+	  Description: %s
+	  StreamID:    %d
+	  Coins:       %s
+	  NumEpochsToAdd:   %d
+`, csp.Title, csp.Description, csp.StreamId, csp.Coins, csp.NumEpochsToAdd))
+	return b.String()
+}
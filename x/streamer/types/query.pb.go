@@ -501,6 +501,358 @@ func (m *UpcomingStreamsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+type VoteRequest struct {
+	// Address of the delegator
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+}
+
+func (m *VoteRequest) Reset()         { *m = VoteRequest{} }
+func (m *VoteRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteRequest) ProtoMessage()    {}
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{10}
+}
+func (m *VoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRequest.Merge(m, src)
+}
+func (m *VoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRequest proto.InternalMessageInfo
+
+func (m *VoteRequest) GetVoter() string {
+	if m != nil {
+		return m.Voter
+	}
+	return ""
+}
+
+type VoteResponse struct {
+	// Vote of the delegator
+	Vote Vote `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote"`
+}
+
+func (m *VoteResponse) Reset()         { *m = VoteResponse{} }
+func (m *VoteResponse) String() string { return proto.CompactTextString(m) }
+func (*VoteResponse) ProtoMessage()    {}
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{11}
+}
+func (m *VoteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteResponse.Merge(m, src)
+}
+func (m *VoteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteResponse proto.InternalMessageInfo
+
+func (m *VoteResponse) GetVote() Vote {
+	if m != nil {
+		return m.Vote
+	}
+	return Vote{}
+}
+
+type SponsoredDistributionRequest struct {
+}
+
+func (m *SponsoredDistributionRequest) Reset()         { *m = SponsoredDistributionRequest{} }
+func (m *SponsoredDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*SponsoredDistributionRequest) ProtoMessage()    {}
+func (*SponsoredDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{12}
+}
+func (m *SponsoredDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredDistributionRequest.Merge(m, src)
+}
+func (m *SponsoredDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredDistributionRequest proto.InternalMessageInfo
+
+type SponsoredDistributionResponse struct {
+	// Voting power voted for each gauge
+	Distribution DistrInfo `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *SponsoredDistributionResponse) Reset()         { *m = SponsoredDistributionResponse{} }
+func (m *SponsoredDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*SponsoredDistributionResponse) ProtoMessage()    {}
+func (*SponsoredDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{13}
+}
+func (m *SponsoredDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsoredDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsoredDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsoredDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredDistributionResponse.Merge(m, src)
+}
+func (m *SponsoredDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsoredDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredDistributionResponse proto.InternalMessageInfo
+
+func (m *SponsoredDistributionResponse) GetDistribution() DistrInfo {
+	if m != nil {
+		return m.Distribution
+	}
+	return DistrInfo{}
+}
+
+type RollappGaugeRequest struct {
+	// ID of the rollapp
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *RollappGaugeRequest) Reset()         { *m = RollappGaugeRequest{} }
+func (m *RollappGaugeRequest) String() string { return proto.CompactTextString(m) }
+func (*RollappGaugeRequest) ProtoMessage()    {}
+func (*RollappGaugeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{14}
+}
+func (m *RollappGaugeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappGaugeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappGaugeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappGaugeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappGaugeRequest.Merge(m, src)
+}
+func (m *RollappGaugeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappGaugeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappGaugeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappGaugeRequest proto.InternalMessageInfo
+
+func (m *RollappGaugeRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type RollappGaugeResponse struct {
+	// Gauge of the rollapp
+	RollappGauge RollappGauge `protobuf:"bytes,1,opt,name=rollapp_gauge,json=rollappGauge,proto3" json:"rollapp_gauge"`
+}
+
+func (m *RollappGaugeResponse) Reset()         { *m = RollappGaugeResponse{} }
+func (m *RollappGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*RollappGaugeResponse) ProtoMessage()    {}
+func (*RollappGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{15}
+}
+func (m *RollappGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappGaugeResponse.Merge(m, src)
+}
+func (m *RollappGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappGaugeResponse proto.InternalMessageInfo
+
+func (m *RollappGaugeResponse) GetRollappGauge() RollappGauge {
+	if m != nil {
+		return m.RollappGauge
+	}
+	return RollappGauge{}
+}
+
+type EmissionScheduleRequest struct {
+	// Stream ID being queried
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EmissionScheduleRequest) Reset()         { *m = EmissionScheduleRequest{} }
+func (m *EmissionScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleRequest) ProtoMessage()    {}
+func (*EmissionScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{16}
+}
+func (m *EmissionScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleRequest.Merge(m, src)
+}
+func (m *EmissionScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleRequest proto.InternalMessageInfo
+
+func (m *EmissionScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type EmissionScheduleResponse struct {
+	// Projected emission of each remaining epoch of the stream, capped to the
+	// next 1000 epochs
+	Schedule []EpochEmission `protobuf:"bytes,1,rep,name=schedule,proto3" json:"schedule"`
+}
+
+func (m *EmissionScheduleResponse) Reset()         { *m = EmissionScheduleResponse{} }
+func (m *EmissionScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*EmissionScheduleResponse) ProtoMessage()    {}
+func (*EmissionScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9c3279da5f3c595, []int{17}
+}
+func (m *EmissionScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionScheduleResponse.Merge(m, src)
+}
+func (m *EmissionScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionScheduleResponse proto.InternalMessageInfo
+
+func (m *EmissionScheduleResponse) GetSchedule() []EpochEmission {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "dymensionxyz.dymension.streamer.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "dymensionxyz.dymension.streamer.ModuleToDistributeCoinsResponse")
@@ -512,56 +864,86 @@ func init() {
 	proto.RegisterType((*ActiveStreamsResponse)(nil), "dymensionxyz.dymension.streamer.ActiveStreamsResponse")
 	proto.RegisterType((*UpcomingStreamsRequest)(nil), "dymensionxyz.dymension.streamer.UpcomingStreamsRequest")
 	proto.RegisterType((*UpcomingStreamsResponse)(nil), "dymensionxyz.dymension.streamer.UpcomingStreamsResponse")
+	proto.RegisterType((*VoteRequest)(nil), "dymensionxyz.dymension.streamer.VoteRequest")
+	proto.RegisterType((*VoteResponse)(nil), "dymensionxyz.dymension.streamer.VoteResponse")
+	proto.RegisterType((*SponsoredDistributionRequest)(nil), "dymensionxyz.dymension.streamer.SponsoredDistributionRequest")
+	proto.RegisterType((*SponsoredDistributionResponse)(nil), "dymensionxyz.dymension.streamer.SponsoredDistributionResponse")
+	proto.RegisterType((*RollappGaugeRequest)(nil), "dymensionxyz.dymension.streamer.RollappGaugeRequest")
+	proto.RegisterType((*RollappGaugeResponse)(nil), "dymensionxyz.dymension.streamer.RollappGaugeResponse")
+	proto.RegisterType((*EmissionScheduleRequest)(nil), "dymensionxyz.dymension.streamer.EmissionScheduleRequest")
+	proto.RegisterType((*EmissionScheduleResponse)(nil), "dymensionxyz.dymension.streamer.EmissionScheduleResponse")
 }
 
 func init() { proto.RegisterFile("dymension/streamer/query.proto", fileDescriptor_c9c3279da5f3c595) }
 
 var fileDescriptor_c9c3279da5f3c595 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xf9, 0xf5, 0x05, 0x9e, 0xf2, 0x6b, 0x71, 0xa8, 0xb6, 0x06, 0xd9, 0x94, 0x15,
-	0x34, 0x14, 0xdc, 0x69, 0x52, 0xb5, 0xbe, 0x20, 0xb5, 0x69, 0x51, 0x04, 0x05, 0x8d, 0x16, 0xc4,
-	0x83, 0x71, 0x37, 0x3b, 0xae, 0x83, 0xcd, 0x4e, 0x9a, 0x99, 0x2d, 0x8d, 0xe2, 0x45, 0xfc, 0x03,
-	0x04, 0xcf, 0x7a, 0x11, 0x2f, 0xde, 0x3c, 0x79, 0x11, 0xbc, 0xf6, 0x58, 0xf0, 0xe2, 0xc9, 0x97,
-	0xd6, 0x3f, 0x44, 0x32, 0x33, 0x9b, 0x97, 0x9a, 0xba, 0xa9, 0x50, 0xe8, 0x29, 0xb3, 0x79, 0x9e,
-	0xef, 0xf7, 0xf9, 0x3c, 0x4f, 0x76, 0x9f, 0x0d, 0x58, 0x7e, 0xa3, 0x4a, 0x43, 0xc1, 0x78, 0x48,
-	0x84, 0xac, 0x53, 0xb7, 0x4a, 0xeb, 0x64, 0x35, 0xa2, 0xf5, 0x86, 0x53, 0xab, 0x73, 0xc9, 0x71,
-	0xb6, 0x15, 0x5f, 0x6f, 0x3c, 0x71, 0x5a, 0x17, 0x4e, 0x9c, 0x9c, 0x19, 0x0f, 0x78, 0xc0, 0x55,
-	0x2e, 0x69, 0x9e, 0xb4, 0x2c, 0x73, 0x2c, 0xe0, 0x3c, 0x58, 0xa1, 0xc4, 0xad, 0x31, 0xe2, 0x86,
-	0x21, 0x97, 0xae, 0x64, 0x3c, 0x14, 0x26, 0x6a, 0x99, 0xa8, 0xba, 0xf2, 0xa2, 0x87, 0xc4, 0x8f,
-	0xea, 0x2a, 0x21, 0x8e, 0x57, 0xb8, 0xa8, 0x72, 0x41, 0x3c, 0x57, 0x50, 0xb2, 0x96, 0xf7, 0xa8,
-	0x74, 0xf3, 0xa4, 0xc2, 0x59, 0x1c, 0x9f, 0xee, 0x8c, 0x2b, 0xda, 0x56, 0x56, 0xcd, 0x0d, 0x58,
-	0xd8, 0xe9, 0x95, 0xed, 0xd1, 0xa0, 0x3e, 0xe8, 0x04, 0x7b, 0x0a, 0xac, 0x1b, 0xdc, 0x8f, 0x56,
-	0xe8, 0x1d, 0xbe, 0xc4, 0x84, 0xac, 0x33, 0x2f, 0x92, 0x74, 0x91, 0xb3, 0x50, 0x94, 0xe8, 0x6a,
-	0x44, 0x85, 0xb4, 0x5f, 0x20, 0xc8, 0xee, 0x9a, 0x22, 0x6a, 0x3c, 0x14, 0x14, 0xbb, 0x30, 0xd8,
-	0x04, 0x14, 0x93, 0x68, 0xea, 0xbf, 0xdc, 0x48, 0xe1, 0xa8, 0xa3, 0x11, 0x9d, 0x26, 0xa2, 0x63,
-	0xe0, 0x9c, 0xa6, 0xa4, 0x38, 0xb3, 0xf1, 0x2d, 0x9b, 0x7a, 0xff, 0x3d, 0x9b, 0x0b, 0x98, 0x7c,
-	0x14, 0x79, 0x4e, 0x85, 0x57, 0x89, 0xe9, 0x47, 0x7f, 0x9c, 0x12, 0xfe, 0x63, 0x22, 0x1b, 0x35,
-	0x2a, 0x1c, 0x5d, 0x43, 0x3b, 0xdb, 0xc7, 0xe1, 0xd0, 0x6d, 0x05, 0x5e, 0x6c, 0x5c, 0x5b, 0x32,
-	0x6c, 0x78, 0x14, 0xd2, 0xcc, 0x9f, 0x44, 0x53, 0x28, 0x37, 0x50, 0x4a, 0x33, 0xdf, 0x5e, 0x06,
-	0xdc, 0x99, 0x64, 0xe8, 0xe6, 0x61, 0x48, 0xf7, 0xac, 0x32, 0x47, 0x0a, 0x27, 0x9d, 0x84, 0x9f,
-	0xd5, 0xd1, 0x26, 0x25, 0x23, 0xb3, 0xef, 0xc2, 0xa8, 0xfe, 0x26, 0x1e, 0x0a, 0xbe, 0x02, 0xd0,
-	0x9e, 0xb5, 0xb1, 0x3d, 0xd1, 0xd5, 0xb5, 0xbe, 0x8d, 0xe2, 0xde, 0x6f, 0xba, 0x01, 0x35, 0xda,
-	0x52, 0x87, 0xd2, 0x7e, 0x8d, 0x60, 0xac, 0x65, 0x6d, 0x70, 0x17, 0x60, 0xc0, 0x77, 0xa5, 0x6b,
-	0x66, 0xd9, 0x2f, 0x6c, 0x71, 0xa0, 0x39, 0xd9, 0x92, 0x92, 0xe2, 0xab, 0x5d, 0x78, 0x69, 0xd3,
-	0x75, 0x12, 0x9e, 0xae, 0xdf, 0xc5, 0x77, 0x1f, 0xc6, 0x17, 0x2a, 0x92, 0xad, 0xd1, 0x7d, 0xea,
-	0xff, 0x2d, 0x82, 0xc3, 0x3b, 0x0a, 0x1c, 0xc0, 0x29, 0x3c, 0x80, 0x23, 0xcb, 0xb5, 0x0a, 0xaf,
-	0xb2, 0x30, 0xd8, 0xa7, 0x39, 0xbc, 0x43, 0x30, 0xf1, 0x47, 0x89, 0x83, 0x37, 0x89, 0xc2, 0xa7,
-	0x61, 0x18, 0xbc, 0xd5, 0x4c, 0xc5, 0x3f, 0x11, 0x4c, 0xec, 0xb2, 0x16, 0xf0, 0x7c, 0x22, 0xe3,
-	0xdf, 0x77, 0x4e, 0xe6, 0xf2, 0xbf, 0x1b, 0x68, 0x68, 0x7b, 0xf1, 0xf9, 0x97, 0x5f, 0xaf, 0xd2,
-	0x97, 0xf0, 0x45, 0xd2, 0xe9, 0x44, 0x7a, 0xac, 0xc3, 0xaa, 0x72, 0x2a, 0x4b, 0x5e, 0xf6, 0x5b,
-	0x5e, 0x65, 0xb5, 0x73, 0xf0, 0x07, 0x04, 0xd0, 0xde, 0x27, 0xb8, 0xd0, 0xef, 0xe8, 0xdb, 0x1b,
-	0x2a, 0x33, 0xbb, 0x27, 0x8d, 0x81, 0xbf, 0xa0, 0xe0, 0x4f, 0xe3, 0x42, 0x22, 0xbc, 0x3e, 0x94,
-	0xbd, 0x46, 0x99, 0xf9, 0xe4, 0x29, 0xf3, 0x9f, 0xe1, 0x37, 0x08, 0x86, 0xcd, 0x1d, 0x84, 0x49,
-	0x9f, 0xc5, 0x5b, 0x73, 0x9f, 0xe9, 0x5f, 0x60, 0x50, 0x67, 0x14, 0xea, 0x34, 0xce, 0xf5, 0x89,
-	0x2a, 0xf0, 0x47, 0x04, 0xff, 0x77, 0x3d, 0xf2, 0xf8, 0x4c, 0x62, 0xd5, 0x5e, 0x3b, 0x28, 0x73,
-	0x76, 0xaf, 0x32, 0x83, 0x3c, 0xa7, 0x90, 0xf3, 0x98, 0x24, 0x22, 0xbb, 0x4a, 0x5f, 0x8e, 0xc9,
-	0x3f, 0x23, 0x18, 0xdb, 0xf1, 0x90, 0xe2, 0xb9, 0x44, 0x88, 0xde, 0x9b, 0x23, 0x73, 0x6e, 0xef,
-	0x42, 0xc3, 0x7f, 0x5e, 0xf1, 0xcf, 0xe2, 0x7c, 0x22, 0x7f, 0x64, 0x1c, 0xe2, 0x0e, 0x8a, 0xd7,
-	0x37, 0xb6, 0x2c, 0xb4, 0xb9, 0x65, 0xa1, 0x1f, 0x5b, 0x16, 0x7a, 0xb9, 0x6d, 0xa5, 0x36, 0xb7,
-	0xad, 0xd4, 0xd7, 0x6d, 0x2b, 0x75, 0xaf, 0xd0, 0xf1, 0x3e, 0xde, 0xc5, 0x76, 0xbd, 0x6d, 0xac,
-	0xde, 0xcf, 0xde, 0x90, 0xfa, 0x0b, 0x31, 0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x56, 0x78,
-	0x2a, 0x46, 0x09, 0x00, 0x00,
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0x33, 0x61, 0x53, 0xc8, 0x4b, 0xd2, 0xc2, 0x90, 0x92, 0xb0, 0x6a, 0xbc, 0x91, 0x23,
+	0xda, 0x10, 0x11, 0x4f, 0xb2, 0x49, 0x08, 0x01, 0x95, 0xd0, 0xb4, 0xa5, 0x8a, 0x04, 0xa2, 0x6c,
+	0x5a, 0x54, 0x71, 0xc0, 0x78, 0xd7, 0x13, 0xc7, 0x62, 0xd7, 0xe3, 0xfa, 0x47, 0xd4, 0x25, 0xca,
+	0x05, 0xf1, 0x07, 0x20, 0x71, 0x43, 0x82, 0x0b, 0xe2, 0xc2, 0x8d, 0x13, 0x47, 0xe0, 0x96, 0x63,
+	0x25, 0x0e, 0x70, 0xe2, 0x47, 0xc2, 0x1f, 0x82, 0x66, 0x3c, 0xe3, 0xf5, 0xa6, 0xde, 0x7a, 0x37,
+	0x52, 0xa5, 0x5e, 0x5a, 0x7b, 0xe6, 0xbd, 0xef, 0x7c, 0xde, 0xcb, 0xec, 0xfb, 0xca, 0xa0, 0xd9,
+	0xed, 0x16, 0xf5, 0x42, 0x97, 0x79, 0x24, 0x8c, 0x02, 0x6a, 0xb5, 0x68, 0x40, 0xee, 0xc7, 0x34,
+	0x68, 0x1b, 0x7e, 0xc0, 0x22, 0x86, 0x2b, 0xe9, 0xfe, 0x83, 0xf6, 0xe7, 0x46, 0xfa, 0x62, 0xa8,
+	0xe0, 0xf2, 0xa4, 0xc3, 0x1c, 0x26, 0x62, 0x09, 0x7f, 0x4a, 0xd2, 0xca, 0x97, 0x1c, 0xc6, 0x9c,
+	0x26, 0x25, 0x96, 0xef, 0x12, 0xcb, 0xf3, 0x58, 0x64, 0x45, 0x2e, 0xf3, 0x42, 0xb9, 0xab, 0xc9,
+	0x5d, 0xf1, 0x56, 0x8f, 0x77, 0x89, 0x1d, 0x07, 0x22, 0x40, 0xed, 0x37, 0x58, 0xd8, 0x62, 0x21,
+	0xa9, 0x5b, 0x21, 0x25, 0xfb, 0xcb, 0x75, 0x1a, 0x59, 0xcb, 0xa4, 0xc1, 0x5c, 0xb5, 0xbf, 0x90,
+	0xdd, 0x17, 0xb4, 0x69, 0x94, 0x6f, 0x39, 0xae, 0x97, 0xd5, 0xaa, 0xe4, 0x14, 0x98, 0x3c, 0xc8,
+	0x80, 0xb9, 0x9c, 0x00, 0xdb, 0x0d, 0xa3, 0xc0, 0x74, 0xbd, 0x5d, 0x55, 0xcf, 0x4c, 0x4e, 0xd0,
+	0x3e, 0x8b, 0xa8, 0xdc, 0xbe, 0x9c, 0xb3, 0x1d, 0xb0, 0x66, 0xd3, 0xf2, 0x7d, 0xd3, 0xb1, 0x62,
+	0x47, 0xc5, 0x5d, 0xc9, 0x89, 0xa3, 0x2d, 0x37, 0xe4, 0x2b, 0x66, 0x23, 0x0e, 0xf6, 0x65, 0xa0,
+	0x3e, 0x0b, 0xda, 0xfb, 0xcc, 0x8e, 0x9b, 0xf4, 0x0e, 0xbb, 0xc1, 0x59, 0xdc, 0x7a, 0x1c, 0xd1,
+	0xeb, 0xcc, 0xf5, 0xc2, 0x1a, 0xbd, 0x1f, 0xd3, 0x30, 0xd2, 0xbf, 0x44, 0x50, 0xe9, 0x19, 0x12,
+	0xfa, 0xcc, 0x0b, 0x29, 0xb6, 0x60, 0x84, 0x77, 0x2d, 0x9c, 0x46, 0xb3, 0xcf, 0xcc, 0x8f, 0x55,
+	0x5f, 0x36, 0x92, 0xbe, 0x19, 0xbc, 0x6f, 0x86, 0xec, 0x98, 0xc1, 0x53, 0xb6, 0x96, 0x8e, 0xfe,
+	0xaa, 0x0c, 0xfd, 0xf8, 0x77, 0x65, 0xde, 0x71, 0xa3, 0xbd, 0xb8, 0x6e, 0x34, 0x58, 0x8b, 0xc8,
+	0x26, 0x27, 0xff, 0x2d, 0x86, 0xf6, 0x67, 0x24, 0x6a, 0xfb, 0x34, 0x34, 0x92, 0x33, 0x12, 0x65,
+	0x7d, 0x0e, 0x5e, 0xd8, 0x11, 0x95, 0x6c, 0xb5, 0xb7, 0x6f, 0x48, 0x36, 0x7c, 0x1e, 0x86, 0x5d,
+	0x7b, 0x1a, 0xcd, 0xa2, 0xf9, 0x52, 0x6d, 0xd8, 0xb5, 0xf5, 0xbb, 0x80, 0xb3, 0x41, 0x92, 0x6e,
+	0x13, 0xce, 0x25, 0x4d, 0x10, 0x91, 0x63, 0xd5, 0x2b, 0x46, 0xc1, 0x5d, 0x33, 0x12, 0x91, 0x9a,
+	0x4c, 0xd3, 0xef, 0xc1, 0xf9, 0x64, 0x45, 0x35, 0x05, 0xbf, 0x0b, 0xd0, 0xb9, 0x00, 0x52, 0xf6,
+	0x72, 0x57, 0xd5, 0xc9, 0xdd, 0x56, 0xb5, 0xdf, 0xb6, 0x1c, 0x2a, 0x73, 0x6b, 0x99, 0x4c, 0xfd,
+	0x5b, 0x04, 0x17, 0x52, 0x69, 0x89, 0x7b, 0x0d, 0x4a, 0xb6, 0x15, 0x59, 0xb2, 0x97, 0xfd, 0xc2,
+	0x6e, 0x95, 0x78, 0x67, 0x6b, 0x22, 0x15, 0xdf, 0xea, 0xc2, 0x1b, 0x96, 0x55, 0x17, 0xe1, 0x25,
+	0xe7, 0x77, 0xf1, 0x7d, 0x02, 0x93, 0xd7, 0x1a, 0x91, 0xbb, 0x4f, 0x9f, 0x50, 0xfd, 0xdf, 0x23,
+	0xb8, 0x78, 0xea, 0x80, 0xa7, 0xb0, 0x0b, 0x9f, 0xc2, 0x4b, 0x77, 0xfd, 0x06, 0x6b, 0xb9, 0x9e,
+	0xf3, 0x84, 0xfa, 0xf0, 0x03, 0x82, 0xa9, 0x47, 0x8e, 0x78, 0x0a, 0x3b, 0x31, 0x07, 0x63, 0x1f,
+	0xb1, 0x48, 0x95, 0x80, 0x27, 0x61, 0x84, 0x0f, 0xa7, 0x40, 0x54, 0x3e, 0x5a, 0x4b, 0x5e, 0xf4,
+	0x0f, 0x60, 0x3c, 0x09, 0x4a, 0x7f, 0x7f, 0x25, 0xbe, 0x21, 0xdb, 0xf3, 0x4a, 0x61, 0x01, 0x3c,
+	0x59, 0xe1, 0xf3, 0x44, 0x5d, 0x83, 0x4b, 0x3b, 0x5c, 0x8a, 0x05, 0xd4, 0x4e, 0x47, 0x90, 0xcb,
+	0x3c, 0x35, 0xa2, 0x62, 0x98, 0xe9, 0xb1, 0x2f, 0x09, 0xee, 0xc0, 0xb8, 0x9d, 0x59, 0x97, 0x24,
+	0x0b, 0x85, 0x24, 0x42, 0x6c, 0xdb, 0xdb, 0x65, 0x12, 0xa7, 0x4b, 0x45, 0x5f, 0x85, 0x17, 0x6b,
+	0xc9, 0xec, 0xbd, 0xc5, 0x47, 0xaf, 0x6a, 0xca, 0x0c, 0x80, 0x1a, 0xc9, 0x72, 0x38, 0x8d, 0xd6,
+	0x46, 0xe5, 0xca, 0xb6, 0xad, 0xfb, 0x30, 0xd9, 0x9d, 0x25, 0x19, 0xef, 0xc1, 0x44, 0xd7, 0x24,
+	0x97, 0x90, 0x8b, 0x85, 0x90, 0x59, 0x35, 0xc5, 0x19, 0x64, 0xd6, 0xf4, 0x57, 0x61, 0xea, 0xa6,
+	0x9c, 0xfd, 0x3b, 0x8d, 0x3d, 0xca, 0x47, 0x79, 0xaf, 0x01, 0xda, 0x84, 0xe9, 0x47, 0x43, 0x25,
+	0xe0, 0x6d, 0x78, 0x2e, 0x94, 0x6b, 0xf2, 0x2e, 0x1a, 0x85, 0x6c, 0x37, 0x7d, 0xd6, 0xd8, 0x53,
+	0x8a, 0x12, 0x2e, 0x55, 0xa9, 0xfe, 0x36, 0x01, 0x23, 0x1f, 0xf2, 0x8b, 0x87, 0xff, 0x45, 0x30,
+	0xd5, 0xc3, 0x64, 0xf0, 0x66, 0xe1, 0x29, 0x8f, 0x77, 0xb0, 0xf2, 0x3b, 0x67, 0x17, 0x48, 0x4a,
+	0xd7, 0xaf, 0x7f, 0xf1, 0xfb, 0x7f, 0x5f, 0x0f, 0x5f, 0xc5, 0x6f, 0x91, 0xac, 0x12, 0xc9, 0x31,
+	0xd9, 0x96, 0x50, 0x32, 0x23, 0x66, 0xa6, 0x57, 0x85, 0x9a, 0xc2, 0xc1, 0xf0, 0x4f, 0x08, 0xa0,
+	0xe3, 0x4e, 0xb8, 0xda, 0xef, 0x0f, 0xb9, 0xe3, 0x77, 0xe5, 0x95, 0x81, 0x72, 0x24, 0xfc, 0x9b,
+	0x02, 0x7e, 0x15, 0x57, 0x0b, 0xe1, 0x93, 0x07, 0xb3, 0xde, 0x36, 0x5d, 0x9b, 0x1c, 0xb8, 0xf6,
+	0x21, 0xfe, 0x0e, 0xc1, 0xb3, 0x89, 0x64, 0x88, 0x49, 0x9f, 0x87, 0xa7, 0x7d, 0x5f, 0xea, 0x3f,
+	0x41, 0xa2, 0x2e, 0x09, 0xd4, 0x05, 0x3c, 0xdf, 0x27, 0x6a, 0x88, 0x7f, 0x46, 0x30, 0xd1, 0x65,
+	0x20, 0x78, 0xad, 0xf0, 0xd4, 0x3c, 0x47, 0x2b, 0xbf, 0x3e, 0x68, 0x9a, 0x44, 0x5e, 0x17, 0xc8,
+	0xcb, 0x98, 0x14, 0x22, 0x5b, 0x22, 0xdf, 0x54, 0xe4, 0xbf, 0x20, 0xb8, 0x70, 0x6a, 0xe4, 0xe3,
+	0xf5, 0x42, 0x88, 0x7c, 0x1f, 0x2a, 0xbf, 0x31, 0x78, 0xa2, 0xe4, 0xdf, 0x10, 0xfc, 0x2b, 0x78,
+	0xb9, 0x90, 0x3f, 0x96, 0x0a, 0x69, 0x05, 0xdf, 0x20, 0x28, 0xf1, 0x59, 0x8d, 0x5f, 0xeb, 0x6b,
+	0xa4, 0x2b, 0xd6, 0xc5, 0x3e, 0xa3, 0x25, 0xe0, 0x9a, 0x00, 0x24, 0x78, 0xb1, 0x10, 0x90, 0x7b,
+	0x05, 0x39, 0xe0, 0xff, 0x06, 0x87, 0xf8, 0x0f, 0x04, 0x17, 0x73, 0x4d, 0x01, 0x5f, 0x2d, 0xbe,
+	0x96, 0x8f, 0x31, 0x9b, 0xf2, 0xdb, 0x67, 0x4d, 0x97, 0xf5, 0x6c, 0x8a, 0x7a, 0x36, 0xf0, 0x7a,
+	0xf1, 0x1d, 0x57, 0x3a, 0x66, 0xd6, 0x76, 0xf0, 0xaf, 0x08, 0xc6, 0xb3, 0x33, 0x1f, 0xaf, 0x0e,
+	0x64, 0x11, 0xaa, 0x8e, 0xb5, 0x01, 0xb3, 0x06, 0x1e, 0x85, 0x5d, 0x6e, 0x46, 0x0e, 0x3a, 0x9e,
+	0x78, 0x88, 0x8f, 0x10, 0x3c, 0x7f, 0xda, 0x67, 0x70, 0xf1, 0x1d, 0xee, 0xe1, 0x62, 0xe5, 0x8d,
+	0x33, 0x64, 0x0e, 0xfc, 0xd7, 0x48, 0x3f, 0x9f, 0x94, 0x7d, 0x89, 0x09, 0xb9, 0xf5, 0xde, 0xd1,
+	0xb1, 0x86, 0x1e, 0x1e, 0x6b, 0xe8, 0x9f, 0x63, 0x0d, 0x7d, 0x75, 0xa2, 0x0d, 0x3d, 0x3c, 0xd1,
+	0x86, 0xfe, 0x3c, 0xd1, 0x86, 0x3e, 0xae, 0x66, 0x3e, 0x71, 0x7a, 0x88, 0x3f, 0xe8, 0xc8, 0x8b,
+	0x4f, 0x9e, 0xfa, 0x39, 0xf1, 0x55, 0xb6, 0xf2, 0xff, 0x00, 0x88, 0xca, 0xa9, 0xca, 0x2e, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -586,6 +968,16 @@ type QueryClient interface {
 	ActiveStreams(ctx context.Context, in *ActiveStreamsRequest, opts ...grpc.CallOption) (*ActiveStreamsResponse, error)
 	// Returns scheduled streams that have not yet occured
 	UpcomingStreams(ctx context.Context, in *UpcomingStreamsRequest, opts ...grpc.CallOption) (*UpcomingStreamsResponse, error)
+	// Vote returns the gauge vote of a delegator
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// SponsoredDistribution returns the vote tally the sponsored streams
+	// distribute by
+	SponsoredDistribution(ctx context.Context, in *SponsoredDistributionRequest, opts ...grpc.CallOption) (*SponsoredDistributionResponse, error)
+	// RollappGauge returns the gauge of a rollapp
+	RollappGauge(ctx context.Context, in *RollappGaugeRequest, opts ...grpc.CallOption) (*RollappGaugeResponse, error)
+	// EmissionSchedule returns the projected emission of the remaining epochs of
+	// a stream
+	EmissionSchedule(ctx context.Context, in *EmissionScheduleRequest, opts ...grpc.CallOption) (*EmissionScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -641,6 +1033,42 @@ func (c *queryClient) UpcomingStreams(ctx context.Context, in *UpcomingStreamsRe
 	return out, nil
 }
 
+func (c *queryClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.streamer.Query/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SponsoredDistribution(ctx context.Context, in *SponsoredDistributionRequest, opts ...grpc.CallOption) (*SponsoredDistributionResponse, error) {
+	out := new(SponsoredDistributionResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.streamer.Query/SponsoredDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RollappGauge(ctx context.Context, in *RollappGaugeRequest, opts ...grpc.CallOption) (*RollappGaugeResponse, error) {
+	out := new(RollappGaugeResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.streamer.Query/RollappGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EmissionSchedule(ctx context.Context, in *EmissionScheduleRequest, opts ...grpc.CallOption) (*EmissionScheduleResponse, error) {
+	out := new(EmissionScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.streamer.Query/EmissionSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ModuleToDistributeCoins returns coins that are going to be distributed
@@ -653,6 +1081,16 @@ type QueryServer interface {
 	ActiveStreams(context.Context, *ActiveStreamsRequest) (*ActiveStreamsResponse, error)
 	// Returns scheduled streams that have not yet occured
 	UpcomingStreams(context.Context, *UpcomingStreamsRequest) (*UpcomingStreamsResponse, error)
+	// Vote returns the gauge vote of a delegator
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	// SponsoredDistribution returns the vote tally the sponsored streams
+	// distribute by
+	SponsoredDistribution(context.Context, *SponsoredDistributionRequest) (*SponsoredDistributionResponse, error)
+	// RollappGauge returns the gauge of a rollapp
+	RollappGauge(context.Context, *RollappGaugeRequest) (*RollappGaugeResponse, error)
+	// EmissionSchedule returns the projected emission of the remaining epochs of
+	// a stream
+	EmissionSchedule(context.Context, *EmissionScheduleRequest) (*EmissionScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -674,6 +1112,18 @@ func (*UnimplementedQueryServer) ActiveStreams(ctx context.Context, req *ActiveS
 func (*UnimplementedQueryServer) UpcomingStreams(ctx context.Context, req *UpcomingStreamsRequest) (*UpcomingStreamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpcomingStreams not implemented")
 }
+func (*UnimplementedQueryServer) Vote(ctx context.Context, req *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (*UnimplementedQueryServer) SponsoredDistribution(ctx context.Context, req *SponsoredDistributionRequest) (*SponsoredDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SponsoredDistribution not implemented")
+}
+func (*UnimplementedQueryServer) RollappGauge(ctx context.Context, req *RollappGaugeRequest) (*RollappGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollappGauge not implemented")
+}
+func (*UnimplementedQueryServer) EmissionSchedule(ctx context.Context, req *EmissionScheduleRequest) (*EmissionScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -769,34 +1219,122 @@ func _Query_UpcomingStreams_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.streamer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ModuleToDistributeCoins",
-			Handler:    _Query_ModuleToDistributeCoins_Handler,
-		},
-		{
-			MethodName: "StreamByID",
-			Handler:    _Query_StreamByID_Handler,
-		},
-		{
-			MethodName: "Streams",
-			Handler:    _Query_Streams_Handler,
-		},
-		{
-			MethodName: "ActiveStreams",
-			Handler:    _Query_ActiveStreams_Handler,
-		},
-		{
-			MethodName: "UpcomingStreams",
-			Handler:    _Query_UpcomingStreams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymension/streamer/query.proto",
-}
+func _Query_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.streamer.Query/Vote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SponsoredDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SponsoredDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SponsoredDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.streamer.Query/SponsoredDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SponsoredDistribution(ctx, req.(*SponsoredDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RollappGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollappGaugeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RollappGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.streamer.Query/RollappGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RollappGauge(ctx, req.(*RollappGaugeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EmissionSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmissionScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.streamer.Query/EmissionSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionSchedule(ctx, req.(*EmissionScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.streamer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ModuleToDistributeCoins",
+			Handler:    _Query_ModuleToDistributeCoins_Handler,
+		},
+		{
+			MethodName: "StreamByID",
+			Handler:    _Query_StreamByID_Handler,
+		},
+		{
+			MethodName: "Streams",
+			Handler:    _Query_Streams_Handler,
+		},
+		{
+			MethodName: "ActiveStreams",
+			Handler:    _Query_ActiveStreams_Handler,
+		},
+		{
+			MethodName: "UpcomingStreams",
+			Handler:    _Query_UpcomingStreams_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _Query_Vote_Handler,
+		},
+		{
+			MethodName: "SponsoredDistribution",
+			Handler:    _Query_SponsoredDistribution_Handler,
+		},
+		{
+			MethodName: "RollappGauge",
+			Handler:    _Query_RollappGauge_Handler,
+		},
+		{
+			MethodName: "EmissionSchedule",
+			Handler:    _Query_EmissionSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymension/streamer/query.proto",
+}
 
 func (m *ModuleToDistributeCoinsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -1173,121 +1711,368 @@ func (m *UpcomingStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *VoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Coins) > 0 {
-		for _, e := range m.Coins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovQuery(uint64(m.Id))
+func (m *VoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *StreamByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Vote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SponsoredDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StreamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SponsoredDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsoredDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsoredDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveStreamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RollappGaugeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappGaugeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappGaugeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ActiveStreamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RollappGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.RollappGauge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for iNdEx := len(m.Schedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleToDistributeCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ModuleToDistributeCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StreamByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *StreamByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ActiveStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
 	if m.Pagination != nil {
@@ -1329,6 +2114,101 @@ func (m *UpcomingStreamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *VoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Vote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SponsoredDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SponsoredDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *RollappGaugeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RollappGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RollappGauge.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EmissionScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *EmissionScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Schedule) > 0 {
+		for _, e := range m.Schedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1404,19 +2284,790 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleToDistributeCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Stream{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Stream{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpcomingStreamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpcomingStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpcomingStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpcomingStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, Stream{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1443,8 +3094,10 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1469,7 +3122,7 @@ func (m *ModuleToDistributeCoinsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *VoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1492,17 +3145,17 @@ func (m *StreamByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1512,11 +3165,24 @@ func (m *StreamByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1538,7 +3204,7 @@ func (m *StreamByIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamByIDResponse) Unmarshal(dAtA []byte) error {
+func (m *VoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1561,15 +3227,15 @@ func (m *StreamByIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamByIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vote", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1596,10 +3262,7 @@ func (m *StreamByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stream == nil {
-				m.Stream = &Stream{}
-			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Vote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1624,7 +3287,7 @@ func (m *StreamByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *SponsoredDistributionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1647,48 +3310,12 @@ func (m *StreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsoredDistributionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsoredDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1710,7 +3337,7 @@ func (m *StreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *SponsoredDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1733,49 +3360,15 @@ func (m *StreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsoredDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsoredDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, Stream{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1802,10 +3395,7 @@ func (m *StreamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1830,7 +3420,7 @@ func (m *StreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RollappGaugeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1853,17 +3443,17 @@ func (m *ActiveStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollappGaugeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollappGaugeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1873,27 +3463,23 @@ func (m *ActiveStreamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1916,7 +3502,7 @@ func (m *ActiveStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *RollappGaugeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1939,49 +3525,15 @@ func (m *ActiveStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollappGaugeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollappGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, Stream{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappGauge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2008,10 +3560,7 @@ func (m *ActiveStreamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RollappGauge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2036,7 +3585,7 @@ func (m *ActiveStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *EmissionScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,17 +3608,17 @@ func (m *UpcomingStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2079,28 +3628,11 @@ func (m *UpcomingStreamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2122,7 +3654,7 @@ func (m *UpcomingStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpcomingStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *EmissionScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2145,49 +3677,15 @@ func (m *UpcomingStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpcomingStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpcomingStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = append(m.Data, Stream{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2214,10 +3712,8 @@ func (m *UpcomingStreamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedule = append(m.Schedule, EpochEmission{})
+			if err := m.Schedule[len(m.Schedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
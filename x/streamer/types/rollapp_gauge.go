@@ -0,0 +1,20 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of a rollapp gauge.
+func (g RollappGauge) Validate() error {
+	if g.RollappId == "" {
+		return fmt.Errorf("rollapp gauge %d has an empty rollapp id", g.GaugeId)
+	}
+	if g.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(g.Recipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -7,7 +7,8 @@ import (
 )
 
 // NewStream creates a new stream struct given the required stream parameters.
-func NewStream(id uint64, distrTo *DistrInfo, coins sdk.Coins, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64) Stream {
+// Streams created by governance have an empty owner.
+func NewStream(id uint64, distrTo *DistrInfo, coins sdk.Coins, startTime time.Time, epochIdentifier string, numEpochsPaidOver uint64, owner string, sponsored bool, emissionCurve *EmissionCurve) Stream {
 	return Stream{
 		Id:                   id,
 		DistributeTo:         distrTo,
@@ -17,6 +18,9 @@ func NewStream(id uint64, distrTo *DistrInfo, coins sdk.Coins, startTime time.Ti
 		NumEpochsPaidOver:    numEpochsPaidOver,
 		FilledEpochs:         0,
 		DistributedCoins:     sdk.Coins{},
+		Owner:                owner,
+		Sponsored:            sponsored,
+		EmissionCurve:        emissionCurve,
 	}
 }
 
@@ -53,6 +53,15 @@ type Stream struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// owner is the address of the account which funded the stream. Streams
+	// created by governance have no owner
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// sponsored streams distribute according to the gauge votes of the stakers.
+	// Their distribute_to is replaced by the vote tally before each distribution
+	Sponsored bool `protobuf:"varint,10,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
+	// emission_curve is how the stream spreads its coins over its epochs. Streams
+	// without a curve distribute linearly
+	EmissionCurve *EmissionCurve `protobuf:"bytes,11,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty" yaml:"emission_curve"`
 }
 
 func (m *Stream) Reset()         { *m = Stream{} }
@@ -144,6 +153,27 @@ func (m *Stream) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins
 	return nil
 }
 
+func (m *Stream) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Stream) GetSponsored() bool {
+	if m != nil {
+		return m.Sponsored
+	}
+	return false
+}
+
+func (m *Stream) GetEmissionCurve() *EmissionCurve {
+	if m != nil {
+		return m.EmissionCurve
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Stream)(nil), "dymensionxyz.dymension.streamer.Stream")
 }
@@ -151,38 +181,44 @@ func init() {
 func init() { proto.RegisterFile("dymension/streamer/stream.proto", fileDescriptor_409f823846b6b198) }
 
 var fileDescriptor_409f823846b6b198 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xba, 0xae, 0x30, 0x6f, 0x43, 0x34, 0xaa, 0x50, 0xa8, 0xb4, 0xa4, 0x74, 0x97, 0x08,
-	0x09, 0x9b, 0x95, 0x1b, 0xc7, 0x02, 0x87, 0x49, 0x48, 0x43, 0x61, 0x12, 0x88, 0x4b, 0xe4, 0xd4,
-	0x4e, 0x66, 0xd1, 0xc4, 0x51, 0xec, 0x54, 0x2d, 0xbf, 0x62, 0xbf, 0x83, 0x5f, 0xb2, 0x1b, 0x3b,
-	0x72, 0xea, 0x50, 0xfb, 0x0f, 0xf6, 0x0b, 0x90, 0xed, 0x64, 0xa9, 0xd0, 0x24, 0x2e, 0x3b, 0xd9,
-	0xef, 0xbd, 0xef, 0xfb, 0xde, 0x7b, 0x9f, 0x0d, 0x3c, 0xb2, 0x4c, 0x69, 0x26, 0x18, 0xcf, 0x90,
-	0x90, 0x05, 0xc5, 0x29, 0x2d, 0xaa, 0x0b, 0xcc, 0x0b, 0x2e, 0xb9, 0xdd, 0x00, 0x16, 0xcb, 0x1f,
-	0xf0, 0x2e, 0x80, 0x35, 0x7a, 0xd0, 0x4f, 0x78, 0xc2, 0x35, 0x16, 0xa9, 0x9b, 0xa1, 0x0d, 0xdc,
-	0x84, 0xf3, 0x64, 0x46, 0x91, 0x8e, 0xa2, 0x32, 0x46, 0xa4, 0x2c, 0xb0, 0x54, 0x44, 0x53, 0xf7,
-	0xfe, 0xad, 0x4b, 0x96, 0x52, 0x21, 0x71, 0x9a, 0xd7, 0x02, 0x53, 0x2e, 0x52, 0x2e, 0x50, 0x84,
-	0x05, 0x45, 0xf3, 0x93, 0x88, 0x4a, 0x7c, 0x82, 0xa6, 0x9c, 0xd5, 0x02, 0xc7, 0xf7, 0x0c, 0x4e,
-	0x98, 0x90, 0x45, 0xc8, 0xb2, 0xb8, 0x9a, 0x62, 0xf4, 0xab, 0x03, 0xba, 0x9f, 0x75, 0xd5, 0x7e,
-	0x02, 0xda, 0x8c, 0x38, 0xd6, 0xd0, 0xf2, 0x3b, 0x41, 0x9b, 0x11, 0xfb, 0x0c, 0x1c, 0x6a, 0x38,
-	0x8b, 0x4a, 0x49, 0x43, 0xc9, 0x9d, 0xf6, 0xd0, 0xf2, 0xf7, 0xc7, 0x2f, 0xe1, 0x7f, 0xf6, 0x85,
-	0xef, 0x15, 0xeb, 0x34, 0x8b, 0x79, 0x70, 0xd0, 0x08, 0x9c, 0x73, 0x1b, 0x83, 0x5d, 0x35, 0x9e,
-	0x70, 0x76, 0x86, 0x3b, 0xfe, 0xfe, 0xf8, 0x39, 0x34, 0x0b, 0x40, 0xb5, 0x00, 0xac, 0x16, 0x80,
-	0xef, 0x38, 0xcb, 0x26, 0xaf, 0xaf, 0x56, 0x5e, 0xeb, 0xe7, 0x8d, 0xe7, 0x27, 0x4c, 0x5e, 0x94,
-	0x11, 0x9c, 0xf2, 0x14, 0x55, 0xdb, 0x9a, 0xe3, 0x95, 0x20, 0xdf, 0x91, 0x5c, 0xe6, 0x54, 0x68,
-	0x82, 0x08, 0x8c, 0xb2, 0xfd, 0x15, 0x00, 0x21, 0x71, 0x21, 0x43, 0x65, 0x96, 0xd3, 0xd1, 0x03,
-	0x0f, 0xa0, 0x71, 0x12, 0xd6, 0x4e, 0xc2, 0xf3, 0xda, 0xc9, 0xc9, 0x91, 0x6a, 0x74, 0xbb, 0xf2,
-	0x7a, 0x4b, 0x9c, 0xce, 0xde, 0x8e, 0x1a, 0xee, 0xe8, 0xf2, 0xc6, 0xb3, 0x82, 0x3d, 0x9d, 0x50,
-	0x70, 0xfb, 0x0b, 0x78, 0x66, 0xcc, 0xa3, 0x39, 0x9f, 0x5e, 0x84, 0x8c, 0xd0, 0x4c, 0xb2, 0x98,
-	0xd1, 0xc2, 0xd9, 0x1d, 0x5a, 0xfe, 0xde, 0xe4, 0xc5, 0xed, 0xca, 0x3b, 0x32, 0x2a, 0xf7, 0xe3,
-	0x46, 0x41, 0x5f, 0x17, 0x3e, 0xa8, 0xfc, 0xe9, 0x5d, 0xda, 0x46, 0xa0, 0x9f, 0x95, 0xa9, 0x81,
-	0x8b, 0x30, 0xc7, 0x8c, 0x84, 0x7c, 0x4e, 0x0b, 0xa7, 0xab, 0x1f, 0xa2, 0x97, 0x95, 0xa9, 0x66,
-	0x88, 0x4f, 0x98, 0x91, 0xb3, 0x39, 0x2d, 0xec, 0x63, 0x70, 0x18, 0xb3, 0xd9, 0x8c, 0x92, 0x8a,
-	0xe3, 0x3c, 0xd2, 0xc8, 0x03, 0x93, 0x34, 0x60, 0x7b, 0x01, 0x7a, 0x8d, 0xf7, 0x24, 0x34, 0xbe,
-	0x3f, 0x7e, 0x78, 0xdf, 0x9f, 0x6e, 0x75, 0xd1, 0x99, 0xc9, 0xc7, 0xab, 0xb5, 0x6b, 0x5d, 0xaf,
-	0x5d, 0xeb, 0xcf, 0xda, 0xb5, 0x2e, 0x37, 0x6e, 0xeb, 0x7a, 0xe3, 0xb6, 0x7e, 0x6f, 0xdc, 0xd6,
-	0xb7, 0xf1, 0x96, 0xea, 0xf6, 0x1f, 0x6a, 0x02, 0xb4, 0x68, 0xbe, 0xaa, 0xee, 0x12, 0x75, 0xf5,
-	0xa3, 0xbd, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x60, 0x6c, 0xe9, 0x86, 0x03, 0x00, 0x00,
+	// 582 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xf6, 0xa7, 0xbf, 0xd5, 0xfb, 0xa3, 0xd5, 0xea, 0x0f, 0x65, 0x15, 0x4b, 0x4a, 0x26,
+	0x41, 0x84, 0x84, 0xc3, 0xca, 0x8d, 0x63, 0xc7, 0x0e, 0x93, 0x90, 0x86, 0xc2, 0x24, 0x10, 0x97,
+	0x28, 0xa9, 0xdd, 0xce, 0xa2, 0x89, 0x23, 0xdb, 0x29, 0x2d, 0x5f, 0x80, 0xeb, 0x3e, 0x07, 0x9f,
+	0x64, 0xc7, 0x1d, 0x39, 0x75, 0xa8, 0xfd, 0x06, 0xfd, 0x04, 0x28, 0x76, 0xb3, 0xb4, 0xa8, 0xd2,
+	0x2e, 0x9c, 0x62, 0x3f, 0xef, 0xf3, 0x3c, 0xaf, 0xdf, 0xc7, 0x0e, 0xb0, 0xf1, 0x38, 0x26, 0x89,
+	0xa0, 0x2c, 0xf1, 0x84, 0xe4, 0x24, 0x8c, 0x09, 0x5f, 0x2c, 0x50, 0xca, 0x99, 0x64, 0xb0, 0x24,
+	0x8c, 0xc6, 0xdf, 0xd1, 0xc3, 0x06, 0x15, 0xec, 0x66, 0xa3, 0xcf, 0xfa, 0x4c, 0x71, 0xbd, 0x7c,
+	0xa5, 0x65, 0x4d, 0xab, 0xcf, 0x58, 0x7f, 0x40, 0x3c, 0xb5, 0x8b, 0xb2, 0x9e, 0x87, 0x33, 0x1e,
+	0xca, 0x5c, 0xa8, 0xeb, 0xf6, 0xdf, 0x75, 0x49, 0x63, 0x22, 0x64, 0x18, 0xa7, 0x85, 0x41, 0x97,
+	0x89, 0x98, 0x09, 0x2f, 0x0a, 0x05, 0xf1, 0x86, 0xa7, 0x11, 0x91, 0xe1, 0xa9, 0xd7, 0x65, 0xb4,
+	0x30, 0x38, 0x59, 0x73, 0x70, 0x4c, 0x85, 0xe4, 0x01, 0x4d, 0x7a, 0xc5, 0x29, 0x5e, 0xac, 0x21,
+	0x91, 0x98, 0x8a, 0x1c, 0x09, 0xba, 0x19, 0x1f, 0x12, 0x4d, 0x74, 0x7e, 0x54, 0x41, 0xf5, 0xa3,
+	0x62, 0xc0, 0x03, 0xb0, 0x41, 0xb1, 0x69, 0xb4, 0x0c, 0x77, 0xcb, 0xdf, 0xa0, 0x18, 0x5e, 0x82,
+	0x7d, 0xe5, 0x4b, 0xa3, 0x4c, 0x92, 0x40, 0x32, 0x73, 0xa3, 0x65, 0xb8, 0xbb, 0xed, 0x97, 0xe8,
+	0x91, 0x60, 0xd0, 0xbb, 0x5c, 0x75, 0x91, 0xf4, 0x98, 0xbf, 0x57, 0x1a, 0x5c, 0x31, 0x18, 0x82,
+	0xed, 0x7c, 0x0e, 0x61, 0x6e, 0xb6, 0x36, 0xdd, 0xdd, 0xf6, 0x11, 0xd2, 0x93, 0xa2, 0x7c, 0x52,
+	0xb4, 0x98, 0x14, 0x9d, 0x31, 0x9a, 0x74, 0x5e, 0xdf, 0x4e, 0xec, 0xca, 0xcf, 0x7b, 0xdb, 0xed,
+	0x53, 0x79, 0x9d, 0x45, 0xa8, 0xcb, 0x62, 0x6f, 0x11, 0x8b, 0xfe, 0xbc, 0x12, 0xf8, 0xab, 0x27,
+	0xc7, 0x29, 0x11, 0x4a, 0x20, 0x7c, 0xed, 0x0c, 0x3f, 0x03, 0x20, 0x64, 0xc8, 0x65, 0x90, 0xa7,
+	0x6a, 0x6e, 0xa9, 0x03, 0x37, 0x91, 0x8e, 0x1c, 0x15, 0x91, 0xa3, 0xab, 0x22, 0xf2, 0xce, 0x71,
+	0xde, 0x68, 0x3e, 0xb1, 0xeb, 0xe3, 0x30, 0x1e, 0xbc, 0x75, 0x4a, 0xad, 0x73, 0x73, 0x6f, 0x1b,
+	0x7e, 0x4d, 0x01, 0x39, 0x1d, 0x7e, 0x02, 0x4f, 0x74, 0xca, 0x24, 0x65, 0xdd, 0xeb, 0x80, 0x62,
+	0x92, 0x48, 0xda, 0xa3, 0x84, 0x9b, 0xdb, 0x2d, 0xc3, 0xad, 0x75, 0x9e, 0xcd, 0x27, 0xf6, 0xb1,
+	0x76, 0x59, 0xcf, 0x73, 0xfc, 0x86, 0x2a, 0x9c, 0xe7, 0xf8, 0xc5, 0x03, 0x0c, 0x3d, 0xd0, 0x48,
+	0xb2, 0x58, 0xd3, 0x45, 0x90, 0x86, 0x14, 0x07, 0x6c, 0x48, 0xb8, 0x59, 0x55, 0x17, 0x51, 0x4f,
+	0xb2, 0x58, 0x29, 0xc4, 0x87, 0x90, 0xe2, 0xcb, 0x21, 0xe1, 0xf0, 0x04, 0xec, 0xf7, 0xe8, 0x60,
+	0x40, 0xf0, 0x42, 0x63, 0xfe, 0xa7, 0x98, 0x7b, 0x1a, 0xd4, 0x64, 0x38, 0x02, 0xf5, 0x32, 0x7b,
+	0x1c, 0xe8, 0xdc, 0x77, 0xfe, 0x7d, 0xee, 0x87, 0x4b, 0x5d, 0x14, 0x02, 0x9f, 0x83, 0x6d, 0xf6,
+	0x2d, 0x21, 0xdc, 0xac, 0xa9, 0x5c, 0x0e, 0xe7, 0x13, 0x7b, 0x4f, 0xe7, 0xa2, 0x60, 0xc7, 0xd7,
+	0x65, 0xf8, 0x14, 0xd4, 0x44, 0xca, 0x12, 0xc1, 0x38, 0xc1, 0x26, 0x68, 0x19, 0xee, 0x8e, 0x5f,
+	0x02, 0x30, 0x05, 0x07, 0xab, 0xef, 0xd5, 0xdc, 0x55, 0x97, 0x89, 0x1e, 0x7d, 0x7d, 0xe7, 0x0b,
+	0xd9, 0x59, 0xae, 0xea, 0x1c, 0xcd, 0x27, 0xf6, 0xff, 0xba, 0xfd, 0xaa, 0x9f, 0xe3, 0xef, 0x93,
+	0x15, 0xe6, 0xfb, 0xdb, 0xa9, 0x65, 0xdc, 0x4d, 0x2d, 0xe3, 0xf7, 0xd4, 0x32, 0x6e, 0x66, 0x56,
+	0xe5, 0x6e, 0x66, 0x55, 0x7e, 0xcd, 0xac, 0xca, 0x97, 0xf6, 0x52, 0x1a, 0xcb, 0xdd, 0xcb, 0x8d,
+	0x37, 0x2a, 0x7f, 0x33, 0x95, 0x4e, 0x54, 0x55, 0x8f, 0xed, 0xcd, 0x9f, 0x01, 0x00, 0xc7, 0x28,
+	0xa6, 0xac, 0x67, 0x04, 0x00, 0x00,
 }
 
 func (m *Stream) Marshal() (dAtA []byte, err error) {
@@ -205,6 +241,35 @@ func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EmissionCurve != nil {
+		{
+			size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Sponsored {
+		i--
+		if m.Sponsored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -236,12 +301,12 @@ func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintStream(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintStream(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Coins) > 0 {
@@ -326,6 +391,17 @@ func (m *Stream) Size() (n int) {
 			n += 1 + l + sovStream(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.Sponsored {
+		n += 2
+	}
+	if m.EmissionCurve != nil {
+		l = m.EmissionCurve.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
 	return n
 }
 
@@ -590,6 +666,94 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sponsored = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionCurve == nil {
+				m.EmissionCurve = &EmissionCurve{}
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStream(dAtA[iNdEx:])
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateVoteWeights checks that a vote gives a positive weight to each gauge it votes for.
+func ValidateVoteWeights(weights []DistrRecord) error {
+	if len(weights) == 0 {
+		return ErrEmptyProposalRecords
+	}
+	for _, record := range weights {
+		if !record.Weight.IsPositive() {
+			return ErrDistrRecordNotPositiveWeight
+		}
+	}
+	return nil
+}
+
+// Validate performs basic validation of a vote.
+func (v Vote) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(v.Voter); err != nil {
+		return err
+	}
+	if !v.VotingPower.IsPositive() {
+		return ErrNoVotingPower
+	}
+	return ValidateVoteWeights(v.Weights)
+}
+
+// GaugePowers splits the voting power of the vote over its gauges according to their relative weights.
+func (v Vote) GaugePowers() []DistrRecord {
+	totalWeight := sdk.ZeroInt()
+	for _, record := range v.Weights {
+		totalWeight = totalWeight.Add(record.Weight)
+	}
+
+	powers := make([]DistrRecord, 0, len(v.Weights))
+	for _, record := range v.Weights {
+		powers = append(powers, DistrRecord{
+			GaugeId: record.GaugeId,
+			Weight:  v.VotingPower.Mul(record.Weight).Quo(totalWeight),
+		})
+	}
+	return powers
+}
+
+// AddVote adds (or removes, if add is false) the voting power of the vote to the tally of the gauges.
+// Gauges left without voting power are dropped, and the records are kept sorted by gauge ID.
+func (d DistrInfo) AddVote(vote Vote, add bool) DistrInfo {
+	gaugePowers := make(map[uint64]sdk.Int)
+	for _, record := range d.Records {
+		gaugePowers[record.GaugeId] = record.Weight
+	}
+	for _, record := range vote.GaugePowers() {
+		power, ok := gaugePowers[record.GaugeId]
+		if !ok {
+			power = sdk.ZeroInt()
+		}
+		if add {
+			gaugePowers[record.GaugeId] = power.Add(record.Weight)
+		} else {
+			gaugePowers[record.GaugeId] = power.Sub(record.Weight)
+		}
+	}
+
+	tally := DistrInfo{TotalWeight: sdk.ZeroInt(), Records: []DistrRecord{}}
+	for gaugeID, power := range gaugePowers {
+		if !power.IsPositive() {
+			continue
+		}
+		tally.Records = append(tally.Records, DistrRecord{GaugeId: gaugeID, Weight: power})
+		tally.TotalWeight = tally.TotalWeight.Add(power)
+	}
+	sort.Slice(tally.Records, func(i, j int) bool {
+		return tally.Records[i].GaugeId < tally.Records[j].GaugeId
+	})
+	return tally
+}